@@ -12,6 +12,7 @@ import (
 
 	"github.com/ivankorhner/polling-app/internal/config"
 	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
 	"github.com/ivankorhner/polling-app/internal/logging"
 )
 
@@ -116,8 +117,17 @@ func run(ctx context.Context, cfg *config.Config, logger *slog.Logger) error {
 		return err
 	}
 
-	// Create votes (vote_count is now calculated dynamically)
+	// Create ballots and votes (vote_count is calculated dynamically)
+	ballot1, err := client.Ballot.Create().
+		SetPollID(poll1.ID).
+		SetUserID(user1.ID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
 	_, err = client.Vote.Create().
+		SetBallotID(ballot1.ID).
 		SetPollID(poll1.ID).
 		SetOptionID(opt1.ID).
 		SetUserID(user1.ID).
@@ -126,7 +136,16 @@ func run(ctx context.Context, cfg *config.Config, logger *slog.Logger) error {
 		return err
 	}
 
+	ballot2, err := client.Ballot.Create().
+		SetPollID(poll1.ID).
+		SetUserID(user2.ID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
 	_, err = client.Vote.Create().
+		SetBallotID(ballot2.ID).
 		SetPollID(poll1.ID).
 		SetOptionID(opt2.ID).
 		SetUserID(user2.ID).
@@ -135,6 +154,19 @@ func run(ctx context.Context, cfg *config.Config, logger *slog.Logger) error {
 		return err
 	}
 
+	// Record the votes in the poll's vote history
+	for _, v := range []struct{ userID, optionID int }{{user1.ID, opt1.ID}, {user2.ID, opt2.ID}} {
+		_, err = client.VoteEvent.Create().
+			SetPollID(poll1.ID).
+			SetUserID(v.userID).
+			SetAction(voteevent.ActionCast).
+			SetOptionIds([]int{v.optionID}).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
 	logger.Info("database seeded successfully",
 		slog.Int("users", 2),
 		slog.Int("polls", 2),
@@ -15,8 +15,10 @@ import (
 
 	"github.com/ivankorhner/polling-app/internal/config"
 	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/events"
 	"github.com/ivankorhner/polling-app/internal/logging"
 	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
 )
 
 func run(
@@ -56,9 +58,17 @@ func run(
 		slog.String("database", config.DBName),
 	)
 
+	// Poll events reach the clients of every replica through the database
+	bus := events.NewPostgres(db, config.DatabaseURL(), logger)
+	go bus.Listen(ctx)
+
+	// Webhook deliveries are sent from the outbox, so any replica may send
+	// those queued by another
+	go webhooks.NewWorker(client, logger).Run(ctx)
+
 	httpServer := &http.Server{
 		Addr:         config.Addr(),
-		Handler:      server.AddRoutes(ctx, config, logger, db, client),
+		Handler:      server.AddRoutes(ctx, config, logger, db, client, bus),
 		ErrorLog:     slog.NewLogLogger(logger.Handler(), slog.LevelInfo),
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 10 * time.Second,
@@ -13,6 +13,8 @@ type Config struct {
 	Port       int
 	Host       string
 	APITimeout time.Duration
+	// How long responses are kept for replay under their Idempotency-Key
+	IdempotencyTTL time.Duration
 
 	// Database config
 	DBHost     string
@@ -26,9 +28,10 @@ type Config struct {
 func LoadConfig() *Config {
 	cfg := &Config{
 		// HTTP defaults
-		Port:       8080,
-		Host:       "0.0.0.0",
-		APITimeout: 30 * time.Second,
+		Port:           8080,
+		Host:           "0.0.0.0",
+		APITimeout:     30 * time.Second,
+		IdempotencyTTL: 24 * time.Hour,
 
 		// Database defaults
 		DBHost:     "localhost",
@@ -55,6 +58,12 @@ func LoadConfig() *Config {
 		}
 	}
 
+	if ttl := os.Getenv("IDEMPOTENCY_TTL"); ttl != "" {
+		if t, err := time.ParseDuration(ttl); err == nil {
+			cfg.IdempotencyTTL = t
+		}
+	}
+
 	// Override database config from environment variables
 	if dbHost := os.Getenv("DB_HOST"); dbHost != "" {
 		cfg.DBHost = dbHost
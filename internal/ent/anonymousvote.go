@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/polloption"
+)
+
+// AnonymousVote is the model entity for the AnonymousVote schema.
+type AnonymousVote struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// BallotKey holds the value of the "ballot_key" field.
+	BallotKey uuid.UUID `json:"ballot_key,omitempty"`
+	// PollID holds the value of the "poll_id" field.
+	PollID int `json:"poll_id,omitempty"`
+	// OptionID holds the value of the "option_id" field.
+	OptionID int `json:"option_id,omitempty"`
+	// Rank holds the value of the "rank" field.
+	Rank *int `json:"rank,omitempty"`
+	// Score holds the value of the "score" field.
+	Score *int `json:"score,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AnonymousVoteQuery when eager-loading is set.
+	Edges        AnonymousVoteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AnonymousVoteEdges holds the relations/edges for other nodes in the graph.
+type AnonymousVoteEdges struct {
+	// Poll holds the value of the poll edge.
+	Poll *Poll `json:"poll,omitempty"`
+	// Option holds the value of the option edge.
+	Option *PollOption `json:"option,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PollOrErr returns the Poll value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnonymousVoteEdges) PollOrErr() (*Poll, error) {
+	if e.Poll != nil {
+		return e.Poll, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: poll.Label}
+	}
+	return nil, &NotLoadedError{edge: "poll"}
+}
+
+// OptionOrErr returns the Option value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AnonymousVoteEdges) OptionOrErr() (*PollOption, error) {
+	if e.Option != nil {
+		return e.Option, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: polloption.Label}
+	}
+	return nil, &NotLoadedError{edge: "option"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnonymousVote) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case anonymousvote.FieldPollID, anonymousvote.FieldOptionID, anonymousvote.FieldRank, anonymousvote.FieldScore:
+			values[i] = new(sql.NullInt64)
+		case anonymousvote.FieldID, anonymousvote.FieldBallotKey:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnonymousVote fields.
+func (_m *AnonymousVote) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case anonymousvote.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case anonymousvote.FieldBallotKey:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field ballot_key", values[i])
+			} else if value != nil {
+				_m.BallotKey = *value
+			}
+		case anonymousvote.FieldPollID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field poll_id", values[i])
+			} else if value.Valid {
+				_m.PollID = int(value.Int64)
+			}
+		case anonymousvote.FieldOptionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field option_id", values[i])
+			} else if value.Valid {
+				_m.OptionID = int(value.Int64)
+			}
+		case anonymousvote.FieldRank:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				_m.Rank = new(int)
+				*_m.Rank = int(value.Int64)
+			}
+		case anonymousvote.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = new(int)
+				*_m.Score = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnonymousVote.
+// This includes values selected through modifiers, order, etc.
+func (_m *AnonymousVote) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPoll queries the "poll" edge of the AnonymousVote entity.
+func (_m *AnonymousVote) QueryPoll() *PollQuery {
+	return NewAnonymousVoteClient(_m.config).QueryPoll(_m)
+}
+
+// QueryOption queries the "option" edge of the AnonymousVote entity.
+func (_m *AnonymousVote) QueryOption() *PollOptionQuery {
+	return NewAnonymousVoteClient(_m.config).QueryOption(_m)
+}
+
+// Update returns a builder for updating this AnonymousVote.
+// Note that you need to call AnonymousVote.Unwrap() before calling this method if this AnonymousVote
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AnonymousVote) Update() *AnonymousVoteUpdateOne {
+	return NewAnonymousVoteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AnonymousVote entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AnonymousVote) Unwrap() *AnonymousVote {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AnonymousVote is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AnonymousVote) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnonymousVote(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("ballot_key=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BallotKey))
+	builder.WriteString(", ")
+	builder.WriteString("poll_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PollID))
+	builder.WriteString(", ")
+	builder.WriteString("option_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OptionID))
+	builder.WriteString(", ")
+	if v := _m.Rank; v != nil {
+		builder.WriteString("rank=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Score; v != nil {
+		builder.WriteString("score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AnonymousVotes is a parsable slice of AnonymousVote.
+type AnonymousVotes []*AnonymousVote
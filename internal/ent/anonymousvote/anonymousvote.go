@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package anonymousvote
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the anonymousvote type in the database.
+	Label = "anonymous_vote"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBallotKey holds the string denoting the ballot_key field in the database.
+	FieldBallotKey = "ballot_key"
+	// FieldPollID holds the string denoting the poll_id field in the database.
+	FieldPollID = "poll_id"
+	// FieldOptionID holds the string denoting the option_id field in the database.
+	FieldOptionID = "option_id"
+	// FieldRank holds the string denoting the rank field in the database.
+	FieldRank = "rank"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// EdgePoll holds the string denoting the poll edge name in mutations.
+	EdgePoll = "poll"
+	// EdgeOption holds the string denoting the option edge name in mutations.
+	EdgeOption = "option"
+	// Table holds the table name of the anonymousvote in the database.
+	Table = "anonymous_votes"
+	// PollTable is the table that holds the poll relation/edge.
+	PollTable = "anonymous_votes"
+	// PollInverseTable is the table name for the Poll entity.
+	// It exists in this package in order to avoid circular dependency with the "poll" package.
+	PollInverseTable = "polls"
+	// PollColumn is the table column denoting the poll relation/edge.
+	PollColumn = "poll_id"
+	// OptionTable is the table that holds the option relation/edge.
+	OptionTable = "anonymous_votes"
+	// OptionInverseTable is the table name for the PollOption entity.
+	// It exists in this package in order to avoid circular dependency with the "polloption" package.
+	OptionInverseTable = "poll_options"
+	// OptionColumn is the table column denoting the option relation/edge.
+	OptionColumn = "option_id"
+)
+
+// Columns holds all SQL columns for anonymousvote fields.
+var Columns = []string{
+	FieldID,
+	FieldBallotKey,
+	FieldPollID,
+	FieldOptionID,
+	FieldRank,
+	FieldScore,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	RankValidator func(int) error
+	// ScoreValidator is a validator for the "score" field. It is called by the builders before save.
+	ScoreValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the AnonymousVote queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBallotKey orders the results by the ballot_key field.
+func ByBallotKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBallotKey, opts...).ToFunc()
+}
+
+// ByPollID orders the results by the poll_id field.
+func ByPollID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPollID, opts...).ToFunc()
+}
+
+// ByOptionID orders the results by the option_id field.
+func ByOptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOptionID, opts...).ToFunc()
+}
+
+// ByRank orders the results by the rank field.
+func ByRank(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRank, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByPollField orders the results by poll field.
+func ByPollField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPollStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOptionField orders the results by option field.
+func ByOptionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOptionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPollStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PollInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PollTable, PollColumn),
+	)
+}
+func newOptionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OptionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OptionTable, OptionColumn),
+	)
+}
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package anonymousvote
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/ivankorhner/polling-app/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldLTE(FieldID, id))
+}
+
+// BallotKey applies equality check predicate on the "ballot_key" field. It's identical to BallotKeyEQ.
+func BallotKey(v uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldBallotKey, v))
+}
+
+// PollID applies equality check predicate on the "poll_id" field. It's identical to PollIDEQ.
+func PollID(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldPollID, v))
+}
+
+// OptionID applies equality check predicate on the "option_id" field. It's identical to OptionIDEQ.
+func OptionID(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldOptionID, v))
+}
+
+// Rank applies equality check predicate on the "rank" field. It's identical to RankEQ.
+func Rank(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldRank, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldScore, v))
+}
+
+// BallotKeyEQ applies the EQ predicate on the "ballot_key" field.
+func BallotKeyEQ(v uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldBallotKey, v))
+}
+
+// BallotKeyNEQ applies the NEQ predicate on the "ballot_key" field.
+func BallotKeyNEQ(v uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNEQ(FieldBallotKey, v))
+}
+
+// BallotKeyIn applies the In predicate on the "ballot_key" field.
+func BallotKeyIn(vs ...uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldIn(FieldBallotKey, vs...))
+}
+
+// BallotKeyNotIn applies the NotIn predicate on the "ballot_key" field.
+func BallotKeyNotIn(vs ...uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNotIn(FieldBallotKey, vs...))
+}
+
+// BallotKeyGT applies the GT predicate on the "ballot_key" field.
+func BallotKeyGT(v uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldGT(FieldBallotKey, v))
+}
+
+// BallotKeyGTE applies the GTE predicate on the "ballot_key" field.
+func BallotKeyGTE(v uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldGTE(FieldBallotKey, v))
+}
+
+// BallotKeyLT applies the LT predicate on the "ballot_key" field.
+func BallotKeyLT(v uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldLT(FieldBallotKey, v))
+}
+
+// BallotKeyLTE applies the LTE predicate on the "ballot_key" field.
+func BallotKeyLTE(v uuid.UUID) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldLTE(FieldBallotKey, v))
+}
+
+// PollIDEQ applies the EQ predicate on the "poll_id" field.
+func PollIDEQ(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldPollID, v))
+}
+
+// PollIDNEQ applies the NEQ predicate on the "poll_id" field.
+func PollIDNEQ(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNEQ(FieldPollID, v))
+}
+
+// PollIDIn applies the In predicate on the "poll_id" field.
+func PollIDIn(vs ...int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldIn(FieldPollID, vs...))
+}
+
+// PollIDNotIn applies the NotIn predicate on the "poll_id" field.
+func PollIDNotIn(vs ...int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNotIn(FieldPollID, vs...))
+}
+
+// OptionIDEQ applies the EQ predicate on the "option_id" field.
+func OptionIDEQ(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldOptionID, v))
+}
+
+// OptionIDNEQ applies the NEQ predicate on the "option_id" field.
+func OptionIDNEQ(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNEQ(FieldOptionID, v))
+}
+
+// OptionIDIn applies the In predicate on the "option_id" field.
+func OptionIDIn(vs ...int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldIn(FieldOptionID, vs...))
+}
+
+// OptionIDNotIn applies the NotIn predicate on the "option_id" field.
+func OptionIDNotIn(vs ...int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNotIn(FieldOptionID, vs...))
+}
+
+// RankEQ applies the EQ predicate on the "rank" field.
+func RankEQ(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldRank, v))
+}
+
+// RankNEQ applies the NEQ predicate on the "rank" field.
+func RankNEQ(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNEQ(FieldRank, v))
+}
+
+// RankIn applies the In predicate on the "rank" field.
+func RankIn(vs ...int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldIn(FieldRank, vs...))
+}
+
+// RankNotIn applies the NotIn predicate on the "rank" field.
+func RankNotIn(vs ...int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNotIn(FieldRank, vs...))
+}
+
+// RankGT applies the GT predicate on the "rank" field.
+func RankGT(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldGT(FieldRank, v))
+}
+
+// RankGTE applies the GTE predicate on the "rank" field.
+func RankGTE(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldGTE(FieldRank, v))
+}
+
+// RankLT applies the LT predicate on the "rank" field.
+func RankLT(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldLT(FieldRank, v))
+}
+
+// RankLTE applies the LTE predicate on the "rank" field.
+func RankLTE(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldLTE(FieldRank, v))
+}
+
+// RankIsNil applies the IsNil predicate on the "rank" field.
+func RankIsNil() predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldIsNull(FieldRank))
+}
+
+// RankNotNil applies the NotNil predicate on the "rank" field.
+func RankNotNil() predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNotNull(FieldRank))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldLTE(FieldScore, v))
+}
+
+// ScoreIsNil applies the IsNil predicate on the "score" field.
+func ScoreIsNil() predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldIsNull(FieldScore))
+}
+
+// ScoreNotNil applies the NotNil predicate on the "score" field.
+func ScoreNotNil() predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.FieldNotNull(FieldScore))
+}
+
+// HasPoll applies the HasEdge predicate on the "poll" edge.
+func HasPoll() predicate.AnonymousVote {
+	return predicate.AnonymousVote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PollTable, PollColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPollWith applies the HasEdge predicate on the "poll" edge with a given conditions (other predicates).
+func HasPollWith(preds ...predicate.Poll) predicate.AnonymousVote {
+	return predicate.AnonymousVote(func(s *sql.Selector) {
+		step := newPollStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOption applies the HasEdge predicate on the "option" edge.
+func HasOption() predicate.AnonymousVote {
+	return predicate.AnonymousVote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OptionTable, OptionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOptionWith applies the HasEdge predicate on the "option" edge with a given conditions (other predicates).
+func HasOptionWith(preds ...predicate.PollOption) predicate.AnonymousVote {
+	return predicate.AnonymousVote(func(s *sql.Selector) {
+		step := newOptionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnonymousVote) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnonymousVote) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnonymousVote) predicate.AnonymousVote {
+	return predicate.AnonymousVote(sql.NotPredicates(p))
+}
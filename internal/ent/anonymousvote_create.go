@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/polloption"
+)
+
+// AnonymousVoteCreate is the builder for creating a AnonymousVote entity.
+type AnonymousVoteCreate struct {
+	config
+	mutation *AnonymousVoteMutation
+	hooks    []Hook
+}
+
+// SetBallotKey sets the "ballot_key" field.
+func (_c *AnonymousVoteCreate) SetBallotKey(v uuid.UUID) *AnonymousVoteCreate {
+	_c.mutation.SetBallotKey(v)
+	return _c
+}
+
+// SetPollID sets the "poll_id" field.
+func (_c *AnonymousVoteCreate) SetPollID(v int) *AnonymousVoteCreate {
+	_c.mutation.SetPollID(v)
+	return _c
+}
+
+// SetOptionID sets the "option_id" field.
+func (_c *AnonymousVoteCreate) SetOptionID(v int) *AnonymousVoteCreate {
+	_c.mutation.SetOptionID(v)
+	return _c
+}
+
+// SetRank sets the "rank" field.
+func (_c *AnonymousVoteCreate) SetRank(v int) *AnonymousVoteCreate {
+	_c.mutation.SetRank(v)
+	return _c
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_c *AnonymousVoteCreate) SetNillableRank(v *int) *AnonymousVoteCreate {
+	if v != nil {
+		_c.SetRank(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *AnonymousVoteCreate) SetScore(v int) *AnonymousVoteCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *AnonymousVoteCreate) SetNillableScore(v *int) *AnonymousVoteCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AnonymousVoteCreate) SetID(v uuid.UUID) *AnonymousVoteCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AnonymousVoteCreate) SetNillableID(v *uuid.UUID) *AnonymousVoteCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPoll sets the "poll" edge to the Poll entity.
+func (_c *AnonymousVoteCreate) SetPoll(v *Poll) *AnonymousVoteCreate {
+	return _c.SetPollID(v.ID)
+}
+
+// SetOption sets the "option" edge to the PollOption entity.
+func (_c *AnonymousVoteCreate) SetOption(v *PollOption) *AnonymousVoteCreate {
+	return _c.SetOptionID(v.ID)
+}
+
+// Mutation returns the AnonymousVoteMutation object of the builder.
+func (_c *AnonymousVoteCreate) Mutation() *AnonymousVoteMutation {
+	return _c.mutation
+}
+
+// Save creates the AnonymousVote in the database.
+func (_c *AnonymousVoteCreate) Save(ctx context.Context) (*AnonymousVote, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AnonymousVoteCreate) SaveX(ctx context.Context) *AnonymousVote {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnonymousVoteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnonymousVoteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AnonymousVoteCreate) defaults() {
+	if _, ok := _c.mutation.ID(); !ok {
+		v := anonymousvote.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AnonymousVoteCreate) check() error {
+	if _, ok := _c.mutation.BallotKey(); !ok {
+		return &ValidationError{Name: "ballot_key", err: errors.New(`ent: missing required field "AnonymousVote.ballot_key"`)}
+	}
+	if _, ok := _c.mutation.PollID(); !ok {
+		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "AnonymousVote.poll_id"`)}
+	}
+	if _, ok := _c.mutation.OptionID(); !ok {
+		return &ValidationError{Name: "option_id", err: errors.New(`ent: missing required field "AnonymousVote.option_id"`)}
+	}
+	if v, ok := _c.mutation.Rank(); ok {
+		if err := anonymousvote.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "AnonymousVote.rank": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Score(); ok {
+		if err := anonymousvote.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "AnonymousVote.score": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PollIDs()) == 0 {
+		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "AnonymousVote.poll"`)}
+	}
+	if len(_c.mutation.OptionIDs()) == 0 {
+		return &ValidationError{Name: "option", err: errors.New(`ent: missing required edge "AnonymousVote.option"`)}
+	}
+	return nil
+}
+
+func (_c *AnonymousVoteCreate) sqlSave(ctx context.Context) (*AnonymousVote, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AnonymousVoteCreate) createSpec() (*AnonymousVote, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnonymousVote{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(anonymousvote.Table, sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.BallotKey(); ok {
+		_spec.SetField(anonymousvote.FieldBallotKey, field.TypeUUID, value)
+		_node.BallotKey = value
+	}
+	if value, ok := _c.mutation.Rank(); ok {
+		_spec.SetField(anonymousvote.FieldRank, field.TypeInt, value)
+		_node.Rank = &value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(anonymousvote.FieldScore, field.TypeInt, value)
+		_node.Score = &value
+	}
+	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   anonymousvote.PollTable,
+			Columns: []string{anonymousvote.PollColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(poll.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PollID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   anonymousvote.OptionTable,
+			Columns: []string{anonymousvote.OptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OptionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AnonymousVoteCreateBulk is the builder for creating many AnonymousVote entities in bulk.
+type AnonymousVoteCreateBulk struct {
+	config
+	err      error
+	builders []*AnonymousVoteCreate
+}
+
+// Save creates the AnonymousVote entities in the database.
+func (_c *AnonymousVoteCreateBulk) Save(ctx context.Context) ([]*AnonymousVote, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AnonymousVote, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnonymousVoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AnonymousVoteCreateBulk) SaveX(ctx context.Context) []*AnonymousVote {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AnonymousVoteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AnonymousVoteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/predicate"
+)
+
+// AnonymousVoteDelete is the builder for deleting a AnonymousVote entity.
+type AnonymousVoteDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnonymousVoteMutation
+}
+
+// Where appends a list predicates to the AnonymousVoteDelete builder.
+func (_d *AnonymousVoteDelete) Where(ps ...predicate.AnonymousVote) *AnonymousVoteDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *AnonymousVoteDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnonymousVoteDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *AnonymousVoteDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(anonymousvote.Table, sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// AnonymousVoteDeleteOne is the builder for deleting a single AnonymousVote entity.
+type AnonymousVoteDeleteOne struct {
+	_d *AnonymousVoteDelete
+}
+
+// Where appends a list predicates to the AnonymousVoteDelete builder.
+func (_d *AnonymousVoteDeleteOne) Where(ps ...predicate.AnonymousVote) *AnonymousVoteDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *AnonymousVoteDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{anonymousvote.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *AnonymousVoteDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
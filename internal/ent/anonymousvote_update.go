@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/polloption"
+	"github.com/ivankorhner/polling-app/internal/ent/predicate"
+)
+
+// AnonymousVoteUpdate is the builder for updating AnonymousVote entities.
+type AnonymousVoteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AnonymousVoteMutation
+}
+
+// Where appends a list predicates to the AnonymousVoteUpdate builder.
+func (_u *AnonymousVoteUpdate) Where(ps ...predicate.AnonymousVote) *AnonymousVoteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOptionID sets the "option_id" field.
+func (_u *AnonymousVoteUpdate) SetOptionID(v int) *AnonymousVoteUpdate {
+	_u.mutation.SetOptionID(v)
+	return _u
+}
+
+// SetNillableOptionID sets the "option_id" field if the given value is not nil.
+func (_u *AnonymousVoteUpdate) SetNillableOptionID(v *int) *AnonymousVoteUpdate {
+	if v != nil {
+		_u.SetOptionID(*v)
+	}
+	return _u
+}
+
+// SetRank sets the "rank" field.
+func (_u *AnonymousVoteUpdate) SetRank(v int) *AnonymousVoteUpdate {
+	_u.mutation.ResetRank()
+	_u.mutation.SetRank(v)
+	return _u
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_u *AnonymousVoteUpdate) SetNillableRank(v *int) *AnonymousVoteUpdate {
+	if v != nil {
+		_u.SetRank(*v)
+	}
+	return _u
+}
+
+// AddRank adds value to the "rank" field.
+func (_u *AnonymousVoteUpdate) AddRank(v int) *AnonymousVoteUpdate {
+	_u.mutation.AddRank(v)
+	return _u
+}
+
+// ClearRank clears the value of the "rank" field.
+func (_u *AnonymousVoteUpdate) ClearRank() *AnonymousVoteUpdate {
+	_u.mutation.ClearRank()
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *AnonymousVoteUpdate) SetScore(v int) *AnonymousVoteUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *AnonymousVoteUpdate) SetNillableScore(v *int) *AnonymousVoteUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *AnonymousVoteUpdate) AddScore(v int) *AnonymousVoteUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// ClearScore clears the value of the "score" field.
+func (_u *AnonymousVoteUpdate) ClearScore() *AnonymousVoteUpdate {
+	_u.mutation.ClearScore()
+	return _u
+}
+
+// SetOption sets the "option" edge to the PollOption entity.
+func (_u *AnonymousVoteUpdate) SetOption(v *PollOption) *AnonymousVoteUpdate {
+	return _u.SetOptionID(v.ID)
+}
+
+// Mutation returns the AnonymousVoteMutation object of the builder.
+func (_u *AnonymousVoteUpdate) Mutation() *AnonymousVoteMutation {
+	return _u.mutation
+}
+
+// ClearOption clears the "option" edge to the PollOption entity.
+func (_u *AnonymousVoteUpdate) ClearOption() *AnonymousVoteUpdate {
+	_u.mutation.ClearOption()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AnonymousVoteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnonymousVoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AnonymousVoteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnonymousVoteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnonymousVoteUpdate) check() error {
+	if v, ok := _u.mutation.Rank(); ok {
+		if err := anonymousvote.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "AnonymousVote.rank": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Score(); ok {
+		if err := anonymousvote.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "AnonymousVote.score": %w`, err)}
+		}
+	}
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnonymousVote.poll"`)
+	}
+	if _u.mutation.OptionCleared() && len(_u.mutation.OptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnonymousVote.option"`)
+	}
+	return nil
+}
+
+func (_u *AnonymousVoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(anonymousvote.Table, anonymousvote.Columns, sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rank(); ok {
+		_spec.SetField(anonymousvote.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRank(); ok {
+		_spec.AddField(anonymousvote.FieldRank, field.TypeInt, value)
+	}
+	if _u.mutation.RankCleared() {
+		_spec.ClearField(anonymousvote.FieldRank, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(anonymousvote.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(anonymousvote.FieldScore, field.TypeInt, value)
+	}
+	if _u.mutation.ScoreCleared() {
+		_spec.ClearField(anonymousvote.FieldScore, field.TypeInt)
+	}
+	if _u.mutation.OptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   anonymousvote.OptionTable,
+			Columns: []string{anonymousvote.OptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   anonymousvote.OptionTable,
+			Columns: []string{anonymousvote.OptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{anonymousvote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AnonymousVoteUpdateOne is the builder for updating a single AnonymousVote entity.
+type AnonymousVoteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AnonymousVoteMutation
+}
+
+// SetOptionID sets the "option_id" field.
+func (_u *AnonymousVoteUpdateOne) SetOptionID(v int) *AnonymousVoteUpdateOne {
+	_u.mutation.SetOptionID(v)
+	return _u
+}
+
+// SetNillableOptionID sets the "option_id" field if the given value is not nil.
+func (_u *AnonymousVoteUpdateOne) SetNillableOptionID(v *int) *AnonymousVoteUpdateOne {
+	if v != nil {
+		_u.SetOptionID(*v)
+	}
+	return _u
+}
+
+// SetRank sets the "rank" field.
+func (_u *AnonymousVoteUpdateOne) SetRank(v int) *AnonymousVoteUpdateOne {
+	_u.mutation.ResetRank()
+	_u.mutation.SetRank(v)
+	return _u
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_u *AnonymousVoteUpdateOne) SetNillableRank(v *int) *AnonymousVoteUpdateOne {
+	if v != nil {
+		_u.SetRank(*v)
+	}
+	return _u
+}
+
+// AddRank adds value to the "rank" field.
+func (_u *AnonymousVoteUpdateOne) AddRank(v int) *AnonymousVoteUpdateOne {
+	_u.mutation.AddRank(v)
+	return _u
+}
+
+// ClearRank clears the value of the "rank" field.
+func (_u *AnonymousVoteUpdateOne) ClearRank() *AnonymousVoteUpdateOne {
+	_u.mutation.ClearRank()
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *AnonymousVoteUpdateOne) SetScore(v int) *AnonymousVoteUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *AnonymousVoteUpdateOne) SetNillableScore(v *int) *AnonymousVoteUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *AnonymousVoteUpdateOne) AddScore(v int) *AnonymousVoteUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// ClearScore clears the value of the "score" field.
+func (_u *AnonymousVoteUpdateOne) ClearScore() *AnonymousVoteUpdateOne {
+	_u.mutation.ClearScore()
+	return _u
+}
+
+// SetOption sets the "option" edge to the PollOption entity.
+func (_u *AnonymousVoteUpdateOne) SetOption(v *PollOption) *AnonymousVoteUpdateOne {
+	return _u.SetOptionID(v.ID)
+}
+
+// Mutation returns the AnonymousVoteMutation object of the builder.
+func (_u *AnonymousVoteUpdateOne) Mutation() *AnonymousVoteMutation {
+	return _u.mutation
+}
+
+// ClearOption clears the "option" edge to the PollOption entity.
+func (_u *AnonymousVoteUpdateOne) ClearOption() *AnonymousVoteUpdateOne {
+	_u.mutation.ClearOption()
+	return _u
+}
+
+// Where appends a list predicates to the AnonymousVoteUpdate builder.
+func (_u *AnonymousVoteUpdateOne) Where(ps ...predicate.AnonymousVote) *AnonymousVoteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AnonymousVoteUpdateOne) Select(field string, fields ...string) *AnonymousVoteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AnonymousVote entity.
+func (_u *AnonymousVoteUpdateOne) Save(ctx context.Context) (*AnonymousVote, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AnonymousVoteUpdateOne) SaveX(ctx context.Context) *AnonymousVote {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AnonymousVoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AnonymousVoteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AnonymousVoteUpdateOne) check() error {
+	if v, ok := _u.mutation.Rank(); ok {
+		if err := anonymousvote.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "AnonymousVote.rank": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Score(); ok {
+		if err := anonymousvote.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "AnonymousVote.score": %w`, err)}
+		}
+	}
+	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnonymousVote.poll"`)
+	}
+	if _u.mutation.OptionCleared() && len(_u.mutation.OptionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AnonymousVote.option"`)
+	}
+	return nil
+}
+
+func (_u *AnonymousVoteUpdateOne) sqlSave(ctx context.Context) (_node *AnonymousVote, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(anonymousvote.Table, anonymousvote.Columns, sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AnonymousVote.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, anonymousvote.FieldID)
+		for _, f := range fields {
+			if !anonymousvote.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != anonymousvote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Rank(); ok {
+		_spec.SetField(anonymousvote.FieldRank, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRank(); ok {
+		_spec.AddField(anonymousvote.FieldRank, field.TypeInt, value)
+	}
+	if _u.mutation.RankCleared() {
+		_spec.ClearField(anonymousvote.FieldRank, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(anonymousvote.FieldScore, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(anonymousvote.FieldScore, field.TypeInt, value)
+	}
+	if _u.mutation.ScoreCleared() {
+		_spec.ClearField(anonymousvote.FieldScore, field.TypeInt)
+	}
+	if _u.mutation.OptionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   anonymousvote.OptionTable,
+			Columns: []string{anonymousvote.OptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OptionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   anonymousvote.OptionTable,
+			Columns: []string{anonymousvote.OptionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(polloption.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AnonymousVote{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{anonymousvote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,10 +12,23 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/apitoken"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/idempotencykey"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	"github.com/ivankorhner/polling-app/internal/ent/organization"
+	"github.com/ivankorhner/polling-app/internal/ent/organizationinvitation"
+	"github.com/ivankorhner/polling-app/internal/ent/participation"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/pollinvitee"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/user"
 	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
+	"github.com/ivankorhner/polling-app/internal/ent/webhook"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookattempt"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -76,10 +89,23 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			poll.Table:       poll.ValidColumn,
-			polloption.Table: polloption.ValidColumn,
-			user.Table:       user.ValidColumn,
-			vote.Table:       vote.ValidColumn,
+			apitoken.Table:               apitoken.ValidColumn,
+			anonymousvote.Table:          anonymousvote.ValidColumn,
+			ballot.Table:                 ballot.ValidColumn,
+			idempotencykey.Table:         idempotencykey.ValidColumn,
+			membership.Table:             membership.ValidColumn,
+			organization.Table:           organization.ValidColumn,
+			organizationinvitation.Table: organizationinvitation.ValidColumn,
+			participation.Table:          participation.ValidColumn,
+			poll.Table:                   poll.ValidColumn,
+			pollinvitee.Table:            pollinvitee.ValidColumn,
+			polloption.Table:             polloption.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			vote.Table:                   vote.ValidColumn,
+			voteevent.Table:              voteevent.ValidColumn,
+			webhook.Table:                webhook.ValidColumn,
+			webhookattempt.Table:         webhookattempt.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
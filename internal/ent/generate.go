@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ../ent/schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/lock ../ent/schema
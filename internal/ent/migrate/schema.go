@@ -3,16 +3,321 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// APITokensColumns holds the columns for the "api_tokens" table.
+	APITokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// APITokensTable holds the schema information for the "api_tokens" table.
+	APITokensTable = &schema.Table{
+		Name:       "api_tokens",
+		Columns:    APITokensColumns,
+		PrimaryKey: []*schema.Column{APITokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_tokens_users_api_tokens",
+				Columns:    []*schema.Column{APITokensColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apitoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{APITokensColumns[7]},
+			},
+		},
+	}
+	// AnonymousVotesColumns holds the columns for the "anonymous_votes" table.
+	AnonymousVotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "ballot_key", Type: field.TypeUUID},
+		{Name: "rank", Type: field.TypeInt, Nullable: true},
+		{Name: "score", Type: field.TypeInt, Nullable: true},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "option_id", Type: field.TypeInt},
+	}
+	// AnonymousVotesTable holds the schema information for the "anonymous_votes" table.
+	AnonymousVotesTable = &schema.Table{
+		Name:       "anonymous_votes",
+		Columns:    AnonymousVotesColumns,
+		PrimaryKey: []*schema.Column{AnonymousVotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "anonymous_votes_polls_anonymous_votes",
+				Columns:    []*schema.Column{AnonymousVotesColumns[4]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "anonymous_votes_poll_options_anonymous_votes",
+				Columns:    []*schema.Column{AnonymousVotesColumns[5]},
+				RefColumns: []*schema.Column{PollOptionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "anonymousvote_ballot_key_option_id",
+				Unique:  true,
+				Columns: []*schema.Column{AnonymousVotesColumns[1], AnonymousVotesColumns[5]},
+			},
+			{
+				Name:    "anonymousvote_poll_id",
+				Unique:  false,
+				Columns: []*schema.Column{AnonymousVotesColumns[4]},
+			},
+			{
+				Name:    "anonymousvote_option_id",
+				Unique:  false,
+				Columns: []*schema.Column{AnonymousVotesColumns[5]},
+			},
+		},
+	}
+	// BallotsColumns holds the columns for the "ballots" table.
+	BallotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// BallotsTable holds the schema information for the "ballots" table.
+	BallotsTable = &schema.Table{
+		Name:       "ballots",
+		Columns:    BallotsColumns,
+		PrimaryKey: []*schema.Column{BallotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ballots_polls_ballots",
+				Columns:    []*schema.Column{BallotsColumns[2]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "ballots_users_ballots",
+				Columns:    []*schema.Column{BallotsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ballot_user_id_poll_id",
+				Unique:  true,
+				Columns: []*schema.Column{BallotsColumns[3], BallotsColumns[2]},
+			},
+			{
+				Name:    "ballot_poll_id",
+				Unique:  false,
+				Columns: []*schema.Column{BallotsColumns[2]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "content_type", Type: field.TypeString, Nullable: true},
+		{Name: "body", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "idempotency_keys_users_idempotency_keys",
+				Columns:    []*schema.Column{IdempotencyKeysColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_key_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[8]},
+			},
+			{
+				Name:    "idempotencykey_key_anonymous",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "user_id IS NULL",
+				},
+			},
+			{
+				Name:    "idempotencykey_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[7]},
+			},
+		},
+	}
+	// MembershipsColumns holds the columns for the "memberships" table.
+	MembershipsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member"}, Default: "member"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// MembershipsTable holds the schema information for the "memberships" table.
+	MembershipsTable = &schema.Table{
+		Name:       "memberships",
+		Columns:    MembershipsColumns,
+		PrimaryKey: []*schema.Column{MembershipsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "memberships_organizations_memberships",
+				Columns:    []*schema.Column{MembershipsColumns[3]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "memberships_users_memberships",
+				Columns:    []*schema.Column{MembershipsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "membership_organization_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MembershipsColumns[3], MembershipsColumns[4]},
+			},
+			{
+				Name:    "membership_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{MembershipsColumns[4]},
+			},
+		},
+	}
+	// OrganizationsColumns holds the columns for the "organizations" table.
+	OrganizationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OrganizationsTable holds the schema information for the "organizations" table.
+	OrganizationsTable = &schema.Table{
+		Name:       "organizations",
+		Columns:    OrganizationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationsColumns[0]},
+	}
+	// OrganizationInvitationsColumns holds the columns for the "organization_invitations" table.
+	OrganizationInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "admin", "member"}, Default: "member"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "accepted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeInt},
+	}
+	// OrganizationInvitationsTable holds the schema information for the "organization_invitations" table.
+	OrganizationInvitationsTable = &schema.Table{
+		Name:       "organization_invitations",
+		Columns:    OrganizationInvitationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "organization_invitations_organizations_invitations",
+				Columns:    []*schema.Column{OrganizationInvitationsColumns[6]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organizationinvitation_organization_id_email",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationInvitationsColumns[6], OrganizationInvitationsColumns[1]},
+			},
+			{
+				Name:    "organizationinvitation_email",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationInvitationsColumns[1]},
+			},
+		},
+	}
+	// ParticipationsColumns holds the columns for the "participations" table.
+	ParticipationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ParticipationsTable holds the schema information for the "participations" table.
+	ParticipationsTable = &schema.Table{
+		Name:       "participations",
+		Columns:    ParticipationsColumns,
+		PrimaryKey: []*schema.Column{ParticipationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "participations_polls_participations",
+				Columns:    []*schema.Column{ParticipationsColumns[2]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "participations_users_participations",
+				Columns:    []*schema.Column{ParticipationsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "participation_user_id_poll_id",
+				Unique:  true,
+				Columns: []*schema.Column{ParticipationsColumns[3], ParticipationsColumns[2]},
+			},
+			{
+				Name:    "participation_poll_id",
+				Unique:  false,
+				Columns: []*schema.Column{ParticipationsColumns[2]},
+			},
+		},
+	}
 	// PollsColumns holds the columns for the "polls" table.
 	PollsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "title", Type: field.TypeString},
+		{Name: "voting_method", Type: field.TypeEnum, Enums: []string{"plurality", "approval", "borda", "instant_runoff", "schulze", "score"}, Default: "plurality"},
+		{Name: "min_selections", Type: field.TypeInt, Default: 1},
+		{Name: "max_selections", Type: field.TypeInt, Default: 1},
+		{Name: "draft", Type: field.TypeBool, Default: false},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"public", "unlisted", "private"}, Default: "public"},
+		{Name: "share_slug", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "results_visibility", Type: field.TypeEnum, Enums: []string{"always", "after_vote", "after_close", "owner_only"}, Default: "always"},
+		{Name: "anonymous", Type: field.TypeBool, Default: false},
+		{Name: "opens_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closes_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeInt, Nullable: true},
 		{Name: "owner_id", Type: field.TypeInt},
 	}
 	// PollsTable holds the schema information for the "polls" table.
@@ -21,13 +326,75 @@ var (
 		Columns:    PollsColumns,
 		PrimaryKey: []*schema.Column{PollsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "polls_organizations_polls",
+				Columns:    []*schema.Column{PollsColumns[16]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
 			{
 				Symbol:     "polls_users_polls",
-				Columns:    []*schema.Column{PollsColumns[3]},
+				Columns:    []*schema.Column{PollsColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "poll_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{PollsColumns[13], PollsColumns[0]},
+			},
+			{
+				Name:    "poll_closes_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{PollsColumns[11], PollsColumns[0]},
+			},
+			{
+				Name:    "poll_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{PollsColumns[17]},
+			},
+			{
+				Name:    "poll_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{PollsColumns[16]},
+			},
+		},
+	}
+	// PollInviteesColumns holds the columns for the "poll_invitees" table.
+	PollInviteesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PollInviteesTable holds the schema information for the "poll_invitees" table.
+	PollInviteesTable = &schema.Table{
+		Name:       "poll_invitees",
+		Columns:    PollInviteesColumns,
+		PrimaryKey: []*schema.Column{PollInviteesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_invitees_polls_invitees",
+				Columns:    []*schema.Column{PollInviteesColumns[2]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "poll_invitees_users_poll_invitations",
+				Columns:    []*schema.Column{PollInviteesColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pollinvitee_poll_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{PollInviteesColumns[2], PollInviteesColumns[3]},
+			},
+		},
 	}
 	// PollOptionsColumns holds the columns for the "poll_options" table.
 	PollOptionsColumns = []*schema.Column{
@@ -55,7 +422,9 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "username", Type: field.TypeString, Unique: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"user", "admin"}, Default: "user"},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -66,7 +435,10 @@ var (
 	// VotesColumns holds the columns for the "votes" table.
 	VotesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "rank", Type: field.TypeInt, Nullable: true},
+		{Name: "score", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "ballot_id", Type: field.TypeInt},
 		{Name: "poll_id", Type: field.TypeInt},
 		{Name: "option_id", Type: field.TypeInt},
 		{Name: "user_id", Type: field.TypeInt},
@@ -77,46 +449,230 @@ var (
 		Columns:    VotesColumns,
 		PrimaryKey: []*schema.Column{VotesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "votes_ballots_votes",
+				Columns:    []*schema.Column{VotesColumns[4]},
+				RefColumns: []*schema.Column{BallotsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 			{
 				Symbol:     "votes_polls_votes",
-				Columns:    []*schema.Column{VotesColumns[2]},
+				Columns:    []*schema.Column{VotesColumns[5]},
 				RefColumns: []*schema.Column{PollsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "votes_poll_options_votes",
-				Columns:    []*schema.Column{VotesColumns[3]},
+				Columns:    []*schema.Column{VotesColumns[6]},
 				RefColumns: []*schema.Column{PollOptionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "votes_users_votes",
-				Columns:    []*schema.Column{VotesColumns[4]},
+				Columns:    []*schema.Column{VotesColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "vote_user_id_poll_id",
+				Name:    "vote_ballot_id_option_id",
 				Unique:  true,
-				Columns: []*schema.Column{VotesColumns[4], VotesColumns[2]},
+				Columns: []*schema.Column{VotesColumns[4], VotesColumns[6]},
+			},
+			{
+				Name:    "vote_poll_id_option_id",
+				Unique:  false,
+				Columns: []*schema.Column{VotesColumns[5], VotesColumns[6]},
+			},
+		},
+	}
+	// VoteEventsColumns holds the columns for the "vote_events" table.
+	VoteEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"cast", "change", "retract"}},
+		{Name: "option_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// VoteEventsTable holds the schema information for the "vote_events" table.
+	VoteEventsTable = &schema.Table{
+		Name:       "vote_events",
+		Columns:    VoteEventsColumns,
+		PrimaryKey: []*schema.Column{VoteEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "vote_events_polls_vote_events",
+				Columns:    []*schema.Column{VoteEventsColumns[4]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "vote_events_users_vote_events",
+				Columns:    []*schema.Column{VoteEventsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "voteevent_poll_id",
+				Unique:  false,
+				Columns: []*schema.Column{VoteEventsColumns[4]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "poll_id", Type: field.TypeInt, Nullable: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_users_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[6]},
+			},
+			{
+				Name:    "webhook_poll_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhooksColumns[1]},
+			},
+		},
+	}
+	// WebhookAttemptsColumns holds the columns for the "webhook_attempts" table.
+	WebhookAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "delivery_id", Type: field.TypeInt},
+	}
+	// WebhookAttemptsTable holds the schema information for the "webhook_attempts" table.
+	WebhookAttemptsTable = &schema.Table{
+		Name:       "webhook_attempts",
+		Columns:    WebhookAttemptsColumns,
+		PrimaryKey: []*schema.Column{WebhookAttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_attempts_webhook_deliveries_attempts",
+				Columns:    []*schema.Column{WebhookAttemptsColumns[5]},
+				RefColumns: []*schema.Column{WebhookDeliveriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookattempt_delivery_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookAttemptsColumns[5]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempt_count", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "webhook_id", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[8]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_webhook_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[8]},
+			},
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[3], WebhookDeliveriesColumns[5]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APITokensTable,
+		AnonymousVotesTable,
+		BallotsTable,
+		IdempotencyKeysTable,
+		MembershipsTable,
+		OrganizationsTable,
+		OrganizationInvitationsTable,
+		ParticipationsTable,
 		PollsTable,
+		PollInviteesTable,
 		PollOptionsTable,
 		UsersTable,
 		VotesTable,
+		VoteEventsTable,
+		WebhooksTable,
+		WebhookAttemptsTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
-	PollsTable.ForeignKeys[0].RefTable = UsersTable
+	APITokensTable.ForeignKeys[0].RefTable = UsersTable
+	AnonymousVotesTable.ForeignKeys[0].RefTable = PollsTable
+	AnonymousVotesTable.ForeignKeys[1].RefTable = PollOptionsTable
+	BallotsTable.ForeignKeys[0].RefTable = PollsTable
+	BallotsTable.ForeignKeys[1].RefTable = UsersTable
+	IdempotencyKeysTable.ForeignKeys[0].RefTable = UsersTable
+	MembershipsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	MembershipsTable.ForeignKeys[1].RefTable = UsersTable
+	OrganizationInvitationsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	ParticipationsTable.ForeignKeys[0].RefTable = PollsTable
+	ParticipationsTable.ForeignKeys[1].RefTable = UsersTable
+	PollsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	PollsTable.ForeignKeys[1].RefTable = UsersTable
+	PollInviteesTable.ForeignKeys[0].RefTable = PollsTable
+	PollInviteesTable.ForeignKeys[1].RefTable = UsersTable
 	PollOptionsTable.ForeignKeys[0].RefTable = PollsTable
-	VotesTable.ForeignKeys[0].RefTable = PollsTable
-	VotesTable.ForeignKeys[1].RefTable = PollOptionsTable
-	VotesTable.ForeignKeys[2].RefTable = UsersTable
+	VotesTable.ForeignKeys[0].RefTable = BallotsTable
+	VotesTable.ForeignKeys[1].RefTable = PollsTable
+	VotesTable.ForeignKeys[2].RefTable = PollOptionsTable
+	VotesTable.ForeignKeys[3].RefTable = UsersTable
+	VoteEventsTable.ForeignKeys[0].RefTable = PollsTable
+	VoteEventsTable.ForeignKeys[1].RefTable = UsersTable
+	WebhooksTable.ForeignKeys[0].RefTable = UsersTable
+	WebhookAttemptsTable.ForeignKeys[0].RefTable = WebhookDeliveriesTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 }
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ivankorhner/polling-app/internal/ent/organization"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
 	"github.com/ivankorhner/polling-app/internal/ent/user"
 )
@@ -20,10 +21,38 @@ type Poll struct {
 	ID int `json:"id,omitempty"`
 	// OwnerID holds the value of the "owner_id" field.
 	OwnerID int `json:"owner_id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID *int `json:"organization_id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
+	// VotingMethod holds the value of the "voting_method" field.
+	VotingMethod poll.VotingMethod `json:"voting_method,omitempty"`
+	// MinSelections holds the value of the "min_selections" field.
+	MinSelections int `json:"min_selections,omitempty"`
+	// MaxSelections holds the value of the "max_selections" field.
+	MaxSelections int `json:"max_selections,omitempty"`
+	// Draft holds the value of the "draft" field.
+	Draft bool `json:"draft,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility poll.Visibility `json:"visibility,omitempty"`
+	// ShareSlug holds the value of the "share_slug" field.
+	ShareSlug *string `json:"share_slug,omitempty"`
+	// ResultsVisibility holds the value of the "results_visibility" field.
+	ResultsVisibility poll.ResultsVisibility `json:"results_visibility,omitempty"`
+	// Anonymous holds the value of the "anonymous" field.
+	Anonymous bool `json:"anonymous,omitempty"`
+	// OpensAt holds the value of the "opens_at" field.
+	OpensAt *time.Time `json:"opens_at,omitempty"`
+	// ClosesAt holds the value of the "closes_at" field.
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PollQuery when eager-loading is set.
 	Edges        PollEdges `json:"edges"`
@@ -34,13 +63,25 @@ type Poll struct {
 type PollEdges struct {
 	// Owner holds the value of the owner edge.
 	Owner *User `json:"owner,omitempty"`
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
 	// Options holds the value of the options edge.
 	Options []*PollOption `json:"options,omitempty"`
+	// Ballots holds the value of the ballots edge.
+	Ballots []*Ballot `json:"ballots,omitempty"`
 	// Votes holds the value of the votes edge.
 	Votes []*Vote `json:"votes,omitempty"`
+	// VoteEvents holds the value of the vote_events edge.
+	VoteEvents []*VoteEvent `json:"vote_events,omitempty"`
+	// Participations holds the value of the participations edge.
+	Participations []*Participation `json:"participations,omitempty"`
+	// AnonymousVotes holds the value of the anonymous_votes edge.
+	AnonymousVotes []*AnonymousVote `json:"anonymous_votes,omitempty"`
+	// Invitees holds the value of the invitees edge.
+	Invitees []*PollInvitee `json:"invitees,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [9]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -54,34 +95,92 @@ func (e PollEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PollEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
 // OptionsOrErr returns the Options value or an error if the edge
 // was not loaded in eager-loading.
 func (e PollEdges) OptionsOrErr() ([]*PollOption, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Options, nil
 	}
 	return nil, &NotLoadedError{edge: "options"}
 }
 
+// BallotsOrErr returns the Ballots value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) BallotsOrErr() ([]*Ballot, error) {
+	if e.loadedTypes[3] {
+		return e.Ballots, nil
+	}
+	return nil, &NotLoadedError{edge: "ballots"}
+}
+
 // VotesOrErr returns the Votes value or an error if the edge
 // was not loaded in eager-loading.
 func (e PollEdges) VotesOrErr() ([]*Vote, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[4] {
 		return e.Votes, nil
 	}
 	return nil, &NotLoadedError{edge: "votes"}
 }
 
+// VoteEventsOrErr returns the VoteEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) VoteEventsOrErr() ([]*VoteEvent, error) {
+	if e.loadedTypes[5] {
+		return e.VoteEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "vote_events"}
+}
+
+// ParticipationsOrErr returns the Participations value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) ParticipationsOrErr() ([]*Participation, error) {
+	if e.loadedTypes[6] {
+		return e.Participations, nil
+	}
+	return nil, &NotLoadedError{edge: "participations"}
+}
+
+// AnonymousVotesOrErr returns the AnonymousVotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) AnonymousVotesOrErr() ([]*AnonymousVote, error) {
+	if e.loadedTypes[7] {
+		return e.AnonymousVotes, nil
+	}
+	return nil, &NotLoadedError{edge: "anonymous_votes"}
+}
+
+// InviteesOrErr returns the Invitees value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollEdges) InviteesOrErr() ([]*PollInvitee, error) {
+	if e.loadedTypes[8] {
+		return e.Invitees, nil
+	}
+	return nil, &NotLoadedError{edge: "invitees"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Poll) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case poll.FieldID, poll.FieldOwnerID:
+		case poll.FieldDraft, poll.FieldAnonymous:
+			values[i] = new(sql.NullBool)
+		case poll.FieldID, poll.FieldOwnerID, poll.FieldOrganizationID, poll.FieldMinSelections, poll.FieldMaxSelections, poll.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case poll.FieldTitle:
+		case poll.FieldTitle, poll.FieldVotingMethod, poll.FieldVisibility, poll.FieldShareSlug, poll.FieldResultsVisibility:
 			values[i] = new(sql.NullString)
-		case poll.FieldCreatedAt:
+		case poll.FieldOpensAt, poll.FieldClosesAt, poll.FieldClosedAt, poll.FieldCreatedAt, poll.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -110,18 +209,107 @@ func (_m *Poll) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.OwnerID = int(value.Int64)
 			}
+		case poll.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(int)
+				*_m.OrganizationID = int(value.Int64)
+			}
 		case poll.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
 			} else if value.Valid {
 				_m.Title = value.String
 			}
+		case poll.FieldVotingMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field voting_method", values[i])
+			} else if value.Valid {
+				_m.VotingMethod = poll.VotingMethod(value.String)
+			}
+		case poll.FieldMinSelections:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_selections", values[i])
+			} else if value.Valid {
+				_m.MinSelections = int(value.Int64)
+			}
+		case poll.FieldMaxSelections:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_selections", values[i])
+			} else if value.Valid {
+				_m.MaxSelections = int(value.Int64)
+			}
+		case poll.FieldDraft:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field draft", values[i])
+			} else if value.Valid {
+				_m.Draft = value.Bool
+			}
+		case poll.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = poll.Visibility(value.String)
+			}
+		case poll.FieldShareSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_slug", values[i])
+			} else if value.Valid {
+				_m.ShareSlug = new(string)
+				*_m.ShareSlug = value.String
+			}
+		case poll.FieldResultsVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field results_visibility", values[i])
+			} else if value.Valid {
+				_m.ResultsVisibility = poll.ResultsVisibility(value.String)
+			}
+		case poll.FieldAnonymous:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field anonymous", values[i])
+			} else if value.Valid {
+				_m.Anonymous = value.Bool
+			}
+		case poll.FieldOpensAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opens_at", values[i])
+			} else if value.Valid {
+				_m.OpensAt = new(time.Time)
+				*_m.OpensAt = value.Time
+			}
+		case poll.FieldClosesAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closes_at", values[i])
+			} else if value.Valid {
+				_m.ClosesAt = new(time.Time)
+				*_m.ClosesAt = value.Time
+			}
+		case poll.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
 		case poll.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case poll.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case poll.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -140,16 +328,46 @@ func (_m *Poll) QueryOwner() *UserQuery {
 	return NewPollClient(_m.config).QueryOwner(_m)
 }
 
+// QueryOrganization queries the "organization" edge of the Poll entity.
+func (_m *Poll) QueryOrganization() *OrganizationQuery {
+	return NewPollClient(_m.config).QueryOrganization(_m)
+}
+
 // QueryOptions queries the "options" edge of the Poll entity.
 func (_m *Poll) QueryOptions() *PollOptionQuery {
 	return NewPollClient(_m.config).QueryOptions(_m)
 }
 
+// QueryBallots queries the "ballots" edge of the Poll entity.
+func (_m *Poll) QueryBallots() *BallotQuery {
+	return NewPollClient(_m.config).QueryBallots(_m)
+}
+
 // QueryVotes queries the "votes" edge of the Poll entity.
 func (_m *Poll) QueryVotes() *VoteQuery {
 	return NewPollClient(_m.config).QueryVotes(_m)
 }
 
+// QueryVoteEvents queries the "vote_events" edge of the Poll entity.
+func (_m *Poll) QueryVoteEvents() *VoteEventQuery {
+	return NewPollClient(_m.config).QueryVoteEvents(_m)
+}
+
+// QueryParticipations queries the "participations" edge of the Poll entity.
+func (_m *Poll) QueryParticipations() *ParticipationQuery {
+	return NewPollClient(_m.config).QueryParticipations(_m)
+}
+
+// QueryAnonymousVotes queries the "anonymous_votes" edge of the Poll entity.
+func (_m *Poll) QueryAnonymousVotes() *AnonymousVoteQuery {
+	return NewPollClient(_m.config).QueryAnonymousVotes(_m)
+}
+
+// QueryInvitees queries the "invitees" edge of the Poll entity.
+func (_m *Poll) QueryInvitees() *PollInviteeQuery {
+	return NewPollClient(_m.config).QueryInvitees(_m)
+}
+
 // Update returns a builder for updating this Poll.
 // Note that you need to call Poll.Unwrap() before calling this method if this Poll
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -176,11 +394,63 @@ func (_m *Poll) String() string {
 	builder.WriteString("owner_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OwnerID))
 	builder.WriteString(", ")
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
+	builder.WriteString("voting_method=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VotingMethod))
+	builder.WriteString(", ")
+	builder.WriteString("min_selections=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinSelections))
+	builder.WriteString(", ")
+	builder.WriteString("max_selections=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxSelections))
+	builder.WriteString(", ")
+	builder.WriteString("draft=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Draft))
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteString(", ")
+	if v := _m.ShareSlug; v != nil {
+		builder.WriteString("share_slug=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("results_visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResultsVisibility))
+	builder.WriteString(", ")
+	builder.WriteString("anonymous=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Anonymous))
+	builder.WriteString(", ")
+	if v := _m.OpensAt; v != nil {
+		builder.WriteString("opens_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosesAt; v != nil {
+		builder.WriteString("closes_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
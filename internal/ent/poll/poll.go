@@ -3,6 +3,7 @@
 package poll
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -16,16 +17,56 @@ const (
 	FieldID = "id"
 	// FieldOwnerID holds the string denoting the owner_id field in the database.
 	FieldOwnerID = "owner_id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
+	// FieldVotingMethod holds the string denoting the voting_method field in the database.
+	FieldVotingMethod = "voting_method"
+	// FieldMinSelections holds the string denoting the min_selections field in the database.
+	FieldMinSelections = "min_selections"
+	// FieldMaxSelections holds the string denoting the max_selections field in the database.
+	FieldMaxSelections = "max_selections"
+	// FieldDraft holds the string denoting the draft field in the database.
+	FieldDraft = "draft"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldShareSlug holds the string denoting the share_slug field in the database.
+	FieldShareSlug = "share_slug"
+	// FieldResultsVisibility holds the string denoting the results_visibility field in the database.
+	FieldResultsVisibility = "results_visibility"
+	// FieldAnonymous holds the string denoting the anonymous field in the database.
+	FieldAnonymous = "anonymous"
+	// FieldOpensAt holds the string denoting the opens_at field in the database.
+	FieldOpensAt = "opens_at"
+	// FieldClosesAt holds the string denoting the closes_at field in the database.
+	FieldClosesAt = "closes_at"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
 	// EdgeOptions holds the string denoting the options edge name in mutations.
 	EdgeOptions = "options"
+	// EdgeBallots holds the string denoting the ballots edge name in mutations.
+	EdgeBallots = "ballots"
 	// EdgeVotes holds the string denoting the votes edge name in mutations.
 	EdgeVotes = "votes"
+	// EdgeVoteEvents holds the string denoting the vote_events edge name in mutations.
+	EdgeVoteEvents = "vote_events"
+	// EdgeParticipations holds the string denoting the participations edge name in mutations.
+	EdgeParticipations = "participations"
+	// EdgeAnonymousVotes holds the string denoting the anonymous_votes edge name in mutations.
+	EdgeAnonymousVotes = "anonymous_votes"
+	// EdgeInvitees holds the string denoting the invitees edge name in mutations.
+	EdgeInvitees = "invitees"
 	// Table holds the table name of the poll in the database.
 	Table = "polls"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -35,6 +76,13 @@ const (
 	OwnerInverseTable = "users"
 	// OwnerColumn is the table column denoting the owner relation/edge.
 	OwnerColumn = "owner_id"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "polls"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
 	// OptionsTable is the table that holds the options relation/edge.
 	OptionsTable = "poll_options"
 	// OptionsInverseTable is the table name for the PollOption entity.
@@ -42,6 +90,13 @@ const (
 	OptionsInverseTable = "poll_options"
 	// OptionsColumn is the table column denoting the options relation/edge.
 	OptionsColumn = "poll_id"
+	// BallotsTable is the table that holds the ballots relation/edge.
+	BallotsTable = "ballots"
+	// BallotsInverseTable is the table name for the Ballot entity.
+	// It exists in this package in order to avoid circular dependency with the "ballot" package.
+	BallotsInverseTable = "ballots"
+	// BallotsColumn is the table column denoting the ballots relation/edge.
+	BallotsColumn = "poll_id"
 	// VotesTable is the table that holds the votes relation/edge.
 	VotesTable = "votes"
 	// VotesInverseTable is the table name for the Vote entity.
@@ -49,14 +104,56 @@ const (
 	VotesInverseTable = "votes"
 	// VotesColumn is the table column denoting the votes relation/edge.
 	VotesColumn = "poll_id"
+	// VoteEventsTable is the table that holds the vote_events relation/edge.
+	VoteEventsTable = "vote_events"
+	// VoteEventsInverseTable is the table name for the VoteEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "voteevent" package.
+	VoteEventsInverseTable = "vote_events"
+	// VoteEventsColumn is the table column denoting the vote_events relation/edge.
+	VoteEventsColumn = "poll_id"
+	// ParticipationsTable is the table that holds the participations relation/edge.
+	ParticipationsTable = "participations"
+	// ParticipationsInverseTable is the table name for the Participation entity.
+	// It exists in this package in order to avoid circular dependency with the "participation" package.
+	ParticipationsInverseTable = "participations"
+	// ParticipationsColumn is the table column denoting the participations relation/edge.
+	ParticipationsColumn = "poll_id"
+	// AnonymousVotesTable is the table that holds the anonymous_votes relation/edge.
+	AnonymousVotesTable = "anonymous_votes"
+	// AnonymousVotesInverseTable is the table name for the AnonymousVote entity.
+	// It exists in this package in order to avoid circular dependency with the "anonymousvote" package.
+	AnonymousVotesInverseTable = "anonymous_votes"
+	// AnonymousVotesColumn is the table column denoting the anonymous_votes relation/edge.
+	AnonymousVotesColumn = "poll_id"
+	// InviteesTable is the table that holds the invitees relation/edge.
+	InviteesTable = "poll_invitees"
+	// InviteesInverseTable is the table name for the PollInvitee entity.
+	// It exists in this package in order to avoid circular dependency with the "pollinvitee" package.
+	InviteesInverseTable = "poll_invitees"
+	// InviteesColumn is the table column denoting the invitees relation/edge.
+	InviteesColumn = "poll_id"
 )
 
 // Columns holds all SQL columns for poll fields.
 var Columns = []string{
 	FieldID,
 	FieldOwnerID,
+	FieldOrganizationID,
 	FieldTitle,
+	FieldVotingMethod,
+	FieldMinSelections,
+	FieldMaxSelections,
+	FieldDraft,
+	FieldVisibility,
+	FieldShareSlug,
+	FieldResultsVisibility,
+	FieldAnonymous,
+	FieldOpensAt,
+	FieldClosesAt,
+	FieldClosedAt,
 	FieldCreatedAt,
+	FieldVersion,
+	FieldUpdatedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -72,10 +169,113 @@ func ValidColumn(column string) bool {
 var (
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
+	// DefaultMinSelections holds the default value on creation for the "min_selections" field.
+	DefaultMinSelections int
+	// MinSelectionsValidator is a validator for the "min_selections" field. It is called by the builders before save.
+	MinSelectionsValidator func(int) error
+	// DefaultMaxSelections holds the default value on creation for the "max_selections" field.
+	DefaultMaxSelections int
+	// MaxSelectionsValidator is a validator for the "max_selections" field. It is called by the builders before save.
+	MaxSelectionsValidator func(int) error
+	// DefaultDraft holds the default value on creation for the "draft" field.
+	DefaultDraft bool
+	// DefaultAnonymous holds the default value on creation for the "anonymous" field.
+	DefaultAnonymous bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
 )
 
+// VotingMethod defines the type for the "voting_method" enum field.
+type VotingMethod string
+
+// VotingMethodPlurality is the default value of the VotingMethod enum.
+const DefaultVotingMethod = VotingMethodPlurality
+
+// VotingMethod values.
+const (
+	VotingMethodPlurality     VotingMethod = "plurality"
+	VotingMethodApproval      VotingMethod = "approval"
+	VotingMethodBorda         VotingMethod = "borda"
+	VotingMethodInstantRunoff VotingMethod = "instant_runoff"
+	VotingMethodSchulze       VotingMethod = "schulze"
+	VotingMethodScore         VotingMethod = "score"
+)
+
+func (vm VotingMethod) String() string {
+	return string(vm)
+}
+
+// VotingMethodValidator is a validator for the "voting_method" field enum values. It is called by the builders before save.
+func VotingMethodValidator(vm VotingMethod) error {
+	switch vm {
+	case VotingMethodPlurality, VotingMethodApproval, VotingMethodBorda, VotingMethodInstantRunoff, VotingMethodSchulze, VotingMethodScore:
+		return nil
+	default:
+		return fmt.Errorf("poll: invalid enum value for voting_method field: %q", vm)
+	}
+}
+
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityPublic is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityPublic
+
+// Visibility values.
+const (
+	VisibilityPublic   Visibility = "public"
+	VisibilityUnlisted Visibility = "unlisted"
+	VisibilityPrivate  Visibility = "private"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityPublic, VisibilityUnlisted, VisibilityPrivate:
+		return nil
+	default:
+		return fmt.Errorf("poll: invalid enum value for visibility field: %q", v)
+	}
+}
+
+// ResultsVisibility defines the type for the "results_visibility" enum field.
+type ResultsVisibility string
+
+// ResultsVisibilityAlways is the default value of the ResultsVisibility enum.
+const DefaultResultsVisibility = ResultsVisibilityAlways
+
+// ResultsVisibility values.
+const (
+	ResultsVisibilityAlways     ResultsVisibility = "always"
+	ResultsVisibilityAfterVote  ResultsVisibility = "after_vote"
+	ResultsVisibilityAfterClose ResultsVisibility = "after_close"
+	ResultsVisibilityOwnerOnly  ResultsVisibility = "owner_only"
+)
+
+func (rv ResultsVisibility) String() string {
+	return string(rv)
+}
+
+// ResultsVisibilityValidator is a validator for the "results_visibility" field enum values. It is called by the builders before save.
+func ResultsVisibilityValidator(rv ResultsVisibility) error {
+	switch rv {
+	case ResultsVisibilityAlways, ResultsVisibilityAfterVote, ResultsVisibilityAfterClose, ResultsVisibilityOwnerOnly:
+		return nil
+	default:
+		return fmt.Errorf("poll: invalid enum value for results_visibility field: %q", rv)
+	}
+}
+
 // OrderOption defines the ordering options for the Poll queries.
 type OrderOption func(*sql.Selector)
 
@@ -89,16 +289,86 @@ func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
 }
 
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
 // ByTitle orders the results by the title field.
 func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
 }
 
+// ByVotingMethod orders the results by the voting_method field.
+func ByVotingMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVotingMethod, opts...).ToFunc()
+}
+
+// ByMinSelections orders the results by the min_selections field.
+func ByMinSelections(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinSelections, opts...).ToFunc()
+}
+
+// ByMaxSelections orders the results by the max_selections field.
+func ByMaxSelections(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxSelections, opts...).ToFunc()
+}
+
+// ByDraft orders the results by the draft field.
+func ByDraft(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDraft, opts...).ToFunc()
+}
+
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
+// ByShareSlug orders the results by the share_slug field.
+func ByShareSlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShareSlug, opts...).ToFunc()
+}
+
+// ByResultsVisibility orders the results by the results_visibility field.
+func ByResultsVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResultsVisibility, opts...).ToFunc()
+}
+
+// ByAnonymous orders the results by the anonymous field.
+func ByAnonymous(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnonymous, opts...).ToFunc()
+}
+
+// ByOpensAt orders the results by the opens_at field.
+func ByOpensAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpensAt, opts...).ToFunc()
+}
+
+// ByClosesAt orders the results by the closes_at field.
+func ByClosesAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosesAt, opts...).ToFunc()
+}
+
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -106,6 +376,13 @@ func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByOptionsCount orders the results by options count.
 func ByOptionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -120,6 +397,20 @@ func ByOptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByBallotsCount orders the results by ballots count.
+func ByBallotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBallotsStep(), opts...)
+	}
+}
+
+// ByBallots orders the results by ballots terms.
+func ByBallots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBallotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByVotesCount orders the results by votes count.
 func ByVotesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -133,6 +424,62 @@ func ByVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newVotesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByVoteEventsCount orders the results by vote_events count.
+func ByVoteEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVoteEventsStep(), opts...)
+	}
+}
+
+// ByVoteEvents orders the results by vote_events terms.
+func ByVoteEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVoteEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByParticipationsCount orders the results by participations count.
+func ByParticipationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newParticipationsStep(), opts...)
+	}
+}
+
+// ByParticipations orders the results by participations terms.
+func ByParticipations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParticipationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAnonymousVotesCount orders the results by anonymous_votes count.
+func ByAnonymousVotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnonymousVotesStep(), opts...)
+	}
+}
+
+// ByAnonymousVotes orders the results by anonymous_votes terms.
+func ByAnonymousVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnonymousVotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByInviteesCount orders the results by invitees count.
+func ByInviteesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newInviteesStep(), opts...)
+	}
+}
+
+// ByInvitees orders the results by invitees terms.
+func ByInvitees(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInviteesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -140,6 +487,13 @@ func newOwnerStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
 	)
 }
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
 func newOptionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -147,6 +501,13 @@ func newOptionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OptionsTable, OptionsColumn),
 	)
 }
+func newBallotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BallotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BallotsTable, BallotsColumn),
+	)
+}
 func newVotesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -154,3 +515,31 @@ func newVotesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, VotesTable, VotesColumn),
 	)
 }
+func newVoteEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VoteEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VoteEventsTable, VoteEventsColumn),
+	)
+}
+func newParticipationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ParticipationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ParticipationsTable, ParticipationsColumn),
+	)
+}
+func newAnonymousVotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnonymousVotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnonymousVotesTable, AnonymousVotesColumn),
+	)
+}
+func newInviteesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InviteesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, InviteesTable, InviteesColumn),
+	)
+}
@@ -60,16 +60,71 @@ func OwnerID(v int) predicate.Poll {
 	return predicate.Poll(sql.FieldEQ(FieldOwnerID, v))
 }
 
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldOrganizationID, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Poll {
 	return predicate.Poll(sql.FieldEQ(FieldTitle, v))
 }
 
+// MinSelections applies equality check predicate on the "min_selections" field. It's identical to MinSelectionsEQ.
+func MinSelections(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldMinSelections, v))
+}
+
+// MaxSelections applies equality check predicate on the "max_selections" field. It's identical to MaxSelectionsEQ.
+func MaxSelections(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldMaxSelections, v))
+}
+
+// Draft applies equality check predicate on the "draft" field. It's identical to DraftEQ.
+func Draft(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldDraft, v))
+}
+
+// ShareSlug applies equality check predicate on the "share_slug" field. It's identical to ShareSlugEQ.
+func ShareSlug(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldShareSlug, v))
+}
+
+// Anonymous applies equality check predicate on the "anonymous" field. It's identical to AnonymousEQ.
+func Anonymous(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldAnonymous, v))
+}
+
+// OpensAt applies equality check predicate on the "opens_at" field. It's identical to OpensAtEQ.
+func OpensAt(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldOpensAt, v))
+}
+
+// ClosesAt applies equality check predicate on the "closes_at" field. It's identical to ClosesAtEQ.
+func ClosesAt(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldClosesAt, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldClosedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Poll {
 	return predicate.Poll(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldVersion, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // OwnerIDEQ applies the EQ predicate on the "owner_id" field.
 func OwnerIDEQ(v int) predicate.Poll {
 	return predicate.Poll(sql.FieldEQ(FieldOwnerID, v))
@@ -90,6 +145,36 @@ func OwnerIDNotIn(vs ...int) predicate.Poll {
 	return predicate.Poll(sql.FieldNotIn(FieldOwnerID, vs...))
 }
 
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldOrganizationID))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Poll {
 	return predicate.Poll(sql.FieldEQ(FieldTitle, v))
@@ -155,6 +240,391 @@ func TitleContainsFold(v string) predicate.Poll {
 	return predicate.Poll(sql.FieldContainsFold(FieldTitle, v))
 }
 
+// VotingMethodEQ applies the EQ predicate on the "voting_method" field.
+func VotingMethodEQ(v VotingMethod) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldVotingMethod, v))
+}
+
+// VotingMethodNEQ applies the NEQ predicate on the "voting_method" field.
+func VotingMethodNEQ(v VotingMethod) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldVotingMethod, v))
+}
+
+// VotingMethodIn applies the In predicate on the "voting_method" field.
+func VotingMethodIn(vs ...VotingMethod) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldVotingMethod, vs...))
+}
+
+// VotingMethodNotIn applies the NotIn predicate on the "voting_method" field.
+func VotingMethodNotIn(vs ...VotingMethod) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldVotingMethod, vs...))
+}
+
+// MinSelectionsEQ applies the EQ predicate on the "min_selections" field.
+func MinSelectionsEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldMinSelections, v))
+}
+
+// MinSelectionsNEQ applies the NEQ predicate on the "min_selections" field.
+func MinSelectionsNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldMinSelections, v))
+}
+
+// MinSelectionsIn applies the In predicate on the "min_selections" field.
+func MinSelectionsIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldMinSelections, vs...))
+}
+
+// MinSelectionsNotIn applies the NotIn predicate on the "min_selections" field.
+func MinSelectionsNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldMinSelections, vs...))
+}
+
+// MinSelectionsGT applies the GT predicate on the "min_selections" field.
+func MinSelectionsGT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldMinSelections, v))
+}
+
+// MinSelectionsGTE applies the GTE predicate on the "min_selections" field.
+func MinSelectionsGTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldMinSelections, v))
+}
+
+// MinSelectionsLT applies the LT predicate on the "min_selections" field.
+func MinSelectionsLT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldMinSelections, v))
+}
+
+// MinSelectionsLTE applies the LTE predicate on the "min_selections" field.
+func MinSelectionsLTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldMinSelections, v))
+}
+
+// MaxSelectionsEQ applies the EQ predicate on the "max_selections" field.
+func MaxSelectionsEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldMaxSelections, v))
+}
+
+// MaxSelectionsNEQ applies the NEQ predicate on the "max_selections" field.
+func MaxSelectionsNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldMaxSelections, v))
+}
+
+// MaxSelectionsIn applies the In predicate on the "max_selections" field.
+func MaxSelectionsIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldMaxSelections, vs...))
+}
+
+// MaxSelectionsNotIn applies the NotIn predicate on the "max_selections" field.
+func MaxSelectionsNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldMaxSelections, vs...))
+}
+
+// MaxSelectionsGT applies the GT predicate on the "max_selections" field.
+func MaxSelectionsGT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldMaxSelections, v))
+}
+
+// MaxSelectionsGTE applies the GTE predicate on the "max_selections" field.
+func MaxSelectionsGTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldMaxSelections, v))
+}
+
+// MaxSelectionsLT applies the LT predicate on the "max_selections" field.
+func MaxSelectionsLT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldMaxSelections, v))
+}
+
+// MaxSelectionsLTE applies the LTE predicate on the "max_selections" field.
+func MaxSelectionsLTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldMaxSelections, v))
+}
+
+// DraftEQ applies the EQ predicate on the "draft" field.
+func DraftEQ(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldDraft, v))
+}
+
+// DraftNEQ applies the NEQ predicate on the "draft" field.
+func DraftNEQ(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldDraft, v))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// ShareSlugEQ applies the EQ predicate on the "share_slug" field.
+func ShareSlugEQ(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldShareSlug, v))
+}
+
+// ShareSlugNEQ applies the NEQ predicate on the "share_slug" field.
+func ShareSlugNEQ(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldShareSlug, v))
+}
+
+// ShareSlugIn applies the In predicate on the "share_slug" field.
+func ShareSlugIn(vs ...string) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldShareSlug, vs...))
+}
+
+// ShareSlugNotIn applies the NotIn predicate on the "share_slug" field.
+func ShareSlugNotIn(vs ...string) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldShareSlug, vs...))
+}
+
+// ShareSlugGT applies the GT predicate on the "share_slug" field.
+func ShareSlugGT(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldShareSlug, v))
+}
+
+// ShareSlugGTE applies the GTE predicate on the "share_slug" field.
+func ShareSlugGTE(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldShareSlug, v))
+}
+
+// ShareSlugLT applies the LT predicate on the "share_slug" field.
+func ShareSlugLT(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldShareSlug, v))
+}
+
+// ShareSlugLTE applies the LTE predicate on the "share_slug" field.
+func ShareSlugLTE(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldShareSlug, v))
+}
+
+// ShareSlugContains applies the Contains predicate on the "share_slug" field.
+func ShareSlugContains(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldContains(FieldShareSlug, v))
+}
+
+// ShareSlugHasPrefix applies the HasPrefix predicate on the "share_slug" field.
+func ShareSlugHasPrefix(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldHasPrefix(FieldShareSlug, v))
+}
+
+// ShareSlugHasSuffix applies the HasSuffix predicate on the "share_slug" field.
+func ShareSlugHasSuffix(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldHasSuffix(FieldShareSlug, v))
+}
+
+// ShareSlugIsNil applies the IsNil predicate on the "share_slug" field.
+func ShareSlugIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldShareSlug))
+}
+
+// ShareSlugNotNil applies the NotNil predicate on the "share_slug" field.
+func ShareSlugNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldShareSlug))
+}
+
+// ShareSlugEqualFold applies the EqualFold predicate on the "share_slug" field.
+func ShareSlugEqualFold(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldEqualFold(FieldShareSlug, v))
+}
+
+// ShareSlugContainsFold applies the ContainsFold predicate on the "share_slug" field.
+func ShareSlugContainsFold(v string) predicate.Poll {
+	return predicate.Poll(sql.FieldContainsFold(FieldShareSlug, v))
+}
+
+// ResultsVisibilityEQ applies the EQ predicate on the "results_visibility" field.
+func ResultsVisibilityEQ(v ResultsVisibility) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldResultsVisibility, v))
+}
+
+// ResultsVisibilityNEQ applies the NEQ predicate on the "results_visibility" field.
+func ResultsVisibilityNEQ(v ResultsVisibility) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldResultsVisibility, v))
+}
+
+// ResultsVisibilityIn applies the In predicate on the "results_visibility" field.
+func ResultsVisibilityIn(vs ...ResultsVisibility) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldResultsVisibility, vs...))
+}
+
+// ResultsVisibilityNotIn applies the NotIn predicate on the "results_visibility" field.
+func ResultsVisibilityNotIn(vs ...ResultsVisibility) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldResultsVisibility, vs...))
+}
+
+// AnonymousEQ applies the EQ predicate on the "anonymous" field.
+func AnonymousEQ(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldAnonymous, v))
+}
+
+// AnonymousNEQ applies the NEQ predicate on the "anonymous" field.
+func AnonymousNEQ(v bool) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldAnonymous, v))
+}
+
+// OpensAtEQ applies the EQ predicate on the "opens_at" field.
+func OpensAtEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldOpensAt, v))
+}
+
+// OpensAtNEQ applies the NEQ predicate on the "opens_at" field.
+func OpensAtNEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldOpensAt, v))
+}
+
+// OpensAtIn applies the In predicate on the "opens_at" field.
+func OpensAtIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldOpensAt, vs...))
+}
+
+// OpensAtNotIn applies the NotIn predicate on the "opens_at" field.
+func OpensAtNotIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldOpensAt, vs...))
+}
+
+// OpensAtGT applies the GT predicate on the "opens_at" field.
+func OpensAtGT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldOpensAt, v))
+}
+
+// OpensAtGTE applies the GTE predicate on the "opens_at" field.
+func OpensAtGTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldOpensAt, v))
+}
+
+// OpensAtLT applies the LT predicate on the "opens_at" field.
+func OpensAtLT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldOpensAt, v))
+}
+
+// OpensAtLTE applies the LTE predicate on the "opens_at" field.
+func OpensAtLTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldOpensAt, v))
+}
+
+// OpensAtIsNil applies the IsNil predicate on the "opens_at" field.
+func OpensAtIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldOpensAt))
+}
+
+// OpensAtNotNil applies the NotNil predicate on the "opens_at" field.
+func OpensAtNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldOpensAt))
+}
+
+// ClosesAtEQ applies the EQ predicate on the "closes_at" field.
+func ClosesAtEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldClosesAt, v))
+}
+
+// ClosesAtNEQ applies the NEQ predicate on the "closes_at" field.
+func ClosesAtNEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldClosesAt, v))
+}
+
+// ClosesAtIn applies the In predicate on the "closes_at" field.
+func ClosesAtIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldClosesAt, vs...))
+}
+
+// ClosesAtNotIn applies the NotIn predicate on the "closes_at" field.
+func ClosesAtNotIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldClosesAt, vs...))
+}
+
+// ClosesAtGT applies the GT predicate on the "closes_at" field.
+func ClosesAtGT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldClosesAt, v))
+}
+
+// ClosesAtGTE applies the GTE predicate on the "closes_at" field.
+func ClosesAtGTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldClosesAt, v))
+}
+
+// ClosesAtLT applies the LT predicate on the "closes_at" field.
+func ClosesAtLT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldClosesAt, v))
+}
+
+// ClosesAtLTE applies the LTE predicate on the "closes_at" field.
+func ClosesAtLTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldClosesAt, v))
+}
+
+// ClosesAtIsNil applies the IsNil predicate on the "closes_at" field.
+func ClosesAtIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldClosesAt))
+}
+
+// ClosesAtNotNil applies the NotNil predicate on the "closes_at" field.
+func ClosesAtNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldClosesAt))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.Poll {
+	return predicate.Poll(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.Poll {
+	return predicate.Poll(sql.FieldNotNull(FieldClosedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Poll {
 	return predicate.Poll(sql.FieldEQ(FieldCreatedAt, v))
@@ -195,6 +665,86 @@ func CreatedAtLTE(v time.Time) predicate.Poll {
 	return predicate.Poll(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldVersion, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Poll {
+	return predicate.Poll(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Poll {
 	return predicate.Poll(func(s *sql.Selector) {
@@ -218,6 +768,29 @@ func HasOwnerWith(preds ...predicate.User) predicate.Poll {
 	})
 }
 
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasOptions applies the HasEdge predicate on the "options" edge.
 func HasOptions() predicate.Poll {
 	return predicate.Poll(func(s *sql.Selector) {
@@ -241,6 +814,29 @@ func HasOptionsWith(preds ...predicate.PollOption) predicate.Poll {
 	})
 }
 
+// HasBallots applies the HasEdge predicate on the "ballots" edge.
+func HasBallots() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BallotsTable, BallotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBallotsWith applies the HasEdge predicate on the "ballots" edge with a given conditions (other predicates).
+func HasBallotsWith(preds ...predicate.Ballot) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newBallotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasVotes applies the HasEdge predicate on the "votes" edge.
 func HasVotes() predicate.Poll {
 	return predicate.Poll(func(s *sql.Selector) {
@@ -264,6 +860,98 @@ func HasVotesWith(preds ...predicate.Vote) predicate.Poll {
 	})
 }
 
+// HasVoteEvents applies the HasEdge predicate on the "vote_events" edge.
+func HasVoteEvents() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VoteEventsTable, VoteEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVoteEventsWith applies the HasEdge predicate on the "vote_events" edge with a given conditions (other predicates).
+func HasVoteEventsWith(preds ...predicate.VoteEvent) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newVoteEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParticipations applies the HasEdge predicate on the "participations" edge.
+func HasParticipations() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ParticipationsTable, ParticipationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParticipationsWith applies the HasEdge predicate on the "participations" edge with a given conditions (other predicates).
+func HasParticipationsWith(preds ...predicate.Participation) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newParticipationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAnonymousVotes applies the HasEdge predicate on the "anonymous_votes" edge.
+func HasAnonymousVotes() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnonymousVotesTable, AnonymousVotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnonymousVotesWith applies the HasEdge predicate on the "anonymous_votes" edge with a given conditions (other predicates).
+func HasAnonymousVotesWith(preds ...predicate.AnonymousVote) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newAnonymousVotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasInvitees applies the HasEdge predicate on the "invitees" edge.
+func HasInvitees() predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, InviteesTable, InviteesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInviteesWith applies the HasEdge predicate on the "invitees" edge with a given conditions (other predicates).
+func HasInviteesWith(preds ...predicate.PollInvitee) predicate.Poll {
+	return predicate.Poll(func(s *sql.Selector) {
+		step := newInviteesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Poll) predicate.Poll {
 	return predicate.Poll(sql.AndPredicates(predicates...))
@@ -10,10 +10,17 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/organization"
+	"github.com/ivankorhner/polling-app/internal/ent/participation"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/pollinvitee"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/user"
 	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
 )
 
 // PollCreate is the builder for creating a Poll entity.
@@ -29,12 +36,180 @@ func (_c *PollCreate) SetOwnerID(v int) *PollCreate {
 	return _c
 }
 
+// SetOrganizationID sets the "organization_id" field.
+func (_c *PollCreate) SetOrganizationID(v int) *PollCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *PollCreate) SetNillableOrganizationID(v *int) *PollCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *PollCreate) SetTitle(v string) *PollCreate {
 	_c.mutation.SetTitle(v)
 	return _c
 }
 
+// SetVotingMethod sets the "voting_method" field.
+func (_c *PollCreate) SetVotingMethod(v poll.VotingMethod) *PollCreate {
+	_c.mutation.SetVotingMethod(v)
+	return _c
+}
+
+// SetNillableVotingMethod sets the "voting_method" field if the given value is not nil.
+func (_c *PollCreate) SetNillableVotingMethod(v *poll.VotingMethod) *PollCreate {
+	if v != nil {
+		_c.SetVotingMethod(*v)
+	}
+	return _c
+}
+
+// SetMinSelections sets the "min_selections" field.
+func (_c *PollCreate) SetMinSelections(v int) *PollCreate {
+	_c.mutation.SetMinSelections(v)
+	return _c
+}
+
+// SetNillableMinSelections sets the "min_selections" field if the given value is not nil.
+func (_c *PollCreate) SetNillableMinSelections(v *int) *PollCreate {
+	if v != nil {
+		_c.SetMinSelections(*v)
+	}
+	return _c
+}
+
+// SetMaxSelections sets the "max_selections" field.
+func (_c *PollCreate) SetMaxSelections(v int) *PollCreate {
+	_c.mutation.SetMaxSelections(v)
+	return _c
+}
+
+// SetNillableMaxSelections sets the "max_selections" field if the given value is not nil.
+func (_c *PollCreate) SetNillableMaxSelections(v *int) *PollCreate {
+	if v != nil {
+		_c.SetMaxSelections(*v)
+	}
+	return _c
+}
+
+// SetDraft sets the "draft" field.
+func (_c *PollCreate) SetDraft(v bool) *PollCreate {
+	_c.mutation.SetDraft(v)
+	return _c
+}
+
+// SetNillableDraft sets the "draft" field if the given value is not nil.
+func (_c *PollCreate) SetNillableDraft(v *bool) *PollCreate {
+	if v != nil {
+		_c.SetDraft(*v)
+	}
+	return _c
+}
+
+// SetVisibility sets the "visibility" field.
+func (_c *PollCreate) SetVisibility(v poll.Visibility) *PollCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *PollCreate) SetNillableVisibility(v *poll.Visibility) *PollCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetShareSlug sets the "share_slug" field.
+func (_c *PollCreate) SetShareSlug(v string) *PollCreate {
+	_c.mutation.SetShareSlug(v)
+	return _c
+}
+
+// SetNillableShareSlug sets the "share_slug" field if the given value is not nil.
+func (_c *PollCreate) SetNillableShareSlug(v *string) *PollCreate {
+	if v != nil {
+		_c.SetShareSlug(*v)
+	}
+	return _c
+}
+
+// SetResultsVisibility sets the "results_visibility" field.
+func (_c *PollCreate) SetResultsVisibility(v poll.ResultsVisibility) *PollCreate {
+	_c.mutation.SetResultsVisibility(v)
+	return _c
+}
+
+// SetNillableResultsVisibility sets the "results_visibility" field if the given value is not nil.
+func (_c *PollCreate) SetNillableResultsVisibility(v *poll.ResultsVisibility) *PollCreate {
+	if v != nil {
+		_c.SetResultsVisibility(*v)
+	}
+	return _c
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (_c *PollCreate) SetAnonymous(v bool) *PollCreate {
+	_c.mutation.SetAnonymous(v)
+	return _c
+}
+
+// SetNillableAnonymous sets the "anonymous" field if the given value is not nil.
+func (_c *PollCreate) SetNillableAnonymous(v *bool) *PollCreate {
+	if v != nil {
+		_c.SetAnonymous(*v)
+	}
+	return _c
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_c *PollCreate) SetOpensAt(v time.Time) *PollCreate {
+	_c.mutation.SetOpensAt(v)
+	return _c
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableOpensAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetOpensAt(*v)
+	}
+	return _c
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_c *PollCreate) SetClosesAt(v time.Time) *PollCreate {
+	_c.mutation.SetClosesAt(v)
+	return _c
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableClosesAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetClosesAt(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *PollCreate) SetClosedAt(v time.Time) *PollCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableClosedAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PollCreate) SetCreatedAt(v time.Time) *PollCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -49,6 +224,34 @@ func (_c *PollCreate) SetNillableCreatedAt(v *time.Time) *PollCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *PollCreate) SetVersion(v int) *PollCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *PollCreate) SetNillableVersion(v *int) *PollCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PollCreate) SetUpdatedAt(v time.Time) *PollCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PollCreate) SetNillableUpdatedAt(v *time.Time) *PollCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PollCreate) SetID(v int) *PollCreate {
 	_c.mutation.SetID(v)
@@ -60,6 +263,11 @@ func (_c *PollCreate) SetOwner(v *User) *PollCreate {
 	return _c.SetOwnerID(v.ID)
 }
 
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *PollCreate) SetOrganization(v *Organization) *PollCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
 // AddOptionIDs adds the "options" edge to the PollOption entity by IDs.
 func (_c *PollCreate) AddOptionIDs(ids ...int) *PollCreate {
 	_c.mutation.AddOptionIDs(ids...)
@@ -75,6 +283,21 @@ func (_c *PollCreate) AddOptions(v ...*PollOption) *PollCreate {
 	return _c.AddOptionIDs(ids...)
 }
 
+// AddBallotIDs adds the "ballots" edge to the Ballot entity by IDs.
+func (_c *PollCreate) AddBallotIDs(ids ...int) *PollCreate {
+	_c.mutation.AddBallotIDs(ids...)
+	return _c
+}
+
+// AddBallots adds the "ballots" edges to the Ballot entity.
+func (_c *PollCreate) AddBallots(v ...*Ballot) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBallotIDs(ids...)
+}
+
 // AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
 func (_c *PollCreate) AddVoteIDs(ids ...int) *PollCreate {
 	_c.mutation.AddVoteIDs(ids...)
@@ -90,6 +313,66 @@ func (_c *PollCreate) AddVotes(v ...*Vote) *PollCreate {
 	return _c.AddVoteIDs(ids...)
 }
 
+// AddVoteEventIDs adds the "vote_events" edge to the VoteEvent entity by IDs.
+func (_c *PollCreate) AddVoteEventIDs(ids ...int) *PollCreate {
+	_c.mutation.AddVoteEventIDs(ids...)
+	return _c
+}
+
+// AddVoteEvents adds the "vote_events" edges to the VoteEvent entity.
+func (_c *PollCreate) AddVoteEvents(v ...*VoteEvent) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVoteEventIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participation entity by IDs.
+func (_c *PollCreate) AddParticipationIDs(ids ...int) *PollCreate {
+	_c.mutation.AddParticipationIDs(ids...)
+	return _c
+}
+
+// AddParticipations adds the "participations" edges to the Participation entity.
+func (_c *PollCreate) AddParticipations(v ...*Participation) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddParticipationIDs(ids...)
+}
+
+// AddAnonymousVoteIDs adds the "anonymous_votes" edge to the AnonymousVote entity by IDs.
+func (_c *PollCreate) AddAnonymousVoteIDs(ids ...uuid.UUID) *PollCreate {
+	_c.mutation.AddAnonymousVoteIDs(ids...)
+	return _c
+}
+
+// AddAnonymousVotes adds the "anonymous_votes" edges to the AnonymousVote entity.
+func (_c *PollCreate) AddAnonymousVotes(v ...*AnonymousVote) *PollCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnonymousVoteIDs(ids...)
+}
+
+// AddInviteeIDs adds the "invitees" edge to the PollInvitee entity by IDs.
+func (_c *PollCreate) AddInviteeIDs(ids ...int) *PollCreate {
+	_c.mutation.AddInviteeIDs(ids...)
+	return _c
+}
+
+// AddInvitees adds the "invitees" edges to the PollInvitee entity.
+func (_c *PollCreate) AddInvitees(v ...*PollInvitee) *PollCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInviteeIDs(ids...)
+}
+
 // Mutation returns the PollMutation object of the builder.
 func (_c *PollCreate) Mutation() *PollMutation {
 	return _c.mutation
@@ -125,10 +408,46 @@ func (_c *PollCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PollCreate) defaults() {
+	if _, ok := _c.mutation.VotingMethod(); !ok {
+		v := poll.DefaultVotingMethod
+		_c.mutation.SetVotingMethod(v)
+	}
+	if _, ok := _c.mutation.MinSelections(); !ok {
+		v := poll.DefaultMinSelections
+		_c.mutation.SetMinSelections(v)
+	}
+	if _, ok := _c.mutation.MaxSelections(); !ok {
+		v := poll.DefaultMaxSelections
+		_c.mutation.SetMaxSelections(v)
+	}
+	if _, ok := _c.mutation.Draft(); !ok {
+		v := poll.DefaultDraft
+		_c.mutation.SetDraft(v)
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := poll.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
+	if _, ok := _c.mutation.ResultsVisibility(); !ok {
+		v := poll.DefaultResultsVisibility
+		_c.mutation.SetResultsVisibility(v)
+	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		v := poll.DefaultAnonymous
+		_c.mutation.SetAnonymous(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := poll.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := poll.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := poll.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -144,9 +463,61 @@ func (_c *PollCreate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Poll.title": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.VotingMethod(); !ok {
+		return &ValidationError{Name: "voting_method", err: errors.New(`ent: missing required field "Poll.voting_method"`)}
+	}
+	if v, ok := _c.mutation.VotingMethod(); ok {
+		if err := poll.VotingMethodValidator(v); err != nil {
+			return &ValidationError{Name: "voting_method", err: fmt.Errorf(`ent: validator failed for field "Poll.voting_method": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MinSelections(); !ok {
+		return &ValidationError{Name: "min_selections", err: errors.New(`ent: missing required field "Poll.min_selections"`)}
+	}
+	if v, ok := _c.mutation.MinSelections(); ok {
+		if err := poll.MinSelectionsValidator(v); err != nil {
+			return &ValidationError{Name: "min_selections", err: fmt.Errorf(`ent: validator failed for field "Poll.min_selections": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxSelections(); !ok {
+		return &ValidationError{Name: "max_selections", err: errors.New(`ent: missing required field "Poll.max_selections"`)}
+	}
+	if v, ok := _c.mutation.MaxSelections(); ok {
+		if err := poll.MaxSelectionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_selections", err: fmt.Errorf(`ent: validator failed for field "Poll.max_selections": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Draft(); !ok {
+		return &ValidationError{Name: "draft", err: errors.New(`ent: missing required field "Poll.draft"`)}
+	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Poll.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := poll.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.visibility": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ResultsVisibility(); !ok {
+		return &ValidationError{Name: "results_visibility", err: errors.New(`ent: missing required field "Poll.results_visibility"`)}
+	}
+	if v, ok := _c.mutation.ResultsVisibility(); ok {
+		if err := poll.ResultsVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "results_visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.results_visibility": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Anonymous(); !ok {
+		return &ValidationError{Name: "anonymous", err: errors.New(`ent: missing required field "Poll.anonymous"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Poll.created_at"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Poll.version"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Poll.updated_at"`)}
+	}
 	if len(_c.mutation.OwnerIDs()) == 0 {
 		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Poll.owner"`)}
 	}
@@ -186,10 +557,62 @@ func (_c *PollCreate) createSpec() (*Poll, *sqlgraph.CreateSpec) {
 		_spec.SetField(poll.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := _c.mutation.VotingMethod(); ok {
+		_spec.SetField(poll.FieldVotingMethod, field.TypeEnum, value)
+		_node.VotingMethod = value
+	}
+	if value, ok := _c.mutation.MinSelections(); ok {
+		_spec.SetField(poll.FieldMinSelections, field.TypeInt, value)
+		_node.MinSelections = value
+	}
+	if value, ok := _c.mutation.MaxSelections(); ok {
+		_spec.SetField(poll.FieldMaxSelections, field.TypeInt, value)
+		_node.MaxSelections = value
+	}
+	if value, ok := _c.mutation.Draft(); ok {
+		_spec.SetField(poll.FieldDraft, field.TypeBool, value)
+		_node.Draft = value
+	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(poll.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.ShareSlug(); ok {
+		_spec.SetField(poll.FieldShareSlug, field.TypeString, value)
+		_node.ShareSlug = &value
+	}
+	if value, ok := _c.mutation.ResultsVisibility(); ok {
+		_spec.SetField(poll.FieldResultsVisibility, field.TypeEnum, value)
+		_node.ResultsVisibility = value
+	}
+	if value, ok := _c.mutation.Anonymous(); ok {
+		_spec.SetField(poll.FieldAnonymous, field.TypeBool, value)
+		_node.Anonymous = value
+	}
+	if value, ok := _c.mutation.OpensAt(); ok {
+		_spec.SetField(poll.FieldOpensAt, field.TypeTime, value)
+		_node.OpensAt = &value
+	}
+	if value, ok := _c.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+		_node.ClosesAt = &value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(poll.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(poll.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(poll.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -207,6 +630,23 @@ func (_c *PollCreate) createSpec() (*Poll, *sqlgraph.CreateSpec) {
 		_node.OwnerID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   poll.OrganizationTable,
+			Columns: []string{poll.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.OptionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -223,6 +663,22 @@ func (_c *PollCreate) createSpec() (*Poll, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.BallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.VotesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -239,6 +695,70 @@ func (_c *PollCreate) createSpec() (*Poll, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.VoteEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VoteEventsTable,
+			Columns: []string{poll.VoteEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voteevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AnonymousVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.AnonymousVotesTable,
+			Columns: []string{poll.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InviteesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.InviteesTable,
+			Columns: []string{poll.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollinvitee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,26 +9,40 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/organization"
+	"github.com/ivankorhner/polling-app/internal/ent/participation"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/pollinvitee"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/predicate"
 	"github.com/ivankorhner/polling-app/internal/ent/user"
 	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
 )
 
 // PollQuery is the builder for querying Poll entities.
 type PollQuery struct {
 	config
-	ctx         *QueryContext
-	order       []poll.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Poll
-	withOwner   *UserQuery
-	withOptions *PollOptionQuery
-	withVotes   *VoteQuery
+	ctx                *QueryContext
+	order              []poll.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Poll
+	withOwner          *UserQuery
+	withOrganization   *OrganizationQuery
+	withOptions        *PollOptionQuery
+	withBallots        *BallotQuery
+	withVotes          *VoteQuery
+	withVoteEvents     *VoteEventQuery
+	withParticipations *ParticipationQuery
+	withAnonymousVotes *AnonymousVoteQuery
+	withInvitees       *PollInviteeQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +101,28 @@ func (_q *PollQuery) QueryOwner() *UserQuery {
 	return query
 }
 
+// QueryOrganization chains the current query on the "organization" edge.
+func (_q *PollQuery) QueryOrganization() *OrganizationQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, poll.OrganizationTable, poll.OrganizationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryOptions chains the current query on the "options" edge.
 func (_q *PollQuery) QueryOptions() *PollOptionQuery {
 	query := (&PollOptionClient{config: _q.config}).Query()
@@ -109,6 +145,28 @@ func (_q *PollQuery) QueryOptions() *PollOptionQuery {
 	return query
 }
 
+// QueryBallots chains the current query on the "ballots" edge.
+func (_q *PollQuery) QueryBallots() *BallotQuery {
+	query := (&BallotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(ballot.Table, ballot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, poll.BallotsTable, poll.BallotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryVotes chains the current query on the "votes" edge.
 func (_q *PollQuery) QueryVotes() *VoteQuery {
 	query := (&VoteClient{config: _q.config}).Query()
@@ -131,6 +189,94 @@ func (_q *PollQuery) QueryVotes() *VoteQuery {
 	return query
 }
 
+// QueryVoteEvents chains the current query on the "vote_events" edge.
+func (_q *PollQuery) QueryVoteEvents() *VoteEventQuery {
+	query := (&VoteEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(voteevent.Table, voteevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, poll.VoteEventsTable, poll.VoteEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParticipations chains the current query on the "participations" edge.
+func (_q *PollQuery) QueryParticipations() *ParticipationQuery {
+	query := (&ParticipationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(participation.Table, participation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, poll.ParticipationsTable, poll.ParticipationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAnonymousVotes chains the current query on the "anonymous_votes" edge.
+func (_q *PollQuery) QueryAnonymousVotes() *AnonymousVoteQuery {
+	query := (&AnonymousVoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(anonymousvote.Table, anonymousvote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, poll.AnonymousVotesTable, poll.AnonymousVotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvitees chains the current query on the "invitees" edge.
+func (_q *PollQuery) QueryInvitees() *PollInviteeQuery {
+	query := (&PollInviteeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(poll.Table, poll.FieldID, selector),
+			sqlgraph.To(pollinvitee.Table, pollinvitee.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, poll.InviteesTable, poll.InviteesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Poll entity from the query.
 // Returns a *NotFoundError when no Poll was found.
 func (_q *PollQuery) First(ctx context.Context) (*Poll, error) {
@@ -318,14 +464,20 @@ func (_q *PollQuery) Clone() *PollQuery {
 		return nil
 	}
 	return &PollQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]poll.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Poll{}, _q.predicates...),
-		withOwner:   _q.withOwner.Clone(),
-		withOptions: _q.withOptions.Clone(),
-		withVotes:   _q.withVotes.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]poll.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.Poll{}, _q.predicates...),
+		withOwner:          _q.withOwner.Clone(),
+		withOrganization:   _q.withOrganization.Clone(),
+		withOptions:        _q.withOptions.Clone(),
+		withBallots:        _q.withBallots.Clone(),
+		withVotes:          _q.withVotes.Clone(),
+		withVoteEvents:     _q.withVoteEvents.Clone(),
+		withParticipations: _q.withParticipations.Clone(),
+		withAnonymousVotes: _q.withAnonymousVotes.Clone(),
+		withInvitees:       _q.withInvitees.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -343,6 +495,17 @@ func (_q *PollQuery) WithOwner(opts ...func(*UserQuery)) *PollQuery {
 	return _q
 }
 
+// WithOrganization tells the query-builder to eager-load the nodes that are connected to
+// the "organization" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithOrganization(opts ...func(*OrganizationQuery)) *PollQuery {
+	query := (&OrganizationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrganization = query
+	return _q
+}
+
 // WithOptions tells the query-builder to eager-load the nodes that are connected to
 // the "options" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *PollQuery) WithOptions(opts ...func(*PollOptionQuery)) *PollQuery {
@@ -354,6 +517,17 @@ func (_q *PollQuery) WithOptions(opts ...func(*PollOptionQuery)) *PollQuery {
 	return _q
 }
 
+// WithBallots tells the query-builder to eager-load the nodes that are connected to
+// the "ballots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithBallots(opts ...func(*BallotQuery)) *PollQuery {
+	query := (&BallotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBallots = query
+	return _q
+}
+
 // WithVotes tells the query-builder to eager-load the nodes that are connected to
 // the "votes" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *PollQuery) WithVotes(opts ...func(*VoteQuery)) *PollQuery {
@@ -365,6 +539,50 @@ func (_q *PollQuery) WithVotes(opts ...func(*VoteQuery)) *PollQuery {
 	return _q
 }
 
+// WithVoteEvents tells the query-builder to eager-load the nodes that are connected to
+// the "vote_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithVoteEvents(opts ...func(*VoteEventQuery)) *PollQuery {
+	query := (&VoteEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVoteEvents = query
+	return _q
+}
+
+// WithParticipations tells the query-builder to eager-load the nodes that are connected to
+// the "participations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithParticipations(opts ...func(*ParticipationQuery)) *PollQuery {
+	query := (&ParticipationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withParticipations = query
+	return _q
+}
+
+// WithAnonymousVotes tells the query-builder to eager-load the nodes that are connected to
+// the "anonymous_votes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithAnonymousVotes(opts ...func(*AnonymousVoteQuery)) *PollQuery {
+	query := (&AnonymousVoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAnonymousVotes = query
+	return _q
+}
+
+// WithInvitees tells the query-builder to eager-load the nodes that are connected to
+// the "invitees" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollQuery) WithInvitees(opts ...func(*PollInviteeQuery)) *PollQuery {
+	query := (&PollInviteeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInvitees = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +661,16 @@ func (_q *PollQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Poll, e
 	var (
 		nodes       = []*Poll{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [9]bool{
 			_q.withOwner != nil,
+			_q.withOrganization != nil,
 			_q.withOptions != nil,
+			_q.withBallots != nil,
 			_q.withVotes != nil,
+			_q.withVoteEvents != nil,
+			_q.withParticipations != nil,
+			_q.withAnonymousVotes != nil,
+			_q.withInvitees != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -458,6 +682,9 @@ func (_q *PollQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Poll, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -473,6 +700,12 @@ func (_q *PollQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Poll, e
 			return nil, err
 		}
 	}
+	if query := _q.withOrganization; query != nil {
+		if err := _q.loadOrganization(ctx, query, nodes, nil,
+			func(n *Poll, e *Organization) { n.Edges.Organization = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withOptions; query != nil {
 		if err := _q.loadOptions(ctx, query, nodes,
 			func(n *Poll) { n.Edges.Options = []*PollOption{} },
@@ -480,6 +713,13 @@ func (_q *PollQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Poll, e
 			return nil, err
 		}
 	}
+	if query := _q.withBallots; query != nil {
+		if err := _q.loadBallots(ctx, query, nodes,
+			func(n *Poll) { n.Edges.Ballots = []*Ballot{} },
+			func(n *Poll, e *Ballot) { n.Edges.Ballots = append(n.Edges.Ballots, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withVotes; query != nil {
 		if err := _q.loadVotes(ctx, query, nodes,
 			func(n *Poll) { n.Edges.Votes = []*Vote{} },
@@ -487,6 +727,34 @@ func (_q *PollQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Poll, e
 			return nil, err
 		}
 	}
+	if query := _q.withVoteEvents; query != nil {
+		if err := _q.loadVoteEvents(ctx, query, nodes,
+			func(n *Poll) { n.Edges.VoteEvents = []*VoteEvent{} },
+			func(n *Poll, e *VoteEvent) { n.Edges.VoteEvents = append(n.Edges.VoteEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withParticipations; query != nil {
+		if err := _q.loadParticipations(ctx, query, nodes,
+			func(n *Poll) { n.Edges.Participations = []*Participation{} },
+			func(n *Poll, e *Participation) { n.Edges.Participations = append(n.Edges.Participations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAnonymousVotes; query != nil {
+		if err := _q.loadAnonymousVotes(ctx, query, nodes,
+			func(n *Poll) { n.Edges.AnonymousVotes = []*AnonymousVote{} },
+			func(n *Poll, e *AnonymousVote) { n.Edges.AnonymousVotes = append(n.Edges.AnonymousVotes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvitees; query != nil {
+		if err := _q.loadInvitees(ctx, query, nodes,
+			func(n *Poll) { n.Edges.Invitees = []*PollInvitee{} },
+			func(n *Poll, e *PollInvitee) { n.Edges.Invitees = append(n.Edges.Invitees, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -519,6 +787,38 @@ func (_q *PollQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*P
 	}
 	return nil
 }
+func (_q *PollQuery) loadOrganization(ctx context.Context, query *OrganizationQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *Organization)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Poll)
+	for i := range nodes {
+		if nodes[i].OrganizationID == nil {
+			continue
+		}
+		fk := *nodes[i].OrganizationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(organization.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "organization_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *PollQuery) loadOptions(ctx context.Context, query *PollOptionQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *PollOption)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Poll)
@@ -549,6 +849,36 @@ func (_q *PollQuery) loadOptions(ctx context.Context, query *PollOptionQuery, no
 	}
 	return nil
 }
+func (_q *PollQuery) loadBallots(ctx context.Context, query *BallotQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *Ballot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(ballot.FieldPollID)
+	}
+	query.Where(predicate.Ballot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.BallotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *PollQuery) loadVotes(ctx context.Context, query *VoteQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *Vote)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Poll)
@@ -579,9 +909,132 @@ func (_q *PollQuery) loadVotes(ctx context.Context, query *VoteQuery, nodes []*P
 	}
 	return nil
 }
+func (_q *PollQuery) loadVoteEvents(ctx context.Context, query *VoteEventQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *VoteEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(voteevent.FieldPollID)
+	}
+	query.Where(predicate.VoteEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.VoteEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadParticipations(ctx context.Context, query *ParticipationQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *Participation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(participation.FieldPollID)
+	}
+	query.Where(predicate.Participation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.ParticipationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadAnonymousVotes(ctx context.Context, query *AnonymousVoteQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *AnonymousVote)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(anonymousvote.FieldPollID)
+	}
+	query.Where(predicate.AnonymousVote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.AnonymousVotesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PollQuery) loadInvitees(ctx context.Context, query *PollInviteeQuery, nodes []*Poll, init func(*Poll), assign func(*Poll, *PollInvitee)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Poll)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pollinvitee.FieldPollID)
+	}
+	query.Where(predicate.PollInvitee(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(poll.InviteesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PollID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "poll_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PollQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -608,6 +1061,9 @@ func (_q *PollQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withOwner != nil {
 			_spec.Node.AddColumnOnce(poll.FieldOwnerID)
 		}
+		if _q.withOrganization != nil {
+			_spec.Node.AddColumnOnce(poll.FieldOrganizationID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -647,6 +1103,9 @@ func (_q *PollQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -664,6 +1123,32 @@ func (_q *PollQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *PollQuery) ForUpdate(opts ...sql.LockOption) *PollQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *PollQuery) ForShare(opts ...sql.LockOption) *PollQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // PollGroupBy is the group-by builder for Poll entities.
 type PollGroupBy struct {
 	selector
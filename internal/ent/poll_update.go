@@ -6,15 +6,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/participation"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/pollinvitee"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/predicate"
 	"github.com/ivankorhner/polling-app/internal/ent/user"
 	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
 )
 
 // PollUpdate is the builder for updating Poll entities.
@@ -58,6 +65,197 @@ func (_u *PollUpdate) SetNillableTitle(v *string) *PollUpdate {
 	return _u
 }
 
+// SetMinSelections sets the "min_selections" field.
+func (_u *PollUpdate) SetMinSelections(v int) *PollUpdate {
+	_u.mutation.ResetMinSelections()
+	_u.mutation.SetMinSelections(v)
+	return _u
+}
+
+// SetNillableMinSelections sets the "min_selections" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableMinSelections(v *int) *PollUpdate {
+	if v != nil {
+		_u.SetMinSelections(*v)
+	}
+	return _u
+}
+
+// AddMinSelections adds value to the "min_selections" field.
+func (_u *PollUpdate) AddMinSelections(v int) *PollUpdate {
+	_u.mutation.AddMinSelections(v)
+	return _u
+}
+
+// SetMaxSelections sets the "max_selections" field.
+func (_u *PollUpdate) SetMaxSelections(v int) *PollUpdate {
+	_u.mutation.ResetMaxSelections()
+	_u.mutation.SetMaxSelections(v)
+	return _u
+}
+
+// SetNillableMaxSelections sets the "max_selections" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableMaxSelections(v *int) *PollUpdate {
+	if v != nil {
+		_u.SetMaxSelections(*v)
+	}
+	return _u
+}
+
+// AddMaxSelections adds value to the "max_selections" field.
+func (_u *PollUpdate) AddMaxSelections(v int) *PollUpdate {
+	_u.mutation.AddMaxSelections(v)
+	return _u
+}
+
+// SetDraft sets the "draft" field.
+func (_u *PollUpdate) SetDraft(v bool) *PollUpdate {
+	_u.mutation.SetDraft(v)
+	return _u
+}
+
+// SetNillableDraft sets the "draft" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableDraft(v *bool) *PollUpdate {
+	if v != nil {
+		_u.SetDraft(*v)
+	}
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *PollUpdate) SetVisibility(v poll.Visibility) *PollUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableVisibility(v *poll.Visibility) *PollUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetShareSlug sets the "share_slug" field.
+func (_u *PollUpdate) SetShareSlug(v string) *PollUpdate {
+	_u.mutation.SetShareSlug(v)
+	return _u
+}
+
+// SetNillableShareSlug sets the "share_slug" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableShareSlug(v *string) *PollUpdate {
+	if v != nil {
+		_u.SetShareSlug(*v)
+	}
+	return _u
+}
+
+// ClearShareSlug clears the value of the "share_slug" field.
+func (_u *PollUpdate) ClearShareSlug() *PollUpdate {
+	_u.mutation.ClearShareSlug()
+	return _u
+}
+
+// SetResultsVisibility sets the "results_visibility" field.
+func (_u *PollUpdate) SetResultsVisibility(v poll.ResultsVisibility) *PollUpdate {
+	_u.mutation.SetResultsVisibility(v)
+	return _u
+}
+
+// SetNillableResultsVisibility sets the "results_visibility" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableResultsVisibility(v *poll.ResultsVisibility) *PollUpdate {
+	if v != nil {
+		_u.SetResultsVisibility(*v)
+	}
+	return _u
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_u *PollUpdate) SetOpensAt(v time.Time) *PollUpdate {
+	_u.mutation.SetOpensAt(v)
+	return _u
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableOpensAt(v *time.Time) *PollUpdate {
+	if v != nil {
+		_u.SetOpensAt(*v)
+	}
+	return _u
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (_u *PollUpdate) ClearOpensAt() *PollUpdate {
+	_u.mutation.ClearOpensAt()
+	return _u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_u *PollUpdate) SetClosesAt(v time.Time) *PollUpdate {
+	_u.mutation.SetClosesAt(v)
+	return _u
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableClosesAt(v *time.Time) *PollUpdate {
+	if v != nil {
+		_u.SetClosesAt(*v)
+	}
+	return _u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (_u *PollUpdate) ClearClosesAt() *PollUpdate {
+	_u.mutation.ClearClosesAt()
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *PollUpdate) SetClosedAt(v time.Time) *PollUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableClosedAt(v *time.Time) *PollUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *PollUpdate) ClearClosedAt() *PollUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *PollUpdate) SetVersion(v int) *PollUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *PollUpdate) SetNillableVersion(v *int) *PollUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *PollUpdate) AddVersion(v int) *PollUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PollUpdate) SetUpdatedAt(v time.Time) *PollUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (_u *PollUpdate) SetOwner(v *User) *PollUpdate {
 	return _u.SetOwnerID(v.ID)
@@ -78,6 +276,21 @@ func (_u *PollUpdate) AddOptions(v ...*PollOption) *PollUpdate {
 	return _u.AddOptionIDs(ids...)
 }
 
+// AddBallotIDs adds the "ballots" edge to the Ballot entity by IDs.
+func (_u *PollUpdate) AddBallotIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddBallotIDs(ids...)
+	return _u
+}
+
+// AddBallots adds the "ballots" edges to the Ballot entity.
+func (_u *PollUpdate) AddBallots(v ...*Ballot) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBallotIDs(ids...)
+}
+
 // AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
 func (_u *PollUpdate) AddVoteIDs(ids ...int) *PollUpdate {
 	_u.mutation.AddVoteIDs(ids...)
@@ -93,6 +306,66 @@ func (_u *PollUpdate) AddVotes(v ...*Vote) *PollUpdate {
 	return _u.AddVoteIDs(ids...)
 }
 
+// AddVoteEventIDs adds the "vote_events" edge to the VoteEvent entity by IDs.
+func (_u *PollUpdate) AddVoteEventIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddVoteEventIDs(ids...)
+	return _u
+}
+
+// AddVoteEvents adds the "vote_events" edges to the VoteEvent entity.
+func (_u *PollUpdate) AddVoteEvents(v ...*VoteEvent) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVoteEventIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participation entity by IDs.
+func (_u *PollUpdate) AddParticipationIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddParticipationIDs(ids...)
+	return _u
+}
+
+// AddParticipations adds the "participations" edges to the Participation entity.
+func (_u *PollUpdate) AddParticipations(v ...*Participation) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipationIDs(ids...)
+}
+
+// AddAnonymousVoteIDs adds the "anonymous_votes" edge to the AnonymousVote entity by IDs.
+func (_u *PollUpdate) AddAnonymousVoteIDs(ids ...uuid.UUID) *PollUpdate {
+	_u.mutation.AddAnonymousVoteIDs(ids...)
+	return _u
+}
+
+// AddAnonymousVotes adds the "anonymous_votes" edges to the AnonymousVote entity.
+func (_u *PollUpdate) AddAnonymousVotes(v ...*AnonymousVote) *PollUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnonymousVoteIDs(ids...)
+}
+
+// AddInviteeIDs adds the "invitees" edge to the PollInvitee entity by IDs.
+func (_u *PollUpdate) AddInviteeIDs(ids ...int) *PollUpdate {
+	_u.mutation.AddInviteeIDs(ids...)
+	return _u
+}
+
+// AddInvitees adds the "invitees" edges to the PollInvitee entity.
+func (_u *PollUpdate) AddInvitees(v ...*PollInvitee) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddInviteeIDs(ids...)
+}
+
 // Mutation returns the PollMutation object of the builder.
 func (_u *PollUpdate) Mutation() *PollMutation {
 	return _u.mutation
@@ -125,6 +398,27 @@ func (_u *PollUpdate) RemoveOptions(v ...*PollOption) *PollUpdate {
 	return _u.RemoveOptionIDs(ids...)
 }
 
+// ClearBallots clears all "ballots" edges to the Ballot entity.
+func (_u *PollUpdate) ClearBallots() *PollUpdate {
+	_u.mutation.ClearBallots()
+	return _u
+}
+
+// RemoveBallotIDs removes the "ballots" edge to Ballot entities by IDs.
+func (_u *PollUpdate) RemoveBallotIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveBallotIDs(ids...)
+	return _u
+}
+
+// RemoveBallots removes "ballots" edges to Ballot entities.
+func (_u *PollUpdate) RemoveBallots(v ...*Ballot) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBallotIDs(ids...)
+}
+
 // ClearVotes clears all "votes" edges to the Vote entity.
 func (_u *PollUpdate) ClearVotes() *PollUpdate {
 	_u.mutation.ClearVotes()
@@ -146,8 +440,93 @@ func (_u *PollUpdate) RemoveVotes(v ...*Vote) *PollUpdate {
 	return _u.RemoveVoteIDs(ids...)
 }
 
+// ClearVoteEvents clears all "vote_events" edges to the VoteEvent entity.
+func (_u *PollUpdate) ClearVoteEvents() *PollUpdate {
+	_u.mutation.ClearVoteEvents()
+	return _u
+}
+
+// RemoveVoteEventIDs removes the "vote_events" edge to VoteEvent entities by IDs.
+func (_u *PollUpdate) RemoveVoteEventIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveVoteEventIDs(ids...)
+	return _u
+}
+
+// RemoveVoteEvents removes "vote_events" edges to VoteEvent entities.
+func (_u *PollUpdate) RemoveVoteEvents(v ...*VoteEvent) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVoteEventIDs(ids...)
+}
+
+// ClearParticipations clears all "participations" edges to the Participation entity.
+func (_u *PollUpdate) ClearParticipations() *PollUpdate {
+	_u.mutation.ClearParticipations()
+	return _u
+}
+
+// RemoveParticipationIDs removes the "participations" edge to Participation entities by IDs.
+func (_u *PollUpdate) RemoveParticipationIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveParticipationIDs(ids...)
+	return _u
+}
+
+// RemoveParticipations removes "participations" edges to Participation entities.
+func (_u *PollUpdate) RemoveParticipations(v ...*Participation) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipationIDs(ids...)
+}
+
+// ClearAnonymousVotes clears all "anonymous_votes" edges to the AnonymousVote entity.
+func (_u *PollUpdate) ClearAnonymousVotes() *PollUpdate {
+	_u.mutation.ClearAnonymousVotes()
+	return _u
+}
+
+// RemoveAnonymousVoteIDs removes the "anonymous_votes" edge to AnonymousVote entities by IDs.
+func (_u *PollUpdate) RemoveAnonymousVoteIDs(ids ...uuid.UUID) *PollUpdate {
+	_u.mutation.RemoveAnonymousVoteIDs(ids...)
+	return _u
+}
+
+// RemoveAnonymousVotes removes "anonymous_votes" edges to AnonymousVote entities.
+func (_u *PollUpdate) RemoveAnonymousVotes(v ...*AnonymousVote) *PollUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnonymousVoteIDs(ids...)
+}
+
+// ClearInvitees clears all "invitees" edges to the PollInvitee entity.
+func (_u *PollUpdate) ClearInvitees() *PollUpdate {
+	_u.mutation.ClearInvitees()
+	return _u
+}
+
+// RemoveInviteeIDs removes the "invitees" edge to PollInvitee entities by IDs.
+func (_u *PollUpdate) RemoveInviteeIDs(ids ...int) *PollUpdate {
+	_u.mutation.RemoveInviteeIDs(ids...)
+	return _u
+}
+
+// RemoveInvitees removes "invitees" edges to PollInvitee entities.
+func (_u *PollUpdate) RemoveInvitees(v ...*PollInvitee) *PollUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInviteeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PollUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -173,6 +552,14 @@ func (_u *PollUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_u *PollUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := poll.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_u *PollUpdate) check() error {
 	if v, ok := _u.mutation.Title(); ok {
@@ -180,6 +567,26 @@ func (_u *PollUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Poll.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.MinSelections(); ok {
+		if err := poll.MinSelectionsValidator(v); err != nil {
+			return &ValidationError{Name: "min_selections", err: fmt.Errorf(`ent: validator failed for field "Poll.min_selections": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxSelections(); ok {
+		if err := poll.MaxSelectionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_selections", err: fmt.Errorf(`ent: validator failed for field "Poll.max_selections": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := poll.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResultsVisibility(); ok {
+		if err := poll.ResultsVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "results_visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.results_visibility": %w`, err)}
+		}
+	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Poll.owner"`)
 	}
@@ -201,6 +608,60 @@ func (_u *PollUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(poll.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MinSelections(); ok {
+		_spec.SetField(poll.FieldMinSelections, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinSelections(); ok {
+		_spec.AddField(poll.FieldMinSelections, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxSelections(); ok {
+		_spec.SetField(poll.FieldMaxSelections, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxSelections(); ok {
+		_spec.AddField(poll.FieldMaxSelections, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Draft(); ok {
+		_spec.SetField(poll.FieldDraft, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(poll.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ShareSlug(); ok {
+		_spec.SetField(poll.FieldShareSlug, field.TypeString, value)
+	}
+	if _u.mutation.ShareSlugCleared() {
+		_spec.ClearField(poll.FieldShareSlug, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResultsVisibility(); ok {
+		_spec.SetField(poll.FieldResultsVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OpensAt(); ok {
+		_spec.SetField(poll.FieldOpensAt, field.TypeTime, value)
+	}
+	if _u.mutation.OpensAtCleared() {
+		_spec.ClearField(poll.FieldOpensAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosesAtCleared() {
+		_spec.ClearField(poll.FieldClosesAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(poll.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(poll.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(poll.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(poll.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -275,28 +736,28 @@ func (_u *PollUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.VotesCleared() {
+	if _u.mutation.BallotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   poll.VotesTable,
-			Columns: []string{poll.VotesColumn},
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedVotesIDs(); len(nodes) > 0 && !_u.mutation.VotesCleared() {
+	if nodes := _u.mutation.RemovedBallotsIDs(); len(nodes) > 0 && !_u.mutation.BallotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   poll.VotesTable,
-			Columns: []string{poll.VotesColumn},
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -304,15 +765,15 @@ func (_u *PollUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.VotesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.BallotsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   poll.VotesTable,
-			Columns: []string{poll.VotesColumn},
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -320,14 +781,239 @@ func (_u *PollUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{poll.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
+	if _u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVotesIDs(); len(nodes) > 0 && !_u.mutation.VotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VotesTable,
+			Columns: []string{poll.VotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vote.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VoteEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VoteEventsTable,
+			Columns: []string{poll.VoteEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voteevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVoteEventsIDs(); len(nodes) > 0 && !_u.mutation.VoteEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VoteEventsTable,
+			Columns: []string{poll.VoteEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voteevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VoteEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VoteEventsTable,
+			Columns: []string{poll.VoteEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voteevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipationsIDs(); len(nodes) > 0 && !_u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnonymousVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.AnonymousVotesTable,
+			Columns: []string{poll.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnonymousVotesIDs(); len(nodes) > 0 && !_u.mutation.AnonymousVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.AnonymousVotesTable,
+			Columns: []string{poll.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnonymousVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.AnonymousVotesTable,
+			Columns: []string{poll.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InviteesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.InviteesTable,
+			Columns: []string{poll.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollinvitee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInviteesIDs(); len(nodes) > 0 && !_u.mutation.InviteesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.InviteesTable,
+			Columns: []string{poll.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollinvitee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InviteesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.InviteesTable,
+			Columns: []string{poll.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollinvitee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{poll.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
 	_u.mutation.done = true
 	return _node, nil
 }
@@ -368,6 +1054,197 @@ func (_u *PollUpdateOne) SetNillableTitle(v *string) *PollUpdateOne {
 	return _u
 }
 
+// SetMinSelections sets the "min_selections" field.
+func (_u *PollUpdateOne) SetMinSelections(v int) *PollUpdateOne {
+	_u.mutation.ResetMinSelections()
+	_u.mutation.SetMinSelections(v)
+	return _u
+}
+
+// SetNillableMinSelections sets the "min_selections" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableMinSelections(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetMinSelections(*v)
+	}
+	return _u
+}
+
+// AddMinSelections adds value to the "min_selections" field.
+func (_u *PollUpdateOne) AddMinSelections(v int) *PollUpdateOne {
+	_u.mutation.AddMinSelections(v)
+	return _u
+}
+
+// SetMaxSelections sets the "max_selections" field.
+func (_u *PollUpdateOne) SetMaxSelections(v int) *PollUpdateOne {
+	_u.mutation.ResetMaxSelections()
+	_u.mutation.SetMaxSelections(v)
+	return _u
+}
+
+// SetNillableMaxSelections sets the "max_selections" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableMaxSelections(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetMaxSelections(*v)
+	}
+	return _u
+}
+
+// AddMaxSelections adds value to the "max_selections" field.
+func (_u *PollUpdateOne) AddMaxSelections(v int) *PollUpdateOne {
+	_u.mutation.AddMaxSelections(v)
+	return _u
+}
+
+// SetDraft sets the "draft" field.
+func (_u *PollUpdateOne) SetDraft(v bool) *PollUpdateOne {
+	_u.mutation.SetDraft(v)
+	return _u
+}
+
+// SetNillableDraft sets the "draft" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableDraft(v *bool) *PollUpdateOne {
+	if v != nil {
+		_u.SetDraft(*v)
+	}
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *PollUpdateOne) SetVisibility(v poll.Visibility) *PollUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableVisibility(v *poll.Visibility) *PollUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetShareSlug sets the "share_slug" field.
+func (_u *PollUpdateOne) SetShareSlug(v string) *PollUpdateOne {
+	_u.mutation.SetShareSlug(v)
+	return _u
+}
+
+// SetNillableShareSlug sets the "share_slug" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableShareSlug(v *string) *PollUpdateOne {
+	if v != nil {
+		_u.SetShareSlug(*v)
+	}
+	return _u
+}
+
+// ClearShareSlug clears the value of the "share_slug" field.
+func (_u *PollUpdateOne) ClearShareSlug() *PollUpdateOne {
+	_u.mutation.ClearShareSlug()
+	return _u
+}
+
+// SetResultsVisibility sets the "results_visibility" field.
+func (_u *PollUpdateOne) SetResultsVisibility(v poll.ResultsVisibility) *PollUpdateOne {
+	_u.mutation.SetResultsVisibility(v)
+	return _u
+}
+
+// SetNillableResultsVisibility sets the "results_visibility" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableResultsVisibility(v *poll.ResultsVisibility) *PollUpdateOne {
+	if v != nil {
+		_u.SetResultsVisibility(*v)
+	}
+	return _u
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_u *PollUpdateOne) SetOpensAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetOpensAt(v)
+	return _u
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableOpensAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetOpensAt(*v)
+	}
+	return _u
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (_u *PollUpdateOne) ClearOpensAt() *PollUpdateOne {
+	_u.mutation.ClearOpensAt()
+	return _u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_u *PollUpdateOne) SetClosesAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetClosesAt(v)
+	return _u
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableClosesAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetClosesAt(*v)
+	}
+	return _u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (_u *PollUpdateOne) ClearClosesAt() *PollUpdateOne {
+	_u.mutation.ClearClosesAt()
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *PollUpdateOne) SetClosedAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableClosedAt(v *time.Time) *PollUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *PollUpdateOne) ClearClosedAt() *PollUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *PollUpdateOne) SetVersion(v int) *PollUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *PollUpdateOne) SetNillableVersion(v *int) *PollUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *PollUpdateOne) AddVersion(v int) *PollUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PollUpdateOne) SetUpdatedAt(v time.Time) *PollUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (_u *PollUpdateOne) SetOwner(v *User) *PollUpdateOne {
 	return _u.SetOwnerID(v.ID)
@@ -388,19 +1265,94 @@ func (_u *PollUpdateOne) AddOptions(v ...*PollOption) *PollUpdateOne {
 	return _u.AddOptionIDs(ids...)
 }
 
+// AddBallotIDs adds the "ballots" edge to the Ballot entity by IDs.
+func (_u *PollUpdateOne) AddBallotIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddBallotIDs(ids...)
+	return _u
+}
+
+// AddBallots adds the "ballots" edges to the Ballot entity.
+func (_u *PollUpdateOne) AddBallots(v ...*Ballot) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBallotIDs(ids...)
+}
+
 // AddVoteIDs adds the "votes" edge to the Vote entity by IDs.
 func (_u *PollUpdateOne) AddVoteIDs(ids ...int) *PollUpdateOne {
 	_u.mutation.AddVoteIDs(ids...)
 	return _u
 }
 
-// AddVotes adds the "votes" edges to the Vote entity.
-func (_u *PollUpdateOne) AddVotes(v ...*Vote) *PollUpdateOne {
+// AddVotes adds the "votes" edges to the Vote entity.
+func (_u *PollUpdateOne) AddVotes(v ...*Vote) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVoteIDs(ids...)
+}
+
+// AddVoteEventIDs adds the "vote_events" edge to the VoteEvent entity by IDs.
+func (_u *PollUpdateOne) AddVoteEventIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddVoteEventIDs(ids...)
+	return _u
+}
+
+// AddVoteEvents adds the "vote_events" edges to the VoteEvent entity.
+func (_u *PollUpdateOne) AddVoteEvents(v ...*VoteEvent) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVoteEventIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participation entity by IDs.
+func (_u *PollUpdateOne) AddParticipationIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddParticipationIDs(ids...)
+	return _u
+}
+
+// AddParticipations adds the "participations" edges to the Participation entity.
+func (_u *PollUpdateOne) AddParticipations(v ...*Participation) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddParticipationIDs(ids...)
+}
+
+// AddAnonymousVoteIDs adds the "anonymous_votes" edge to the AnonymousVote entity by IDs.
+func (_u *PollUpdateOne) AddAnonymousVoteIDs(ids ...uuid.UUID) *PollUpdateOne {
+	_u.mutation.AddAnonymousVoteIDs(ids...)
+	return _u
+}
+
+// AddAnonymousVotes adds the "anonymous_votes" edges to the AnonymousVote entity.
+func (_u *PollUpdateOne) AddAnonymousVotes(v ...*AnonymousVote) *PollUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnonymousVoteIDs(ids...)
+}
+
+// AddInviteeIDs adds the "invitees" edge to the PollInvitee entity by IDs.
+func (_u *PollUpdateOne) AddInviteeIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.AddInviteeIDs(ids...)
+	return _u
+}
+
+// AddInvitees adds the "invitees" edges to the PollInvitee entity.
+func (_u *PollUpdateOne) AddInvitees(v ...*PollInvitee) *PollUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddVoteIDs(ids...)
+	return _u.AddInviteeIDs(ids...)
 }
 
 // Mutation returns the PollMutation object of the builder.
@@ -435,6 +1387,27 @@ func (_u *PollUpdateOne) RemoveOptions(v ...*PollOption) *PollUpdateOne {
 	return _u.RemoveOptionIDs(ids...)
 }
 
+// ClearBallots clears all "ballots" edges to the Ballot entity.
+func (_u *PollUpdateOne) ClearBallots() *PollUpdateOne {
+	_u.mutation.ClearBallots()
+	return _u
+}
+
+// RemoveBallotIDs removes the "ballots" edge to Ballot entities by IDs.
+func (_u *PollUpdateOne) RemoveBallotIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveBallotIDs(ids...)
+	return _u
+}
+
+// RemoveBallots removes "ballots" edges to Ballot entities.
+func (_u *PollUpdateOne) RemoveBallots(v ...*Ballot) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBallotIDs(ids...)
+}
+
 // ClearVotes clears all "votes" edges to the Vote entity.
 func (_u *PollUpdateOne) ClearVotes() *PollUpdateOne {
 	_u.mutation.ClearVotes()
@@ -456,6 +1429,90 @@ func (_u *PollUpdateOne) RemoveVotes(v ...*Vote) *PollUpdateOne {
 	return _u.RemoveVoteIDs(ids...)
 }
 
+// ClearVoteEvents clears all "vote_events" edges to the VoteEvent entity.
+func (_u *PollUpdateOne) ClearVoteEvents() *PollUpdateOne {
+	_u.mutation.ClearVoteEvents()
+	return _u
+}
+
+// RemoveVoteEventIDs removes the "vote_events" edge to VoteEvent entities by IDs.
+func (_u *PollUpdateOne) RemoveVoteEventIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveVoteEventIDs(ids...)
+	return _u
+}
+
+// RemoveVoteEvents removes "vote_events" edges to VoteEvent entities.
+func (_u *PollUpdateOne) RemoveVoteEvents(v ...*VoteEvent) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVoteEventIDs(ids...)
+}
+
+// ClearParticipations clears all "participations" edges to the Participation entity.
+func (_u *PollUpdateOne) ClearParticipations() *PollUpdateOne {
+	_u.mutation.ClearParticipations()
+	return _u
+}
+
+// RemoveParticipationIDs removes the "participations" edge to Participation entities by IDs.
+func (_u *PollUpdateOne) RemoveParticipationIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveParticipationIDs(ids...)
+	return _u
+}
+
+// RemoveParticipations removes "participations" edges to Participation entities.
+func (_u *PollUpdateOne) RemoveParticipations(v ...*Participation) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveParticipationIDs(ids...)
+}
+
+// ClearAnonymousVotes clears all "anonymous_votes" edges to the AnonymousVote entity.
+func (_u *PollUpdateOne) ClearAnonymousVotes() *PollUpdateOne {
+	_u.mutation.ClearAnonymousVotes()
+	return _u
+}
+
+// RemoveAnonymousVoteIDs removes the "anonymous_votes" edge to AnonymousVote entities by IDs.
+func (_u *PollUpdateOne) RemoveAnonymousVoteIDs(ids ...uuid.UUID) *PollUpdateOne {
+	_u.mutation.RemoveAnonymousVoteIDs(ids...)
+	return _u
+}
+
+// RemoveAnonymousVotes removes "anonymous_votes" edges to AnonymousVote entities.
+func (_u *PollUpdateOne) RemoveAnonymousVotes(v ...*AnonymousVote) *PollUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnonymousVoteIDs(ids...)
+}
+
+// ClearInvitees clears all "invitees" edges to the PollInvitee entity.
+func (_u *PollUpdateOne) ClearInvitees() *PollUpdateOne {
+	_u.mutation.ClearInvitees()
+	return _u
+}
+
+// RemoveInviteeIDs removes the "invitees" edge to PollInvitee entities by IDs.
+func (_u *PollUpdateOne) RemoveInviteeIDs(ids ...int) *PollUpdateOne {
+	_u.mutation.RemoveInviteeIDs(ids...)
+	return _u
+}
+
+// RemoveInvitees removes "invitees" edges to PollInvitee entities.
+func (_u *PollUpdateOne) RemoveInvitees(v ...*PollInvitee) *PollUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveInviteeIDs(ids...)
+}
+
 // Where appends a list predicates to the PollUpdate builder.
 func (_u *PollUpdateOne) Where(ps ...predicate.Poll) *PollUpdateOne {
 	_u.mutation.Where(ps...)
@@ -471,6 +1528,7 @@ func (_u *PollUpdateOne) Select(field string, fields ...string) *PollUpdateOne {
 
 // Save executes the query and returns the updated Poll entity.
 func (_u *PollUpdateOne) Save(ctx context.Context) (*Poll, error) {
+	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -496,6 +1554,14 @@ func (_u *PollUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_u *PollUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := poll.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_u *PollUpdateOne) check() error {
 	if v, ok := _u.mutation.Title(); ok {
@@ -503,6 +1569,26 @@ func (_u *PollUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Poll.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.MinSelections(); ok {
+		if err := poll.MinSelectionsValidator(v); err != nil {
+			return &ValidationError{Name: "min_selections", err: fmt.Errorf(`ent: validator failed for field "Poll.min_selections": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxSelections(); ok {
+		if err := poll.MaxSelectionsValidator(v); err != nil {
+			return &ValidationError{Name: "max_selections", err: fmt.Errorf(`ent: validator failed for field "Poll.max_selections": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := poll.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.visibility": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ResultsVisibility(); ok {
+		if err := poll.ResultsVisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "results_visibility", err: fmt.Errorf(`ent: validator failed for field "Poll.results_visibility": %w`, err)}
+		}
+	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Poll.owner"`)
 	}
@@ -541,6 +1627,60 @@ func (_u *PollUpdateOne) sqlSave(ctx context.Context) (_node *Poll, err error) {
 	if value, ok := _u.mutation.Title(); ok {
 		_spec.SetField(poll.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.MinSelections(); ok {
+		_spec.SetField(poll.FieldMinSelections, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMinSelections(); ok {
+		_spec.AddField(poll.FieldMinSelections, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxSelections(); ok {
+		_spec.SetField(poll.FieldMaxSelections, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxSelections(); ok {
+		_spec.AddField(poll.FieldMaxSelections, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Draft(); ok {
+		_spec.SetField(poll.FieldDraft, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(poll.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ShareSlug(); ok {
+		_spec.SetField(poll.FieldShareSlug, field.TypeString, value)
+	}
+	if _u.mutation.ShareSlugCleared() {
+		_spec.ClearField(poll.FieldShareSlug, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResultsVisibility(); ok {
+		_spec.SetField(poll.FieldResultsVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OpensAt(); ok {
+		_spec.SetField(poll.FieldOpensAt, field.TypeTime, value)
+	}
+	if _u.mutation.OpensAtCleared() {
+		_spec.ClearField(poll.FieldOpensAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosesAt(); ok {
+		_spec.SetField(poll.FieldClosesAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosesAtCleared() {
+		_spec.ClearField(poll.FieldClosesAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(poll.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(poll.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(poll.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(poll.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(poll.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -615,6 +1755,51 @@ func (_u *PollUpdateOne) sqlSave(ctx context.Context) (_node *Poll, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.BallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBallotsIDs(); len(nodes) > 0 && !_u.mutation.BallotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BallotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.BallotsTable,
+			Columns: []string{poll.BallotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.VotesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -660,6 +1845,186 @@ func (_u *PollUpdateOne) sqlSave(ctx context.Context) (_node *Poll, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.VoteEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VoteEventsTable,
+			Columns: []string{poll.VoteEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voteevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVoteEventsIDs(); len(nodes) > 0 && !_u.mutation.VoteEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VoteEventsTable,
+			Columns: []string{poll.VoteEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voteevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VoteEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.VoteEventsTable,
+			Columns: []string{poll.VoteEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(voteevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedParticipationsIDs(); len(nodes) > 0 && !_u.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.ParticipationsTable,
+			Columns: []string{poll.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(participation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnonymousVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.AnonymousVotesTable,
+			Columns: []string{poll.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnonymousVotesIDs(); len(nodes) > 0 && !_u.mutation.AnonymousVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.AnonymousVotesTable,
+			Columns: []string{poll.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnonymousVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.AnonymousVotesTable,
+			Columns: []string{poll.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InviteesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.InviteesTable,
+			Columns: []string{poll.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollinvitee.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedInviteesIDs(); len(nodes) > 0 && !_u.mutation.InviteesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.InviteesTable,
+			Columns: []string{poll.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollinvitee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InviteesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   poll.InviteesTable,
+			Columns: []string{poll.InviteesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pollinvitee.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Poll{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
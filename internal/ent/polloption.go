@@ -36,9 +36,11 @@ type PollOptionEdges struct {
 	Poll *Poll `json:"poll,omitempty"`
 	// Votes holds the value of the votes edge.
 	Votes []*Vote `json:"votes,omitempty"`
+	// AnonymousVotes holds the value of the anonymous_votes edge.
+	AnonymousVotes []*AnonymousVote `json:"anonymous_votes,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // PollOrErr returns the Poll value or an error if the edge
@@ -61,6 +63,15 @@ func (e PollOptionEdges) VotesOrErr() ([]*Vote, error) {
 	return nil, &NotLoadedError{edge: "votes"}
 }
 
+// AnonymousVotesOrErr returns the AnonymousVotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e PollOptionEdges) AnonymousVotesOrErr() ([]*AnonymousVote, error) {
+	if e.loadedTypes[2] {
+		return e.AnonymousVotes, nil
+	}
+	return nil, &NotLoadedError{edge: "anonymous_votes"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PollOption) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -134,6 +145,11 @@ func (_m *PollOption) QueryVotes() *VoteQuery {
 	return NewPollOptionClient(_m.config).QueryVotes(_m)
 }
 
+// QueryAnonymousVotes queries the "anonymous_votes" edge of the PollOption entity.
+func (_m *PollOption) QueryAnonymousVotes() *AnonymousVoteQuery {
+	return NewPollOptionClient(_m.config).QueryAnonymousVotes(_m)
+}
+
 // Update returns a builder for updating this PollOption.
 // Note that you need to call PollOption.Unwrap() before calling this method if this PollOption
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -24,6 +24,8 @@ const (
 	EdgePoll = "poll"
 	// EdgeVotes holds the string denoting the votes edge name in mutations.
 	EdgeVotes = "votes"
+	// EdgeAnonymousVotes holds the string denoting the anonymous_votes edge name in mutations.
+	EdgeAnonymousVotes = "anonymous_votes"
 	// Table holds the table name of the polloption in the database.
 	Table = "poll_options"
 	// PollTable is the table that holds the poll relation/edge.
@@ -40,6 +42,13 @@ const (
 	VotesInverseTable = "votes"
 	// VotesColumn is the table column denoting the votes relation/edge.
 	VotesColumn = "option_id"
+	// AnonymousVotesTable is the table that holds the anonymous_votes relation/edge.
+	AnonymousVotesTable = "anonymous_votes"
+	// AnonymousVotesInverseTable is the table name for the AnonymousVote entity.
+	// It exists in this package in order to avoid circular dependency with the "anonymousvote" package.
+	AnonymousVotesInverseTable = "anonymous_votes"
+	// AnonymousVotesColumn is the table column denoting the anonymous_votes relation/edge.
+	AnonymousVotesColumn = "option_id"
 )
 
 // Columns holds all SQL columns for polloption fields.
@@ -110,6 +119,20 @@ func ByVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newVotesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAnonymousVotesCount orders the results by anonymous_votes count.
+func ByAnonymousVotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnonymousVotesStep(), opts...)
+	}
+}
+
+// ByAnonymousVotes orders the results by anonymous_votes terms.
+func ByAnonymousVotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnonymousVotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPollStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -124,3 +147,10 @@ func newVotesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, VotesTable, VotesColumn),
 	)
 }
+func newAnonymousVotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnonymousVotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnonymousVotesTable, AnonymousVotesColumn),
+	)
+}
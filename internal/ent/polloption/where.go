@@ -241,6 +241,29 @@ func HasVotesWith(preds ...predicate.Vote) predicate.PollOption {
 	})
 }
 
+// HasAnonymousVotes applies the HasEdge predicate on the "anonymous_votes" edge.
+func HasAnonymousVotes() predicate.PollOption {
+	return predicate.PollOption(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnonymousVotesTable, AnonymousVotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnonymousVotesWith applies the HasEdge predicate on the "anonymous_votes" edge with a given conditions (other predicates).
+func HasAnonymousVotesWith(preds ...predicate.AnonymousVote) predicate.PollOption {
+	return predicate.PollOption(func(s *sql.Selector) {
+		step := newAnonymousVotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PollOption) predicate.PollOption {
 	return predicate.PollOption(sql.AndPredicates(predicates...))
@@ -10,6 +10,8 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/vote"
@@ -74,6 +76,21 @@ func (_c *PollOptionCreate) AddVotes(v ...*Vote) *PollOptionCreate {
 	return _c.AddVoteIDs(ids...)
 }
 
+// AddAnonymousVoteIDs adds the "anonymous_votes" edge to the AnonymousVote entity by IDs.
+func (_c *PollOptionCreate) AddAnonymousVoteIDs(ids ...uuid.UUID) *PollOptionCreate {
+	_c.mutation.AddAnonymousVoteIDs(ids...)
+	return _c
+}
+
+// AddAnonymousVotes adds the "anonymous_votes" edges to the AnonymousVote entity.
+func (_c *PollOptionCreate) AddAnonymousVotes(v ...*AnonymousVote) *PollOptionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnonymousVoteIDs(ids...)
+}
+
 // Mutation returns the PollOptionMutation object of the builder.
 func (_c *PollOptionCreate) Mutation() *PollOptionMutation {
 	return _c.mutation
@@ -207,6 +224,22 @@ func (_c *PollOptionCreate) createSpec() (*PollOption, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AnonymousVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   polloption.AnonymousVotesTable,
+			Columns: []string{polloption.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
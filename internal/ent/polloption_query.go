@@ -9,9 +9,11 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/predicate"
@@ -21,12 +23,14 @@ import (
 // PollOptionQuery is the builder for querying PollOption entities.
 type PollOptionQuery struct {
 	config
-	ctx        *QueryContext
-	order      []polloption.OrderOption
-	inters     []Interceptor
-	predicates []predicate.PollOption
-	withPoll   *PollQuery
-	withVotes  *VoteQuery
+	ctx                *QueryContext
+	order              []polloption.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.PollOption
+	withPoll           *PollQuery
+	withVotes          *VoteQuery
+	withAnonymousVotes *AnonymousVoteQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +111,28 @@ func (_q *PollOptionQuery) QueryVotes() *VoteQuery {
 	return query
 }
 
+// QueryAnonymousVotes chains the current query on the "anonymous_votes" edge.
+func (_q *PollOptionQuery) QueryAnonymousVotes() *AnonymousVoteQuery {
+	query := (&AnonymousVoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(polloption.Table, polloption.FieldID, selector),
+			sqlgraph.To(anonymousvote.Table, anonymousvote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, polloption.AnonymousVotesTable, polloption.AnonymousVotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PollOption entity from the query.
 // Returns a *NotFoundError when no PollOption was found.
 func (_q *PollOptionQuery) First(ctx context.Context) (*PollOption, error) {
@@ -294,13 +320,14 @@ func (_q *PollOptionQuery) Clone() *PollOptionQuery {
 		return nil
 	}
 	return &PollOptionQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]polloption.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.PollOption{}, _q.predicates...),
-		withPoll:   _q.withPoll.Clone(),
-		withVotes:  _q.withVotes.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]polloption.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.PollOption{}, _q.predicates...),
+		withPoll:           _q.withPoll.Clone(),
+		withVotes:          _q.withVotes.Clone(),
+		withAnonymousVotes: _q.withAnonymousVotes.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +356,17 @@ func (_q *PollOptionQuery) WithVotes(opts ...func(*VoteQuery)) *PollOptionQuery
 	return _q
 }
 
+// WithAnonymousVotes tells the query-builder to eager-load the nodes that are connected to
+// the "anonymous_votes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PollOptionQuery) WithAnonymousVotes(opts ...func(*AnonymousVoteQuery)) *PollOptionQuery {
+	query := (&AnonymousVoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAnonymousVotes = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +445,10 @@ func (_q *PollOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*P
 	var (
 		nodes       = []*PollOption{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withPoll != nil,
 			_q.withVotes != nil,
+			_q.withAnonymousVotes != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -421,6 +460,9 @@ func (_q *PollOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*P
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -443,6 +485,13 @@ func (_q *PollOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*P
 			return nil, err
 		}
 	}
+	if query := _q.withAnonymousVotes; query != nil {
+		if err := _q.loadAnonymousVotes(ctx, query, nodes,
+			func(n *PollOption) { n.Edges.AnonymousVotes = []*AnonymousVote{} },
+			func(n *PollOption, e *AnonymousVote) { n.Edges.AnonymousVotes = append(n.Edges.AnonymousVotes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -505,9 +554,42 @@ func (_q *PollOptionQuery) loadVotes(ctx context.Context, query *VoteQuery, node
 	}
 	return nil
 }
+func (_q *PollOptionQuery) loadAnonymousVotes(ctx context.Context, query *AnonymousVoteQuery, nodes []*PollOption, init func(*PollOption), assign func(*PollOption, *AnonymousVote)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PollOption)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(anonymousvote.FieldOptionID)
+	}
+	query.Where(predicate.AnonymousVote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(polloption.AnonymousVotesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OptionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "option_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PollOptionQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -573,6 +655,9 @@ func (_q *PollOptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -590,6 +675,32 @@ func (_q *PollOptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *PollOptionQuery) ForUpdate(opts ...sql.LockOption) *PollOptionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *PollOptionQuery) ForShare(opts ...sql.LockOption) *PollOptionQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // PollOptionGroupBy is the group-by builder for PollOption entities.
 type PollOptionGroupBy struct {
 	selector
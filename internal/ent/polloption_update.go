@@ -11,6 +11,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/predicate"
 	"github.com/ivankorhner/polling-app/internal/ent/vote"
@@ -72,6 +74,21 @@ func (_u *PollOptionUpdate) AddVotes(v ...*Vote) *PollOptionUpdate {
 	return _u.AddVoteIDs(ids...)
 }
 
+// AddAnonymousVoteIDs adds the "anonymous_votes" edge to the AnonymousVote entity by IDs.
+func (_u *PollOptionUpdate) AddAnonymousVoteIDs(ids ...uuid.UUID) *PollOptionUpdate {
+	_u.mutation.AddAnonymousVoteIDs(ids...)
+	return _u
+}
+
+// AddAnonymousVotes adds the "anonymous_votes" edges to the AnonymousVote entity.
+func (_u *PollOptionUpdate) AddAnonymousVotes(v ...*AnonymousVote) *PollOptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnonymousVoteIDs(ids...)
+}
+
 // Mutation returns the PollOptionMutation object of the builder.
 func (_u *PollOptionUpdate) Mutation() *PollOptionMutation {
 	return _u.mutation
@@ -98,6 +115,27 @@ func (_u *PollOptionUpdate) RemoveVotes(v ...*Vote) *PollOptionUpdate {
 	return _u.RemoveVoteIDs(ids...)
 }
 
+// ClearAnonymousVotes clears all "anonymous_votes" edges to the AnonymousVote entity.
+func (_u *PollOptionUpdate) ClearAnonymousVotes() *PollOptionUpdate {
+	_u.mutation.ClearAnonymousVotes()
+	return _u
+}
+
+// RemoveAnonymousVoteIDs removes the "anonymous_votes" edge to AnonymousVote entities by IDs.
+func (_u *PollOptionUpdate) RemoveAnonymousVoteIDs(ids ...uuid.UUID) *PollOptionUpdate {
+	_u.mutation.RemoveAnonymousVoteIDs(ids...)
+	return _u
+}
+
+// RemoveAnonymousVotes removes "anonymous_votes" edges to AnonymousVote entities.
+func (_u *PollOptionUpdate) RemoveAnonymousVotes(v ...*AnonymousVote) *PollOptionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnonymousVoteIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PollOptionUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -201,6 +239,51 @@ func (_u *PollOptionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AnonymousVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   polloption.AnonymousVotesTable,
+			Columns: []string{polloption.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnonymousVotesIDs(); len(nodes) > 0 && !_u.mutation.AnonymousVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   polloption.AnonymousVotesTable,
+			Columns: []string{polloption.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnonymousVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   polloption.AnonymousVotesTable,
+			Columns: []string{polloption.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{polloption.Label}
@@ -264,6 +347,21 @@ func (_u *PollOptionUpdateOne) AddVotes(v ...*Vote) *PollOptionUpdateOne {
 	return _u.AddVoteIDs(ids...)
 }
 
+// AddAnonymousVoteIDs adds the "anonymous_votes" edge to the AnonymousVote entity by IDs.
+func (_u *PollOptionUpdateOne) AddAnonymousVoteIDs(ids ...uuid.UUID) *PollOptionUpdateOne {
+	_u.mutation.AddAnonymousVoteIDs(ids...)
+	return _u
+}
+
+// AddAnonymousVotes adds the "anonymous_votes" edges to the AnonymousVote entity.
+func (_u *PollOptionUpdateOne) AddAnonymousVotes(v ...*AnonymousVote) *PollOptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnonymousVoteIDs(ids...)
+}
+
 // Mutation returns the PollOptionMutation object of the builder.
 func (_u *PollOptionUpdateOne) Mutation() *PollOptionMutation {
 	return _u.mutation
@@ -290,6 +388,27 @@ func (_u *PollOptionUpdateOne) RemoveVotes(v ...*Vote) *PollOptionUpdateOne {
 	return _u.RemoveVoteIDs(ids...)
 }
 
+// ClearAnonymousVotes clears all "anonymous_votes" edges to the AnonymousVote entity.
+func (_u *PollOptionUpdateOne) ClearAnonymousVotes() *PollOptionUpdateOne {
+	_u.mutation.ClearAnonymousVotes()
+	return _u
+}
+
+// RemoveAnonymousVoteIDs removes the "anonymous_votes" edge to AnonymousVote entities by IDs.
+func (_u *PollOptionUpdateOne) RemoveAnonymousVoteIDs(ids ...uuid.UUID) *PollOptionUpdateOne {
+	_u.mutation.RemoveAnonymousVoteIDs(ids...)
+	return _u
+}
+
+// RemoveAnonymousVotes removes "anonymous_votes" edges to AnonymousVote entities.
+func (_u *PollOptionUpdateOne) RemoveAnonymousVotes(v ...*AnonymousVote) *PollOptionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnonymousVoteIDs(ids...)
+}
+
 // Where appends a list predicates to the PollOptionUpdate builder.
 func (_u *PollOptionUpdateOne) Where(ps ...predicate.PollOption) *PollOptionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -423,6 +542,51 @@ func (_u *PollOptionUpdateOne) sqlSave(ctx context.Context) (_node *PollOption,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AnonymousVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   polloption.AnonymousVotesTable,
+			Columns: []string{polloption.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnonymousVotesIDs(); len(nodes) > 0 && !_u.mutation.AnonymousVotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   polloption.AnonymousVotesTable,
+			Columns: []string{polloption.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnonymousVotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   polloption.AnonymousVotesTable,
+			Columns: []string{polloption.AnonymousVotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(anonymousvote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &PollOption{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
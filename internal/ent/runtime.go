@@ -5,27 +5,175 @@ package ent
 import (
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/apitoken"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/idempotencykey"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	"github.com/ivankorhner/polling-app/internal/ent/organization"
+	"github.com/ivankorhner/polling-app/internal/ent/organizationinvitation"
+	"github.com/ivankorhner/polling-app/internal/ent/participation"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/pollinvitee"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/schema"
 	"github.com/ivankorhner/polling-app/internal/ent/user"
 	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
+	"github.com/ivankorhner/polling-app/internal/ent/webhook"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookattempt"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookdelivery"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apitokenFields := schema.APIToken{}.Fields()
+	_ = apitokenFields
+	// apitokenDescName is the schema descriptor for name field.
+	apitokenDescName := apitokenFields[2].Descriptor()
+	// apitoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apitoken.NameValidator = apitokenDescName.Validators[0].(func(string) error)
+	// apitokenDescTokenHash is the schema descriptor for token_hash field.
+	apitokenDescTokenHash := apitokenFields[3].Descriptor()
+	// apitoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	apitoken.TokenHashValidator = apitokenDescTokenHash.Validators[0].(func(string) error)
+	// apitokenDescPrefix is the schema descriptor for prefix field.
+	apitokenDescPrefix := apitokenFields[4].Descriptor()
+	// apitoken.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apitoken.PrefixValidator = apitokenDescPrefix.Validators[0].(func(string) error)
+	// apitokenDescCreatedAt is the schema descriptor for created_at field.
+	apitokenDescCreatedAt := apitokenFields[7].Descriptor()
+	// apitoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apitoken.DefaultCreatedAt = apitokenDescCreatedAt.Default.(func() time.Time)
+	anonymousvoteFields := schema.AnonymousVote{}.Fields()
+	_ = anonymousvoteFields
+	// anonymousvoteDescRank is the schema descriptor for rank field.
+	anonymousvoteDescRank := anonymousvoteFields[4].Descriptor()
+	// anonymousvote.RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	anonymousvote.RankValidator = anonymousvoteDescRank.Validators[0].(func(int) error)
+	// anonymousvoteDescScore is the schema descriptor for score field.
+	anonymousvoteDescScore := anonymousvoteFields[5].Descriptor()
+	// anonymousvote.ScoreValidator is a validator for the "score" field. It is called by the builders before save.
+	anonymousvote.ScoreValidator = anonymousvoteDescScore.Validators[0].(func(int) error)
+	// anonymousvoteDescID is the schema descriptor for id field.
+	anonymousvoteDescID := anonymousvoteFields[0].Descriptor()
+	// anonymousvote.DefaultID holds the default value on creation for the id field.
+	anonymousvote.DefaultID = anonymousvoteDescID.Default.(func() uuid.UUID)
+	ballotFields := schema.Ballot{}.Fields()
+	_ = ballotFields
+	// ballotDescCreatedAt is the schema descriptor for created_at field.
+	ballotDescCreatedAt := ballotFields[3].Descriptor()
+	// ballot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ballot.DefaultCreatedAt = ballotDescCreatedAt.Default.(func() time.Time)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescFingerprint is the schema descriptor for fingerprint field.
+	idempotencykeyDescFingerprint := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	idempotencykey.FingerprintValidator = idempotencykeyDescFingerprint.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[7].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	membershipFields := schema.Membership{}.Fields()
+	_ = membershipFields
+	// membershipDescCreatedAt is the schema descriptor for created_at field.
+	membershipDescCreatedAt := membershipFields[4].Descriptor()
+	// membership.DefaultCreatedAt holds the default value on creation for the created_at field.
+	membership.DefaultCreatedAt = membershipDescCreatedAt.Default.(func() time.Time)
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[1].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	// organizationDescCreatedAt is the schema descriptor for created_at field.
+	organizationDescCreatedAt := organizationFields[2].Descriptor()
+	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
+	organizationinvitationFields := schema.OrganizationInvitation{}.Fields()
+	_ = organizationinvitationFields
+	// organizationinvitationDescEmail is the schema descriptor for email field.
+	organizationinvitationDescEmail := organizationinvitationFields[2].Descriptor()
+	// organizationinvitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	organizationinvitation.EmailValidator = organizationinvitationDescEmail.Validators[0].(func(string) error)
+	// organizationinvitationDescCreatedAt is the schema descriptor for created_at field.
+	organizationinvitationDescCreatedAt := organizationinvitationFields[6].Descriptor()
+	// organizationinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizationinvitation.DefaultCreatedAt = organizationinvitationDescCreatedAt.Default.(func() time.Time)
+	participationFields := schema.Participation{}.Fields()
+	_ = participationFields
+	// participationDescCreatedAt is the schema descriptor for created_at field.
+	participationDescCreatedAt := participationFields[3].Descriptor()
+	// participation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	participation.DefaultCreatedAt = participationDescCreatedAt.Default.(func() time.Time)
 	pollFields := schema.Poll{}.Fields()
 	_ = pollFields
 	// pollDescTitle is the schema descriptor for title field.
-	pollDescTitle := pollFields[2].Descriptor()
+	pollDescTitle := pollFields[3].Descriptor()
 	// poll.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	poll.TitleValidator = pollDescTitle.Validators[0].(func(string) error)
+	// pollDescMinSelections is the schema descriptor for min_selections field.
+	pollDescMinSelections := pollFields[5].Descriptor()
+	// poll.DefaultMinSelections holds the default value on creation for the min_selections field.
+	poll.DefaultMinSelections = pollDescMinSelections.Default.(int)
+	// poll.MinSelectionsValidator is a validator for the "min_selections" field. It is called by the builders before save.
+	poll.MinSelectionsValidator = pollDescMinSelections.Validators[0].(func(int) error)
+	// pollDescMaxSelections is the schema descriptor for max_selections field.
+	pollDescMaxSelections := pollFields[6].Descriptor()
+	// poll.DefaultMaxSelections holds the default value on creation for the max_selections field.
+	poll.DefaultMaxSelections = pollDescMaxSelections.Default.(int)
+	// poll.MaxSelectionsValidator is a validator for the "max_selections" field. It is called by the builders before save.
+	poll.MaxSelectionsValidator = pollDescMaxSelections.Validators[0].(func(int) error)
+	// pollDescDraft is the schema descriptor for draft field.
+	pollDescDraft := pollFields[7].Descriptor()
+	// poll.DefaultDraft holds the default value on creation for the draft field.
+	poll.DefaultDraft = pollDescDraft.Default.(bool)
+	// pollDescAnonymous is the schema descriptor for anonymous field.
+	pollDescAnonymous := pollFields[11].Descriptor()
+	// poll.DefaultAnonymous holds the default value on creation for the anonymous field.
+	poll.DefaultAnonymous = pollDescAnonymous.Default.(bool)
 	// pollDescCreatedAt is the schema descriptor for created_at field.
-	pollDescCreatedAt := pollFields[3].Descriptor()
+	pollDescCreatedAt := pollFields[15].Descriptor()
 	// poll.DefaultCreatedAt holds the default value on creation for the created_at field.
 	poll.DefaultCreatedAt = pollDescCreatedAt.Default.(func() time.Time)
+	// pollDescVersion is the schema descriptor for version field.
+	pollDescVersion := pollFields[16].Descriptor()
+	// poll.DefaultVersion holds the default value on creation for the version field.
+	poll.DefaultVersion = pollDescVersion.Default.(int)
+	// pollDescUpdatedAt is the schema descriptor for updated_at field.
+	pollDescUpdatedAt := pollFields[17].Descriptor()
+	// poll.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	poll.DefaultUpdatedAt = pollDescUpdatedAt.Default.(func() time.Time)
+	// poll.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	poll.UpdateDefaultUpdatedAt = pollDescUpdatedAt.UpdateDefault.(func() time.Time)
+	pollinviteeFields := schema.PollInvitee{}.Fields()
+	_ = pollinviteeFields
+	// pollinviteeDescCreatedAt is the schema descriptor for created_at field.
+	pollinviteeDescCreatedAt := pollinviteeFields[3].Descriptor()
+	// pollinvitee.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pollinvitee.DefaultCreatedAt = pollinviteeDescCreatedAt.Default.(func() time.Time)
 	polloptionFields := schema.PollOption{}.Fields()
 	_ = polloptionFields
 	// polloptionDescText is the schema descriptor for text field.
@@ -47,13 +195,65 @@ func init() {
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[3].Descriptor()
+	userDescCreatedAt := userFields[4].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	voteFields := schema.Vote{}.Fields()
 	_ = voteFields
+	// voteDescRank is the schema descriptor for rank field.
+	voteDescRank := voteFields[5].Descriptor()
+	// vote.RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	vote.RankValidator = voteDescRank.Validators[0].(func(int) error)
+	// voteDescScore is the schema descriptor for score field.
+	voteDescScore := voteFields[6].Descriptor()
+	// vote.ScoreValidator is a validator for the "score" field. It is called by the builders before save.
+	vote.ScoreValidator = voteDescScore.Validators[0].(func(int) error)
 	// voteDescCreatedAt is the schema descriptor for created_at field.
-	voteDescCreatedAt := voteFields[4].Descriptor()
+	voteDescCreatedAt := voteFields[7].Descriptor()
 	// vote.DefaultCreatedAt holds the default value on creation for the created_at field.
 	vote.DefaultCreatedAt = voteDescCreatedAt.Default.(func() time.Time)
+	voteeventFields := schema.VoteEvent{}.Fields()
+	_ = voteeventFields
+	// voteeventDescCreatedAt is the schema descriptor for created_at field.
+	voteeventDescCreatedAt := voteeventFields[5].Descriptor()
+	// voteevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	voteevent.DefaultCreatedAt = voteeventDescCreatedAt.Default.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[3].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[4].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[6].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	webhookattemptFields := schema.WebhookAttempt{}.Fields()
+	_ = webhookattemptFields
+	// webhookattemptDescCreatedAt is the schema descriptor for created_at field.
+	webhookattemptDescCreatedAt := webhookattemptFields[5].Descriptor()
+	// webhookattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookattempt.DefaultCreatedAt = webhookattemptDescCreatedAt.Default.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttemptCount is the schema descriptor for attempt_count field.
+	webhookdeliveryDescAttemptCount := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttemptCount holds the default value on creation for the attempt_count field.
+	webhookdelivery.DefaultAttemptCount = webhookdeliveryDescAttemptCount.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
 }
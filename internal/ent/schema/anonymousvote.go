@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AnonymousVote holds the schema definition for the AnonymousVote entity.
+// Anonymous votes hold the choices made on anonymous polls. They reference
+// no user, and carry random IDs and no timestamps so that neither their
+// order nor their timing matches them to a participation.
+type AnonymousVote struct {
+	ent.Schema
+}
+
+// Fields of the AnonymousVote.
+func (AnonymousVote) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		// Groups the votes of one ballot. Random, like the ID.
+		field.UUID("ballot_key", uuid.UUID{}).
+			Immutable(),
+		field.Int("poll_id").
+			Immutable(),
+		field.Int("option_id"),
+		// Preference position on ranked ballots, starting at 1
+		field.Int("rank").
+			Optional().
+			Nillable().
+			Positive(),
+		// Score given to the option on scored ballots
+		field.Int("score").
+			Optional().
+			Nillable().
+			NonNegative(),
+	}
+}
+
+// Edges of the AnonymousVote.
+func (AnonymousVote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("poll", Poll.Type).
+			Ref("anonymous_votes").
+			Field("poll_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("option", PollOption.Type).
+			Ref("anonymous_votes").
+			Field("option_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the AnonymousVote - prevents selecting the same option twice on
+// one ballot
+func (AnonymousVote) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ballot_key", "option_id").
+			Unique(),
+		index.Fields("poll_id"),
+		index.Fields("option_id"),
+	}
+}
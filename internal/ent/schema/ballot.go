@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Ballot holds the schema definition for the Ballot entity.
+// A ballot groups the votes a user cast on a poll.
+type Ballot struct {
+	ent.Schema
+}
+
+// Fields of the Ballot.
+func (Ballot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("poll_id").
+			Immutable(),
+		field.Int("user_id").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Ballot.
+func (Ballot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("poll", Poll.Type).
+			Ref("ballots").
+			Field("poll_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("user", User.Type).
+			Ref("ballots").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.To("votes", Vote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Ballot - one ballot per user per poll, and the ballots of a
+// poll for counting them
+func (Ballot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "poll_id").
+			Unique(),
+		index.Fields("poll_id"),
+	}
+}
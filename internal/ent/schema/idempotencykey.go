@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// It records the response to a request sent with an Idempotency-Key header,
+// so a retry with the same key gets the same response. Keys belong to the
+// user who sent them, or to no one for unauthenticated requests.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.String("key").
+			NotEmpty().
+			MaxLen(255).
+			Immutable(),
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		// Hex-encoded SHA-256 of the request method, path and body
+		field.String("fingerprint").
+			NotEmpty().
+			Immutable(),
+		// Unset while the first request is still being handled
+		field.Int("status_code").
+			Optional().
+			Nillable(),
+		field.String("content_type").
+			Optional(),
+		// Responses that issue secrets, such as registration with its token,
+		// are never recorded
+		field.Bytes("body").
+			Optional().
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+	}
+}
+
+// Edges of the IdempotencyKey.
+func (IdempotencyKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("idempotency_keys").
+			Field("user_id").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the IdempotencyKey - each key is used once per user, and once
+// across unauthenticated requests
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "user_id").
+			Unique(),
+		index.Fields("key").
+			Unique().
+			Annotations(entsql.IndexWhere("user_id IS NULL")).
+			StorageKey("idempotencykey_key_anonymous"),
+		index.Fields("expires_at"),
+	}
+}
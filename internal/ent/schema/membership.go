@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Membership holds the schema definition for the Membership entity.
+// Members create and vote on their organization's polls, admins also invite
+// and remove members, and owners also change roles and delete the
+// organization.
+type Membership struct {
+	ent.Schema
+}
+
+// Fields of the Membership.
+func (Membership) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("organization_id").
+			Immutable(),
+		field.Int("user_id").
+			Immutable(),
+		field.Enum("role").
+			Values("owner", "admin", "member").
+			Default("member"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Membership.
+func (Membership) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("memberships").
+			Field("organization_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("user", User.Type).
+			Ref("memberships").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the Membership - each user joins an organization once
+func (Membership) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "user_id").
+			Unique(),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Organization holds the schema definition for the Organization entity.
+// An organization is a team sharing the deployment; its polls are only seen
+// by its members.
+type Organization struct {
+	ent.Schema
+}
+
+// Fields of the Organization.
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.String("name").
+			NotEmpty(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Organization.
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("memberships", Membership.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invitations", OrganizationInvitation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// An organization with polls cannot be deleted, so its polls never
+		// become visible outside it
+		edge.To("polls", Poll.Type).
+			Annotations(entsql.OnDelete(entsql.NoAction)),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OrganizationInvitation holds the schema definition for the
+// OrganizationInvitation entity. An invitation is addressed to an email and
+// accepted by the user registered with it, who joins with the given role.
+type OrganizationInvitation struct {
+	ent.Schema
+}
+
+// Fields of the OrganizationInvitation.
+func (OrganizationInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("organization_id").
+			Immutable(),
+		field.String("email").
+			NotEmpty().
+			Immutable(),
+		field.Enum("role").
+			Values("owner", "admin", "member").
+			Default("member").
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("accepted_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the OrganizationInvitation.
+func (OrganizationInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("invitations").
+			Field("organization_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the OrganizationInvitation - invitations are looked up by the
+// email they were sent to
+func (OrganizationInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "email"),
+		index.Fields("email"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Participation holds the schema definition for the Participation entity.
+// A participation records that a user voted on an anonymous poll without
+// recording how: the choices go to anonymous_votes, which has no user.
+type Participation struct {
+	ent.Schema
+}
+
+// Fields of the Participation.
+func (Participation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("poll_id").
+			Immutable(),
+		field.Int("user_id").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Participation.
+func (Participation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("poll", Poll.Type).
+			Ref("participations").
+			Field("poll_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("user", User.Type).
+			Ref("participations").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the Participation - one ballot per user per poll, and the
+// participations of a poll for counting its ballots
+func (Participation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "poll_id").
+			Unique(),
+		index.Fields("poll_id"),
+	}
+}
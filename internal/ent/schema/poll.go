@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Poll holds the schema definition for the Poll entity.
@@ -19,11 +20,61 @@ func (Poll) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id"),
 		field.Int("owner_id"),
+		// Polls of an organization are only seen by its members
+		field.Int("organization_id").
+			Optional().
+			Nillable().
+			Immutable(),
 		field.String("title").
 			NotEmpty(),
+		field.Enum("voting_method").
+			Values("plurality", "approval", "borda", "instant_runoff", "schulze", "score").
+			Default("plurality").
+			Immutable(),
+		field.Int("min_selections").
+			Positive().
+			Default(1),
+		field.Int("max_selections").
+			Positive().
+			Default(1),
+		field.Bool("draft").
+			Default(false),
+		// Unlisted polls are reached by their share slug and private polls
+		// by their invitees
+		field.Enum("visibility").
+			Values("public", "unlisted", "private").
+			Default("public"),
+		field.String("share_slug").
+			Optional().
+			Nillable().
+			Unique(),
+		// Who may see vote counts and results besides the owner and admins
+		field.Enum("results_visibility").
+			Values("always", "after_vote", "after_close", "owner_only").
+			Default("always"),
+		// Anonymous polls record who voted apart from what they chose
+		field.Bool("anonymous").
+			Default(false).
+			Immutable(),
+		field.Time("opens_at").
+			Optional().
+			Nillable(),
+		field.Time("closes_at").
+			Optional().
+			Nillable(),
+		field.Time("closed_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
+		// Moved on every change to the poll or its options; with the
+		// latest vote event it makes up the poll's ETag
+		field.Int("version").
+			Default(1),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
 	}
 }
 
@@ -34,11 +85,35 @@ func (Poll) Edges() []ent.Edge {
 			Ref("polls").
 			Field("owner_id").
 			Unique().
-			Required().
-			Annotations(entsql.OnDelete(entsql.Cascade)),
+			Required(),
+		edge.From("organization", Organization.Type).
+			Ref("polls").
+			Field("organization_id").
+			Unique().
+			Immutable(),
 		edge.To("options", PollOption.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("ballots", Ballot.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("votes", Vote.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("vote_events", VoteEvent.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("participations", Participation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("anonymous_votes", AnonymousVote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invitees", PollInvitee.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Poll - support keyset pagination of poll listings
+func (Poll) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at", "id"),
+		index.Fields("closes_at", "id"),
+		index.Fields("owner_id"),
+		index.Fields("organization_id"),
 	}
 }
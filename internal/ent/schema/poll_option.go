@@ -36,5 +36,6 @@ func (PollOption) Edges() []ent.Edge {
 			Required().
 			Immutable(),
 		edge.To("votes", Vote.Type),
+		edge.To("anonymous_votes", AnonymousVote.Type),
 	}
 }
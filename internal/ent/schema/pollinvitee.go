@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PollInvitee holds the schema definition for the PollInvitee entity.
+// Invitees are the users besides its owner and admins who may see and vote
+// on a private poll.
+type PollInvitee struct {
+	ent.Schema
+}
+
+// Fields of the PollInvitee.
+func (PollInvitee) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("poll_id").
+			Immutable(),
+		field.Int("user_id").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PollInvitee.
+func (PollInvitee) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("poll", Poll.Type).
+			Ref("invitees").
+			Field("poll_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("user", User.Type).
+			Ref("poll_invitations").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the PollInvitee - each user is invited to a poll once
+func (PollInvitee) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("poll_id", "user_id").
+			Unique(),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -23,9 +24,17 @@ func (User) Fields() []ent.Field {
 		field.String("email").
 			NotEmpty().
 			Unique(),
+		// Admins may manage every poll, not only their own
+		field.Enum("role").
+			Values("user", "admin").
+			Default("user"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
+		// Deleted users are kept, anonymized, so their votes still count
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -33,6 +42,19 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("polls", Poll.Type),
+		edge.To("ballots", Ballot.Type),
 		edge.To("votes", Vote.Type),
+		edge.To("vote_events", VoteEvent.Type),
+		edge.To("participations", Participation.Type),
+		edge.To("api_tokens", APIToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("webhooks", Webhook.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("poll_invitations", PollInvitee.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("memberships", Membership.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("idempotency_keys", IdempotencyKey.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -19,12 +19,26 @@ type Vote struct {
 func (Vote) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id"),
+		field.Int("ballot_id").
+			Immutable(),
 		field.Int("poll_id").
 			Immutable(),
 		field.Int("option_id").
 			Immutable(),
 		field.Int("user_id").
 			Immutable(),
+		// Preference position on ranked ballots, starting at 1
+		field.Int("rank").
+			Optional().
+			Nillable().
+			Positive().
+			Immutable(),
+		// Score given to the option on scored ballots
+		field.Int("score").
+			Optional().
+			Nillable().
+			NonNegative().
+			Immutable(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -34,6 +48,12 @@ func (Vote) Fields() []ent.Field {
 // Edges of the Vote.
 func (Vote) Edges() []ent.Edge {
 	return []ent.Edge{
+		edge.From("ballot", Ballot.Type).
+			Ref("votes").
+			Field("ballot_id").
+			Required().
+			Unique().
+			Immutable(),
 		edge.From("poll", Poll.Type).
 			Ref("votes").
 			Field("poll_id").
@@ -47,20 +67,24 @@ func (Vote) Edges() []ent.Edge {
 			Unique().
 			Immutable().
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// Deleted users are anonymized rather than removed, so their votes
+		// keep counting
 		edge.From("user", User.Type).
 			Ref("votes").
 			Field("user_id").
 			Required().
 			Unique().
-			Immutable().
-			Annotations(entsql.OnDelete(entsql.Cascade)),
+			Immutable(),
 	}
 }
 
-// Indexes of the Vote - prevents duplicate votes from same user on same poll
+// Indexes of the Vote - prevents selecting the same option twice on one
+// ballot, and serves the per-option vote counts of a poll without scanning
+// the votes of every other poll
 func (Vote) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("user_id", "poll_id").
+		index.Fields("ballot_id", "option_id").
 			Unique(),
+		index.Fields("poll_id", "option_id"),
 	}
 }
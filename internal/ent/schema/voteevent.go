@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VoteEvent holds the schema definition for the VoteEvent entity.
+// Vote events form the history of ballots cast, changed and retracted.
+type VoteEvent struct {
+	ent.Schema
+}
+
+// Fields of the VoteEvent.
+func (VoteEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("poll_id").
+			Immutable(),
+		// Unset on anonymous polls, whose events carry no options either
+		field.Int("user_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Enum("action").
+			Values("cast", "change", "retract").
+			Immutable(),
+		// Options selected on the ballot after the action, in ballot order
+		field.JSON("option_ids", []int{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the VoteEvent.
+func (VoteEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("poll", Poll.Type).
+			Ref("vote_events").
+			Field("poll_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.From("user", User.Type).
+			Ref("vote_events").
+			Field("user_id").
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the VoteEvent.
+func (VoteEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("poll_id"),
+	}
+}
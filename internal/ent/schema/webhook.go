@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+// A webhook subscribes a URL to events on one poll, or on every poll its
+// user owns when no poll is set.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("user_id").
+			Immutable(),
+		// Not a foreign key, so the webhook outlives its poll long enough to
+		// deliver poll_deleted. The worker removes it afterwards.
+		field.Int("poll_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("url").
+			NotEmpty(),
+		// Key for the HMAC signature on each delivery
+		field.String("secret").
+			NotEmpty().
+			Immutable().
+			Sensitive(),
+		// Event types delivered to the URL
+		field.Strings("events"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("webhooks").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Webhook.
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("poll_id"),
+	}
+}
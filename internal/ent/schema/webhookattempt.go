@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookAttempt holds the schema definition for the WebhookAttempt entity.
+// Each attempt to send a delivery is logged for debugging.
+type WebhookAttempt struct {
+	ent.Schema
+}
+
+// Fields of the WebhookAttempt.
+func (WebhookAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("delivery_id").
+			Immutable(),
+		// HTTP status of the response, unset when no response arrived
+		field.Int("status_code").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("error").
+			Optional().
+			Immutable(),
+		field.Int("duration_ms").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the WebhookAttempt.
+func (WebhookAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("delivery", WebhookDelivery.Type).
+			Ref("attempts").
+			Field("delivery_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+// Indexes of the WebhookAttempt.
+func (WebhookAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("delivery_id"),
+	}
+}
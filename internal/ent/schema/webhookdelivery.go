@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Deliveries form the webhook outbox: they are written in the transaction
+// that makes the change and sent afterwards by the webhook worker.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id"),
+		field.Int("webhook_id").
+			Immutable(),
+		field.String("event").
+			NotEmpty().
+			Immutable(),
+		// Request body, signed as sent
+		field.Text("payload").
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending"),
+		field.Int("attempt_count").
+			Default(0),
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.To("attempts", WebhookAttempt.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("webhook_id"),
+		// The worker looks up pending deliveries that are due
+		index.Fields("status", "next_attempt_at"),
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/user"
@@ -20,12 +21,18 @@ type Vote struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// BallotID holds the value of the "ballot_id" field.
+	BallotID int `json:"ballot_id,omitempty"`
 	// PollID holds the value of the "poll_id" field.
 	PollID int `json:"poll_id,omitempty"`
 	// OptionID holds the value of the "option_id" field.
 	OptionID int `json:"option_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID int `json:"user_id,omitempty"`
+	// Rank holds the value of the "rank" field.
+	Rank *int `json:"rank,omitempty"`
+	// Score holds the value of the "score" field.
+	Score *int `json:"score,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -36,6 +43,8 @@ type Vote struct {
 
 // VoteEdges holds the relations/edges for other nodes in the graph.
 type VoteEdges struct {
+	// Ballot holds the value of the ballot edge.
+	Ballot *Ballot `json:"ballot,omitempty"`
 	// Poll holds the value of the poll edge.
 	Poll *Poll `json:"poll,omitempty"`
 	// Option holds the value of the option edge.
@@ -44,7 +53,18 @@ type VoteEdges struct {
 	User *User `json:"user,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
+}
+
+// BallotOrErr returns the Ballot value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VoteEdges) BallotOrErr() (*Ballot, error) {
+	if e.Ballot != nil {
+		return e.Ballot, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: ballot.Label}
+	}
+	return nil, &NotLoadedError{edge: "ballot"}
 }
 
 // PollOrErr returns the Poll value or an error if the edge
@@ -52,7 +72,7 @@ type VoteEdges struct {
 func (e VoteEdges) PollOrErr() (*Poll, error) {
 	if e.Poll != nil {
 		return e.Poll, nil
-	} else if e.loadedTypes[0] {
+	} else if e.loadedTypes[1] {
 		return nil, &NotFoundError{label: poll.Label}
 	}
 	return nil, &NotLoadedError{edge: "poll"}
@@ -63,7 +83,7 @@ func (e VoteEdges) PollOrErr() (*Poll, error) {
 func (e VoteEdges) OptionOrErr() (*PollOption, error) {
 	if e.Option != nil {
 		return e.Option, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: polloption.Label}
 	}
 	return nil, &NotLoadedError{edge: "option"}
@@ -74,7 +94,7 @@ func (e VoteEdges) OptionOrErr() (*PollOption, error) {
 func (e VoteEdges) UserOrErr() (*User, error) {
 	if e.User != nil {
 		return e.User, nil
-	} else if e.loadedTypes[2] {
+	} else if e.loadedTypes[3] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "user"}
@@ -85,7 +105,7 @@ func (*Vote) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case vote.FieldID, vote.FieldPollID, vote.FieldOptionID, vote.FieldUserID:
+		case vote.FieldID, vote.FieldBallotID, vote.FieldPollID, vote.FieldOptionID, vote.FieldUserID, vote.FieldRank, vote.FieldScore:
 			values[i] = new(sql.NullInt64)
 		case vote.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -110,6 +130,12 @@ func (_m *Vote) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case vote.FieldBallotID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ballot_id", values[i])
+			} else if value.Valid {
+				_m.BallotID = int(value.Int64)
+			}
 		case vote.FieldPollID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field poll_id", values[i])
@@ -128,6 +154,20 @@ func (_m *Vote) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UserID = int(value.Int64)
 			}
+		case vote.FieldRank:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rank", values[i])
+			} else if value.Valid {
+				_m.Rank = new(int)
+				*_m.Rank = int(value.Int64)
+			}
+		case vote.FieldScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = new(int)
+				*_m.Score = int(value.Int64)
+			}
 		case vote.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -147,6 +187,11 @@ func (_m *Vote) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryBallot queries the "ballot" edge of the Vote entity.
+func (_m *Vote) QueryBallot() *BallotQuery {
+	return NewVoteClient(_m.config).QueryBallot(_m)
+}
+
 // QueryPoll queries the "poll" edge of the Vote entity.
 func (_m *Vote) QueryPoll() *PollQuery {
 	return NewVoteClient(_m.config).QueryPoll(_m)
@@ -185,6 +230,9 @@ func (_m *Vote) String() string {
 	var builder strings.Builder
 	builder.WriteString("Vote(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("ballot_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BallotID))
+	builder.WriteString(", ")
 	builder.WriteString("poll_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PollID))
 	builder.WriteString(", ")
@@ -194,6 +242,16 @@ func (_m *Vote) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
+	if v := _m.Rank; v != nil {
+		builder.WriteString("rank=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Score; v != nil {
+		builder.WriteString("score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -14,14 +14,22 @@ const (
 	Label = "vote"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldBallotID holds the string denoting the ballot_id field in the database.
+	FieldBallotID = "ballot_id"
 	// FieldPollID holds the string denoting the poll_id field in the database.
 	FieldPollID = "poll_id"
 	// FieldOptionID holds the string denoting the option_id field in the database.
 	FieldOptionID = "option_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldRank holds the string denoting the rank field in the database.
+	FieldRank = "rank"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// EdgeBallot holds the string denoting the ballot edge name in mutations.
+	EdgeBallot = "ballot"
 	// EdgePoll holds the string denoting the poll edge name in mutations.
 	EdgePoll = "poll"
 	// EdgeOption holds the string denoting the option edge name in mutations.
@@ -30,6 +38,13 @@ const (
 	EdgeUser = "user"
 	// Table holds the table name of the vote in the database.
 	Table = "votes"
+	// BallotTable is the table that holds the ballot relation/edge.
+	BallotTable = "votes"
+	// BallotInverseTable is the table name for the Ballot entity.
+	// It exists in this package in order to avoid circular dependency with the "ballot" package.
+	BallotInverseTable = "ballots"
+	// BallotColumn is the table column denoting the ballot relation/edge.
+	BallotColumn = "ballot_id"
 	// PollTable is the table that holds the poll relation/edge.
 	PollTable = "votes"
 	// PollInverseTable is the table name for the Poll entity.
@@ -56,9 +71,12 @@ const (
 // Columns holds all SQL columns for vote fields.
 var Columns = []string{
 	FieldID,
+	FieldBallotID,
 	FieldPollID,
 	FieldOptionID,
 	FieldUserID,
+	FieldRank,
+	FieldScore,
 	FieldCreatedAt,
 }
 
@@ -73,6 +91,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// RankValidator is a validator for the "rank" field. It is called by the builders before save.
+	RankValidator func(int) error
+	// ScoreValidator is a validator for the "score" field. It is called by the builders before save.
+	ScoreValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -85,6 +107,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByBallotID orders the results by the ballot_id field.
+func ByBallotID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBallotID, opts...).ToFunc()
+}
+
 // ByPollID orders the results by the poll_id field.
 func ByPollID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPollID, opts...).ToFunc()
@@ -100,11 +127,28 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByRank orders the results by the rank field.
+func ByRank(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRank, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByBallotField orders the results by ballot field.
+func ByBallotField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBallotStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByPollField orders the results by poll field.
 func ByPollField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -125,6 +169,13 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+func newBallotStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BallotInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BallotTable, BallotColumn),
+	)
+}
 func newPollStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
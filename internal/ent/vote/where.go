@@ -55,6 +55,11 @@ func IDLTE(id int) predicate.Vote {
 	return predicate.Vote(sql.FieldLTE(FieldID, id))
 }
 
+// BallotID applies equality check predicate on the "ballot_id" field. It's identical to BallotIDEQ.
+func BallotID(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldBallotID, v))
+}
+
 // PollID applies equality check predicate on the "poll_id" field. It's identical to PollIDEQ.
 func PollID(v int) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldPollID, v))
@@ -70,11 +75,41 @@ func UserID(v int) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldUserID, v))
 }
 
+// Rank applies equality check predicate on the "rank" field. It's identical to RankEQ.
+func Rank(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldRank, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldScore, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// BallotIDEQ applies the EQ predicate on the "ballot_id" field.
+func BallotIDEQ(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldBallotID, v))
+}
+
+// BallotIDNEQ applies the NEQ predicate on the "ballot_id" field.
+func BallotIDNEQ(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldNEQ(FieldBallotID, v))
+}
+
+// BallotIDIn applies the In predicate on the "ballot_id" field.
+func BallotIDIn(vs ...int) predicate.Vote {
+	return predicate.Vote(sql.FieldIn(FieldBallotID, vs...))
+}
+
+// BallotIDNotIn applies the NotIn predicate on the "ballot_id" field.
+func BallotIDNotIn(vs ...int) predicate.Vote {
+	return predicate.Vote(sql.FieldNotIn(FieldBallotID, vs...))
+}
+
 // PollIDEQ applies the EQ predicate on the "poll_id" field.
 func PollIDEQ(v int) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldPollID, v))
@@ -135,6 +170,106 @@ func UserIDNotIn(vs ...int) predicate.Vote {
 	return predicate.Vote(sql.FieldNotIn(FieldUserID, vs...))
 }
 
+// RankEQ applies the EQ predicate on the "rank" field.
+func RankEQ(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldRank, v))
+}
+
+// RankNEQ applies the NEQ predicate on the "rank" field.
+func RankNEQ(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldNEQ(FieldRank, v))
+}
+
+// RankIn applies the In predicate on the "rank" field.
+func RankIn(vs ...int) predicate.Vote {
+	return predicate.Vote(sql.FieldIn(FieldRank, vs...))
+}
+
+// RankNotIn applies the NotIn predicate on the "rank" field.
+func RankNotIn(vs ...int) predicate.Vote {
+	return predicate.Vote(sql.FieldNotIn(FieldRank, vs...))
+}
+
+// RankGT applies the GT predicate on the "rank" field.
+func RankGT(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldGT(FieldRank, v))
+}
+
+// RankGTE applies the GTE predicate on the "rank" field.
+func RankGTE(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldGTE(FieldRank, v))
+}
+
+// RankLT applies the LT predicate on the "rank" field.
+func RankLT(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldLT(FieldRank, v))
+}
+
+// RankLTE applies the LTE predicate on the "rank" field.
+func RankLTE(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldLTE(FieldRank, v))
+}
+
+// RankIsNil applies the IsNil predicate on the "rank" field.
+func RankIsNil() predicate.Vote {
+	return predicate.Vote(sql.FieldIsNull(FieldRank))
+}
+
+// RankNotNil applies the NotNil predicate on the "rank" field.
+func RankNotNil() predicate.Vote {
+	return predicate.Vote(sql.FieldNotNull(FieldRank))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...int) predicate.Vote {
+	return predicate.Vote(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...int) predicate.Vote {
+	return predicate.Vote(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v int) predicate.Vote {
+	return predicate.Vote(sql.FieldLTE(FieldScore, v))
+}
+
+// ScoreIsNil applies the IsNil predicate on the "score" field.
+func ScoreIsNil() predicate.Vote {
+	return predicate.Vote(sql.FieldIsNull(FieldScore))
+}
+
+// ScoreNotNil applies the NotNil predicate on the "score" field.
+func ScoreNotNil() predicate.Vote {
+	return predicate.Vote(sql.FieldNotNull(FieldScore))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldEQ(FieldCreatedAt, v))
@@ -175,6 +310,29 @@ func CreatedAtLTE(v time.Time) predicate.Vote {
 	return predicate.Vote(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// HasBallot applies the HasEdge predicate on the "ballot" edge.
+func HasBallot() predicate.Vote {
+	return predicate.Vote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BallotTable, BallotColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBallotWith applies the HasEdge predicate on the "ballot" edge with a given conditions (other predicates).
+func HasBallotWith(preds ...predicate.Ballot) predicate.Vote {
+	return predicate.Vote(func(s *sql.Selector) {
+		step := newBallotStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasPoll applies the HasEdge predicate on the "poll" edge.
 func HasPoll() predicate.Vote {
 	return predicate.Vote(func(s *sql.Selector) {
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/user"
@@ -23,6 +24,12 @@ type VoteCreate struct {
 	hooks    []Hook
 }
 
+// SetBallotID sets the "ballot_id" field.
+func (_c *VoteCreate) SetBallotID(v int) *VoteCreate {
+	_c.mutation.SetBallotID(v)
+	return _c
+}
+
 // SetPollID sets the "poll_id" field.
 func (_c *VoteCreate) SetPollID(v int) *VoteCreate {
 	_c.mutation.SetPollID(v)
@@ -41,6 +48,34 @@ func (_c *VoteCreate) SetUserID(v int) *VoteCreate {
 	return _c
 }
 
+// SetRank sets the "rank" field.
+func (_c *VoteCreate) SetRank(v int) *VoteCreate {
+	_c.mutation.SetRank(v)
+	return _c
+}
+
+// SetNillableRank sets the "rank" field if the given value is not nil.
+func (_c *VoteCreate) SetNillableRank(v *int) *VoteCreate {
+	if v != nil {
+		_c.SetRank(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *VoteCreate) SetScore(v int) *VoteCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *VoteCreate) SetNillableScore(v *int) *VoteCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *VoteCreate) SetCreatedAt(v time.Time) *VoteCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -61,6 +96,11 @@ func (_c *VoteCreate) SetID(v int) *VoteCreate {
 	return _c
 }
 
+// SetBallot sets the "ballot" edge to the Ballot entity.
+func (_c *VoteCreate) SetBallot(v *Ballot) *VoteCreate {
+	return _c.SetBallotID(v.ID)
+}
+
 // SetPoll sets the "poll" edge to the Poll entity.
 func (_c *VoteCreate) SetPoll(v *Poll) *VoteCreate {
 	return _c.SetPollID(v.ID)
@@ -119,6 +159,9 @@ func (_c *VoteCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *VoteCreate) check() error {
+	if _, ok := _c.mutation.BallotID(); !ok {
+		return &ValidationError{Name: "ballot_id", err: errors.New(`ent: missing required field "Vote.ballot_id"`)}
+	}
 	if _, ok := _c.mutation.PollID(); !ok {
 		return &ValidationError{Name: "poll_id", err: errors.New(`ent: missing required field "Vote.poll_id"`)}
 	}
@@ -128,9 +171,22 @@ func (_c *VoteCreate) check() error {
 	if _, ok := _c.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Vote.user_id"`)}
 	}
+	if v, ok := _c.mutation.Rank(); ok {
+		if err := vote.RankValidator(v); err != nil {
+			return &ValidationError{Name: "rank", err: fmt.Errorf(`ent: validator failed for field "Vote.rank": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Score(); ok {
+		if err := vote.ScoreValidator(v); err != nil {
+			return &ValidationError{Name: "score", err: fmt.Errorf(`ent: validator failed for field "Vote.score": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Vote.created_at"`)}
 	}
+	if len(_c.mutation.BallotIDs()) == 0 {
+		return &ValidationError{Name: "ballot", err: errors.New(`ent: missing required edge "Vote.ballot"`)}
+	}
 	if len(_c.mutation.PollIDs()) == 0 {
 		return &ValidationError{Name: "poll", err: errors.New(`ent: missing required edge "Vote.poll"`)}
 	}
@@ -172,10 +228,35 @@ func (_c *VoteCreate) createSpec() (*Vote, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.Rank(); ok {
+		_spec.SetField(vote.FieldRank, field.TypeInt, value)
+		_node.Rank = &value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(vote.FieldScore, field.TypeInt, value)
+		_node.Score = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(vote.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if nodes := _c.mutation.BallotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   vote.BallotTable,
+			Columns: []string{vote.BallotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ballot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BallotID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.PollIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
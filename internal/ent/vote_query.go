@@ -8,9 +8,11 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
 	"github.com/ivankorhner/polling-app/internal/ent/poll"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/predicate"
@@ -25,9 +27,11 @@ type VoteQuery struct {
 	order      []vote.OrderOption
 	inters     []Interceptor
 	predicates []predicate.Vote
+	withBallot *BallotQuery
 	withPoll   *PollQuery
 	withOption *PollOptionQuery
 	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -64,6 +68,28 @@ func (_q *VoteQuery) Order(o ...vote.OrderOption) *VoteQuery {
 	return _q
 }
 
+// QueryBallot chains the current query on the "ballot" edge.
+func (_q *VoteQuery) QueryBallot() *BallotQuery {
+	query := (&BallotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vote.Table, vote.FieldID, selector),
+			sqlgraph.To(ballot.Table, ballot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, vote.BallotTable, vote.BallotColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryPoll chains the current query on the "poll" edge.
 func (_q *VoteQuery) QueryPoll() *PollQuery {
 	query := (&PollClient{config: _q.config}).Query()
@@ -322,6 +348,7 @@ func (_q *VoteQuery) Clone() *VoteQuery {
 		order:      append([]vote.OrderOption{}, _q.order...),
 		inters:     append([]Interceptor{}, _q.inters...),
 		predicates: append([]predicate.Vote{}, _q.predicates...),
+		withBallot: _q.withBallot.Clone(),
 		withPoll:   _q.withPoll.Clone(),
 		withOption: _q.withOption.Clone(),
 		withUser:   _q.withUser.Clone(),
@@ -331,6 +358,17 @@ func (_q *VoteQuery) Clone() *VoteQuery {
 	}
 }
 
+// WithBallot tells the query-builder to eager-load the nodes that are connected to
+// the "ballot" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VoteQuery) WithBallot(opts ...func(*BallotQuery)) *VoteQuery {
+	query := (&BallotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBallot = query
+	return _q
+}
+
 // WithPoll tells the query-builder to eager-load the nodes that are connected to
 // the "poll" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *VoteQuery) WithPoll(opts ...func(*PollQuery)) *VoteQuery {
@@ -370,12 +408,12 @@ func (_q *VoteQuery) WithUser(opts ...func(*UserQuery)) *VoteQuery {
 // Example:
 //
 //	var v []struct {
-//		PollID int `json:"poll_id,omitempty"`
+//		BallotID int `json:"ballot_id,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Vote.Query().
-//		GroupBy(vote.FieldPollID).
+//		GroupBy(vote.FieldBallotID).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *VoteQuery) GroupBy(field string, fields ...string) *VoteGroupBy {
@@ -393,11 +431,11 @@ func (_q *VoteQuery) GroupBy(field string, fields ...string) *VoteGroupBy {
 // Example:
 //
 //	var v []struct {
-//		PollID int `json:"poll_id,omitempty"`
+//		BallotID int `json:"ballot_id,omitempty"`
 //	}
 //
 //	client.Vote.Query().
-//		Select(vote.FieldPollID).
+//		Select(vote.FieldBallotID).
 //		Scan(ctx, &v)
 func (_q *VoteQuery) Select(fields ...string) *VoteSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -442,7 +480,8 @@ func (_q *VoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vote, e
 	var (
 		nodes       = []*Vote{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
+			_q.withBallot != nil,
 			_q.withPoll != nil,
 			_q.withOption != nil,
 			_q.withUser != nil,
@@ -457,6 +496,9 @@ func (_q *VoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vote, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -466,6 +508,12 @@ func (_q *VoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vote, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withBallot; query != nil {
+		if err := _q.loadBallot(ctx, query, nodes, nil,
+			func(n *Vote, e *Ballot) { n.Edges.Ballot = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withPoll; query != nil {
 		if err := _q.loadPoll(ctx, query, nodes, nil,
 			func(n *Vote, e *Poll) { n.Edges.Poll = e }); err != nil {
@@ -487,6 +535,35 @@ func (_q *VoteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vote, e
 	return nodes, nil
 }
 
+func (_q *VoteQuery) loadBallot(ctx context.Context, query *BallotQuery, nodes []*Vote, init func(*Vote), assign func(*Vote, *Ballot)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Vote)
+	for i := range nodes {
+		fk := nodes[i].BallotID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(ballot.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "ballot_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *VoteQuery) loadPoll(ctx context.Context, query *PollQuery, nodes []*Vote, init func(*Vote), assign func(*Vote, *Poll)) error {
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*Vote)
@@ -577,6 +654,9 @@ func (_q *VoteQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*Vo
 
 func (_q *VoteQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -600,6 +680,9 @@ func (_q *VoteQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withBallot != nil {
+			_spec.Node.AddColumnOnce(vote.FieldBallotID)
+		}
 		if _q.withPoll != nil {
 			_spec.Node.AddColumnOnce(vote.FieldPollID)
 		}
@@ -648,6 +731,9 @@ func (_q *VoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -665,6 +751,32 @@ func (_q *VoteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *VoteQuery) ForUpdate(opts ...sql.LockOption) *VoteQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *VoteQuery) ForShare(opts ...sql.LockOption) *VoteQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // VoteGroupBy is the group-by builder for Vote entities.
 type VoteGroupBy struct {
 	selector
@@ -61,6 +61,9 @@ func (_u *VoteUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *VoteUpdate) check() error {
+	if _u.mutation.BallotCleared() && len(_u.mutation.BallotIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Vote.ballot"`)
+	}
 	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Vote.poll"`)
 	}
@@ -85,6 +88,12 @@ func (_u *VoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if _u.mutation.RankCleared() {
+		_spec.ClearField(vote.FieldRank, field.TypeInt)
+	}
+	if _u.mutation.ScoreCleared() {
+		_spec.ClearField(vote.FieldScore, field.TypeInt)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{vote.Label}
@@ -152,6 +161,9 @@ func (_u *VoteUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *VoteUpdateOne) check() error {
+	if _u.mutation.BallotCleared() && len(_u.mutation.BallotIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Vote.ballot"`)
+	}
 	if _u.mutation.PollCleared() && len(_u.mutation.PollIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Vote.poll"`)
 	}
@@ -193,6 +205,12 @@ func (_u *VoteUpdateOne) sqlSave(ctx context.Context) (_node *Vote, err error) {
 			}
 		}
 	}
+	if _u.mutation.RankCleared() {
+		_spec.ClearField(vote.FieldRank, field.TypeInt)
+	}
+	if _u.mutation.ScoreCleared() {
+		_spec.ClearField(vote.FieldScore, field.TypeInt)
+	}
 	_node = &Vote{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookattempt"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookdelivery"
+)
+
+// WebhookAttempt is the model entity for the WebhookAttempt schema.
+type WebhookAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DeliveryID holds the value of the "delivery_id" field.
+	DeliveryID int `json:"delivery_id,omitempty"`
+	// StatusCode holds the value of the "status_code" field.
+	StatusCode *int `json:"status_code,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs int `json:"duration_ms,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookAttemptQuery when eager-loading is set.
+	Edges        WebhookAttemptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WebhookAttemptEdges holds the relations/edges for other nodes in the graph.
+type WebhookAttemptEdges struct {
+	// Delivery holds the value of the delivery edge.
+	Delivery *WebhookDelivery `json:"delivery,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DeliveryOrErr returns the Delivery value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookAttemptEdges) DeliveryOrErr() (*WebhookDelivery, error) {
+	if e.Delivery != nil {
+		return e.Delivery, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: webhookdelivery.Label}
+	}
+	return nil, &NotLoadedError{edge: "delivery"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookattempt.FieldID, webhookattempt.FieldDeliveryID, webhookattempt.FieldStatusCode, webhookattempt.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case webhookattempt.FieldError:
+			values[i] = new(sql.NullString)
+		case webhookattempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookAttempt fields.
+func (_m *WebhookAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookattempt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case webhookattempt.FieldDeliveryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_id", values[i])
+			} else if value.Valid {
+				_m.DeliveryID = int(value.Int64)
+			}
+		case webhookattempt.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				_m.StatusCode = new(int)
+				*_m.StatusCode = int(value.Int64)
+			}
+		case webhookattempt.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case webhookattempt.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				_m.DurationMs = int(value.Int64)
+			}
+		case webhookattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookAttempt.
+// This includes values selected through modifiers, order, etc.
+func (_m *WebhookAttempt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDelivery queries the "delivery" edge of the WebhookAttempt entity.
+func (_m *WebhookAttempt) QueryDelivery() *WebhookDeliveryQuery {
+	return NewWebhookAttemptClient(_m.config).QueryDelivery(_m)
+}
+
+// Update returns a builder for updating this WebhookAttempt.
+// Note that you need to call WebhookAttempt.Unwrap() before calling this method if this WebhookAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WebhookAttempt) Update() *WebhookAttemptUpdateOne {
+	return NewWebhookAttemptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WebhookAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WebhookAttempt) Unwrap() *WebhookAttempt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookAttempt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WebhookAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("delivery_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeliveryID))
+	builder.WriteString(", ")
+	if v := _m.StatusCode; v != nil {
+		builder.WriteString("status_code=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookAttempts is a parsable slice of WebhookAttempt.
+type WebhookAttempts []*WebhookAttempt
@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/ivankorhner/polling-app/internal/ent/predicate"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookattempt"
+)
+
+// WebhookAttemptUpdate is the builder for updating WebhookAttempt entities.
+type WebhookAttemptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookAttemptMutation
+}
+
+// Where appends a list predicates to the WebhookAttemptUpdate builder.
+func (_u *WebhookAttemptUpdate) Where(ps ...predicate.WebhookAttempt) *WebhookAttemptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the WebhookAttemptMutation object of the builder.
+func (_u *WebhookAttemptUpdate) Mutation() *WebhookAttemptMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WebhookAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WebhookAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookAttemptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebhookAttemptUpdate) check() error {
+	if _u.mutation.DeliveryCleared() && len(_u.mutation.DeliveryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookAttempt.delivery"`)
+	}
+	return nil
+}
+
+func (_u *WebhookAttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookattempt.Table, webhookattempt.Columns, sqlgraph.NewFieldSpec(webhookattempt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(webhookattempt.FieldStatusCode, field.TypeInt)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(webhookattempt.FieldError, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WebhookAttemptUpdateOne is the builder for updating a single WebhookAttempt entity.
+type WebhookAttemptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookAttemptMutation
+}
+
+// Mutation returns the WebhookAttemptMutation object of the builder.
+func (_u *WebhookAttemptUpdateOne) Mutation() *WebhookAttemptMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WebhookAttemptUpdate builder.
+func (_u *WebhookAttemptUpdateOne) Where(ps ...predicate.WebhookAttempt) *WebhookAttemptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WebhookAttemptUpdateOne) Select(field string, fields ...string) *WebhookAttemptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WebhookAttempt entity.
+func (_u *WebhookAttemptUpdateOne) Save(ctx context.Context) (*WebhookAttempt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WebhookAttemptUpdateOne) SaveX(ctx context.Context) *WebhookAttempt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WebhookAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WebhookAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WebhookAttemptUpdateOne) check() error {
+	if _u.mutation.DeliveryCleared() && len(_u.mutation.DeliveryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WebhookAttempt.delivery"`)
+	}
+	return nil
+}
+
+func (_u *WebhookAttemptUpdateOne) sqlSave(ctx context.Context) (_node *WebhookAttempt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookattempt.Table, webhookattempt.Columns, sqlgraph.NewFieldSpec(webhookattempt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookattempt.FieldID)
+		for _, f := range fields {
+			if !webhookattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.StatusCodeCleared() {
+		_spec.ClearField(webhookattempt.FieldStatusCode, field.TypeInt)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(webhookattempt.FieldError, field.TypeString)
+	}
+	_node = &WebhookAttempt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
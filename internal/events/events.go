@@ -0,0 +1,91 @@
+// Package events carries poll events between the replicas of the server.
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+// Type identifies what happened to a poll
+type Type string
+
+// Event types
+const (
+	TypeVote          Type = "vote"
+	TypePollCreated   Type = "poll_created"
+	TypePollPublished Type = "poll_published"
+	TypePollClosed    Type = "poll_closed"
+	TypePollReopened  Type = "poll_reopened"
+	TypePollDeleted   Type = "poll_deleted"
+)
+
+// Event is a change to a poll. A vote event covers a ballot being cast,
+// changed or retracted.
+type Event struct {
+	Type   Type `json:"type"`
+	PollID int  `json:"poll_id"`
+}
+
+// Bus delivers events to the subscribers of every replica
+type Bus interface {
+	// Publish sends the event to every subscriber, including those of the
+	// publishing replica
+	Publish(ctx context.Context, e Event) error
+	// Subscribe returns a channel of events and a function that ends the
+	// subscription. Subscribers that fall behind miss events rather than
+	// stall the bus.
+	Subscribe() (<-chan Event, func())
+}
+
+// subscriberBuffer is how many events a subscriber may fall behind before
+// it misses events
+const subscriberBuffer = 64
+
+// fanOut delivers events to the local subscribers of a bus
+type fanOut struct {
+	logger *slog.Logger
+
+	mu          sync.Mutex
+	subscribers map[chan Event]struct{}
+}
+
+func newFanOut(logger *slog.Logger) *fanOut {
+	return &fanOut{logger: logger, subscribers: make(map[chan Event]struct{})}
+}
+
+func (f *fanOut) subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+
+	f.mu.Lock()
+	f.subscribers[ch] = struct{}{}
+	f.mu.Unlock()
+
+	return ch, func() {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if _, ok := f.subscribers[ch]; ok {
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// deliver sends the event to every subscriber without blocking
+func (f *fanOut) deliver(ctx context.Context, e Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for ch := range f.subscribers {
+		select {
+		case ch <- e:
+		default:
+			f.logger.LogAttrs(
+				ctx,
+				slog.LevelWarn,
+				"event subscriber is full, dropping event",
+				slog.String("type", string(e.Type)),
+				slog.Int("poll_id", e.PollID),
+			)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Memory is a Bus that delivers events within a single process, for tests
+// and single-replica deployments
+type Memory struct {
+	fanOut *fanOut
+}
+
+// NewMemory creates an in-process bus
+func NewMemory(logger *slog.Logger) *Memory {
+	return &Memory{fanOut: newFanOut(logger)}
+}
+
+// Publish delivers the event to the bus's subscribers
+func (m *Memory) Publish(ctx context.Context, e Event) error {
+	m.fanOut.deliver(ctx, e)
+	return nil
+}
+
+// Subscribe returns a channel of published events
+func (m *Memory) Subscribe() (<-chan Event, func()) {
+	return m.fanOut.subscribe()
+}
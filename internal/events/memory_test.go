@@ -0,0 +1,51 @@
+package events
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	bus := NewMemory(slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	first, unsubscribeFirst := bus.Subscribe()
+	second, unsubscribeSecond := bus.Subscribe()
+	defer unsubscribeSecond()
+
+	vote := Event{Type: TypeVote, PollID: 1}
+	require.NoError(t, bus.Publish(ctx, vote))
+	assert.Equal(t, vote, <-first)
+	assert.Equal(t, vote, <-second)
+
+	// Unsubscribing closes the channel and stops delivery
+	unsubscribeFirst()
+	unsubscribeFirst()
+	_, ok := <-first
+	assert.False(t, ok)
+
+	closed := Event{Type: TypePollClosed, PollID: 1}
+	require.NoError(t, bus.Publish(ctx, closed))
+	assert.Equal(t, closed, <-second)
+}
+
+func TestMemory_SlowSubscriber(t *testing.T) {
+	ctx := context.Background()
+	bus := NewMemory(slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	received, unsubscribe := bus.Subscribe()
+	defer unsubscribe()
+
+	// Publishing never blocks on a subscriber that stopped reading
+	for i := range subscriberBuffer + 10 {
+		require.NoError(t, bus.Publish(ctx, Event{Type: TypeVote, PollID: i + 1}))
+	}
+
+	assert.Len(t, received, subscriberBuffer)
+	assert.Equal(t, 1, (<-received).PollID)
+}
@@ -0,0 +1,122 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Channel is the PostgreSQL notification channel events are sent on
+const Channel = "poll_events"
+
+// Delays between attempts to reconnect the listening connection
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// Postgres is a Bus backed by PostgreSQL LISTEN/NOTIFY. Events are published
+// with pg_notify on the shared connection pool and received on a dedicated
+// connection, so every replica listening on the database receives them.
+type Postgres struct {
+	db         *sql.DB
+	connString string
+	logger     *slog.Logger
+	fanOut     *fanOut
+}
+
+// NewPostgres creates a bus that publishes through db and listens on its own
+// connection to connString. Events are only received while Listen runs.
+func NewPostgres(db *sql.DB, connString string, logger *slog.Logger) *Postgres {
+	return &Postgres{
+		db:         db,
+		connString: connString,
+		logger:     logger,
+		fanOut:     newFanOut(logger),
+	}
+}
+
+// Publish notifies every replica of the event, this one included
+func (p *Postgres) Publish(ctx context.Context, e Event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = p.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", Channel, string(payload))
+	return err
+}
+
+// Subscribe returns a channel of events received from PostgreSQL
+func (p *Postgres) Subscribe() (<-chan Event, func()) {
+	return p.fanOut.subscribe()
+}
+
+// Listen receives events and delivers them to subscribers until ctx is
+// canceled. A lost connection is reestablished with exponential backoff;
+// events published while it is down are missed.
+func (p *Postgres) Listen(ctx context.Context) {
+	delay := minReconnectDelay
+	for {
+		connected, err := p.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			delay = minReconnectDelay
+		}
+
+		p.logger.LogAttrs(
+			ctx,
+			slog.LevelWarn,
+			"event listener disconnected",
+			slog.String("error", err.Error()),
+			slog.Duration("retry_in", delay),
+		)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// listen delivers notifications from a new connection until it fails,
+// reporting whether it got as far as listening
+func (p *Postgres) listen(ctx context.Context) (bool, error) {
+	conn, err := pgx.Connect(ctx, p.connString)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{Channel}.Sanitize()); err != nil {
+		return false, err
+	}
+	p.logger.LogAttrs(ctx, slog.LevelInfo, "event listener connected", slog.String("channel", Channel))
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return true, err
+		}
+
+		var e Event
+		if err := json.Unmarshal([]byte(notification.Payload), &e); err != nil {
+			p.logger.LogAttrs(
+				ctx,
+				slog.LevelError,
+				"failed to decode event",
+				slog.String("error", err.Error()),
+				slog.String("payload", notification.Payload),
+			)
+			continue
+		}
+		p.fanOut.deliver(ctx, e)
+	}
+}
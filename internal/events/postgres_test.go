@@ -0,0 +1,63 @@
+//go:build integration
+
+package events_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_FanOutAcrossReplicas(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(context.Background())
+
+	connString, err := testDB.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	// Two buses on one database stand in for two replicas
+	publisher := events.NewPostgres(testDB.DB, connString, logger)
+	replica := events.NewPostgres(testDB.DB, connString, logger)
+	go publisher.Listen(ctx)
+	go replica.Listen(ctx)
+
+	local, unsubscribeLocal := publisher.Subscribe()
+	defer unsubscribeLocal()
+	remote, unsubscribeRemote := replica.Subscribe()
+	defer unsubscribeRemote()
+
+	// The listeners connect in the background, so publish until one event
+	// arrives on each replica
+	e := events.Event{Type: events.TypeVote, PollID: 42}
+	receive := func(ch <-chan events.Event) events.Event {
+		t.Helper()
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		timeout := time.After(10 * time.Second)
+		for {
+			select {
+			case got := <-ch:
+				return got
+			case <-ticker.C:
+				require.NoError(t, publisher.Publish(ctx, e))
+			case <-timeout:
+				t.Fatal("timed out waiting for event")
+			}
+		}
+	}
+
+	assert.Equal(t, e, receive(remote))
+	assert.Equal(t, e, receive(local))
+}
@@ -0,0 +1,2 @@
+-- Modify "polls" table
+ALTER TABLE "polls" ADD COLUMN "draft" boolean NOT NULL DEFAULT false, ADD COLUMN "opens_at" timestamptz NULL, ADD COLUMN "closes_at" timestamptz NULL, ADD COLUMN "closed_at" timestamptz NULL;
@@ -0,0 +1,24 @@
+-- Modify "polls" table
+ALTER TABLE "polls" ADD COLUMN "min_selections" bigint NOT NULL DEFAULT 1, ADD COLUMN "max_selections" bigint NOT NULL DEFAULT 1;
+-- Create "ballots" table
+CREATE TABLE "ballots" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "created_at" timestamptz NOT NULL,
+  "poll_id" bigint NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "ballots_polls_ballots" FOREIGN KEY ("poll_id") REFERENCES "polls" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "ballots_users_ballots" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "ballot_user_id_poll_id" to table: "ballots"
+CREATE UNIQUE INDEX "ballot_user_id_poll_id" ON "ballots" ("user_id", "poll_id");
+-- Backfill one ballot per existing vote
+INSERT INTO "ballots" ("created_at", "poll_id", "user_id") SELECT "created_at", "poll_id", "user_id" FROM "votes";
+-- Modify "votes" table
+ALTER TABLE "votes" ADD COLUMN "ballot_id" bigint NULL;
+UPDATE "votes" SET "ballot_id" = "ballots"."id" FROM "ballots" WHERE "ballots"."poll_id" = "votes"."poll_id" AND "ballots"."user_id" = "votes"."user_id";
+ALTER TABLE "votes" ALTER COLUMN "ballot_id" SET NOT NULL, ADD CONSTRAINT "votes_ballots_votes" FOREIGN KEY ("ballot_id") REFERENCES "ballots" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- Drop index "vote_user_id_poll_id" from table: "votes"
+DROP INDEX "vote_user_id_poll_id";
+-- Create index "vote_ballot_id_option_id" to table: "votes"
+CREATE UNIQUE INDEX "vote_ballot_id_option_id" ON "votes" ("ballot_id", "option_id");
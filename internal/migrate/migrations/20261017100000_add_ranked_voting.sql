@@ -0,0 +1,4 @@
+-- Modify "polls" table
+ALTER TABLE "polls" ADD COLUMN "voting_method" character varying NOT NULL DEFAULT 'plurality';
+-- Modify "votes" table
+ALTER TABLE "votes" ADD COLUMN "rank" bigint NULL;
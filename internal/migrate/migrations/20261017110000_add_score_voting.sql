@@ -0,0 +1,2 @@
+-- Modify "votes" table
+ALTER TABLE "votes" ADD COLUMN "score" bigint NULL;
@@ -0,0 +1,20 @@
+-- Create "vote_events" table
+CREATE TABLE "vote_events" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "action" character varying NOT NULL,
+  "option_ids" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "poll_id" bigint NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "vote_events_polls_vote_events" FOREIGN KEY ("poll_id") REFERENCES "polls" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "vote_events_users_vote_events" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "voteevent_poll_id" to table: "vote_events"
+CREATE INDEX "voteevent_poll_id" ON "vote_events" ("poll_id");
+-- Backfill a cast event for every existing ballot
+INSERT INTO "vote_events" ("action", "option_ids", "created_at", "poll_id", "user_id")
+SELECT 'cast', jsonb_agg("votes"."option_id" ORDER BY "votes"."rank", "votes"."id"), "ballots"."created_at", "ballots"."poll_id", "ballots"."user_id"
+FROM "ballots" JOIN "votes" ON "votes"."ballot_id" = "ballots"."id"
+GROUP BY "ballots"."id"
+ORDER BY "ballots"."id";
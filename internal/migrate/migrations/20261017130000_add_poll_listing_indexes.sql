@@ -0,0 +1,6 @@
+-- Create index "poll_created_at_id" to table: "polls"
+CREATE INDEX "poll_created_at_id" ON "polls" ("created_at", "id");
+-- Create index "poll_closes_at_id" to table: "polls"
+CREATE INDEX "poll_closes_at_id" ON "polls" ("closes_at", "id");
+-- Create index "poll_owner_id" to table: "polls"
+CREATE INDEX "poll_owner_id" ON "polls" ("owner_id");
@@ -0,0 +1,47 @@
+-- Create "webhooks" table
+CREATE TABLE "webhooks" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "poll_id" bigint NULL,
+  "url" character varying NOT NULL,
+  "secret" character varying NOT NULL,
+  "events" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhooks_users_webhooks" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "webhook_poll_id" to table: "webhooks"
+CREATE INDEX "webhook_poll_id" ON "webhooks" ("poll_id");
+-- Create index "webhook_user_id" to table: "webhooks"
+CREATE INDEX "webhook_user_id" ON "webhooks" ("user_id");
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "event" character varying NOT NULL,
+  "payload" text NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'pending',
+  "attempt_count" bigint NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NOT NULL,
+  "delivered_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "webhook_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhook_deliveries_webhooks_deliveries" FOREIGN KEY ("webhook_id") REFERENCES "webhooks" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "webhookdelivery_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
+-- Create index "webhookdelivery_webhook_id" to table: "webhook_deliveries"
+CREATE INDEX "webhookdelivery_webhook_id" ON "webhook_deliveries" ("webhook_id");
+-- Create "webhook_attempts" table
+CREATE TABLE "webhook_attempts" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "status_code" bigint NULL,
+  "error" character varying NULL,
+  "duration_ms" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "delivery_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhook_attempts_webhook_deliveries_attempts" FOREIGN KEY ("delivery_id") REFERENCES "webhook_deliveries" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "webhookattempt_delivery_id" to table: "webhook_attempts"
+CREATE INDEX "webhookattempt_delivery_id" ON "webhook_attempts" ("delivery_id");
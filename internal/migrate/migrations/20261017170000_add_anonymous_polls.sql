@@ -0,0 +1,34 @@
+-- Modify "polls" table
+ALTER TABLE "polls" ADD COLUMN "anonymous" boolean NOT NULL DEFAULT false;
+-- Modify "vote_events" table
+ALTER TABLE "vote_events" DROP CONSTRAINT "vote_events_users_vote_events", ALTER COLUMN "user_id" DROP NOT NULL, ADD CONSTRAINT "vote_events_users_vote_events" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create "anonymous_votes" table
+CREATE TABLE "anonymous_votes" (
+  "id" uuid NOT NULL,
+  "ballot_key" uuid NOT NULL,
+  "rank" bigint NULL,
+  "score" bigint NULL,
+  "poll_id" bigint NOT NULL,
+  "option_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "anonymous_votes_poll_options_anonymous_votes" FOREIGN KEY ("option_id") REFERENCES "poll_options" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "anonymous_votes_polls_anonymous_votes" FOREIGN KEY ("poll_id") REFERENCES "polls" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "anonymousvote_ballot_key_option_id" to table: "anonymous_votes"
+CREATE UNIQUE INDEX "anonymousvote_ballot_key_option_id" ON "anonymous_votes" ("ballot_key", "option_id");
+-- Create index "anonymousvote_option_id" to table: "anonymous_votes"
+CREATE INDEX "anonymousvote_option_id" ON "anonymous_votes" ("option_id");
+-- Create index "anonymousvote_poll_id" to table: "anonymous_votes"
+CREATE INDEX "anonymousvote_poll_id" ON "anonymous_votes" ("poll_id");
+-- Create "participations" table
+CREATE TABLE "participations" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "created_at" timestamptz NOT NULL,
+  "poll_id" bigint NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "participations_polls_participations" FOREIGN KEY ("poll_id") REFERENCES "polls" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "participations_users_participations" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "participation_user_id_poll_id" to table: "participations"
+CREATE UNIQUE INDEX "participation_user_id_poll_id" ON "participations" ("user_id", "poll_id");
@@ -0,0 +1,2 @@
+-- Modify "polls" table
+ALTER TABLE "polls" ADD COLUMN "results_visibility" character varying NOT NULL DEFAULT 'always';
@@ -0,0 +1,16 @@
+-- Modify "polls" table
+ALTER TABLE "polls" ADD COLUMN "visibility" character varying NOT NULL DEFAULT 'public', ADD COLUMN "share_slug" character varying NULL;
+-- Create index "polls_share_slug_key" to table: "polls"
+CREATE UNIQUE INDEX "polls_share_slug_key" ON "polls" ("share_slug");
+-- Create "poll_invitees" table
+CREATE TABLE "poll_invitees" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "created_at" timestamptz NOT NULL,
+  "poll_id" bigint NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "poll_invitees_polls_invitees" FOREIGN KEY ("poll_id") REFERENCES "polls" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "poll_invitees_users_poll_invitations" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "pollinvitee_poll_id_user_id" to table: "poll_invitees"
+CREATE UNIQUE INDEX "pollinvitee_poll_id_user_id" ON "poll_invitees" ("poll_id", "user_id");
@@ -0,0 +1,42 @@
+-- Create "organizations" table
+CREATE TABLE "organizations" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "name" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create "memberships" table
+CREATE TABLE "memberships" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "role" character varying NOT NULL DEFAULT 'member',
+  "created_at" timestamptz NOT NULL,
+  "organization_id" bigint NOT NULL,
+  "user_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "memberships_organizations_memberships" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "memberships_users_memberships" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "membership_organization_id_user_id" to table: "memberships"
+CREATE UNIQUE INDEX "membership_organization_id_user_id" ON "memberships" ("organization_id", "user_id");
+-- Create index "membership_user_id" to table: "memberships"
+CREATE INDEX "membership_user_id" ON "memberships" ("user_id");
+-- Create "organization_invitations" table
+CREATE TABLE "organization_invitations" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "email" character varying NOT NULL,
+  "role" character varying NOT NULL DEFAULT 'member',
+  "expires_at" timestamptz NOT NULL,
+  "accepted_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  "organization_id" bigint NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "organization_invitations_organizations_invitations" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "organizationinvitation_email" to table: "organization_invitations"
+CREATE INDEX "organizationinvitation_email" ON "organization_invitations" ("email");
+-- Create index "organizationinvitation_organization_id_email" to table: "organization_invitations"
+CREATE INDEX "organizationinvitation_organization_id_email" ON "organization_invitations" ("organization_id", "email");
+-- Modify "polls" table
+ALTER TABLE "polls" ADD COLUMN "organization_id" bigint NULL, ADD CONSTRAINT "polls_organizations_polls" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "poll_organization_id" to table: "polls"
+CREATE INDEX "poll_organization_id" ON "polls" ("organization_id");
@@ -0,0 +1,20 @@
+-- Create "idempotency_keys" table
+CREATE TABLE "idempotency_keys" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "key" character varying(255) NOT NULL,
+  "fingerprint" character varying NOT NULL,
+  "status_code" bigint NULL,
+  "content_type" character varying NULL,
+  "body" bytea NULL,
+  "created_at" timestamptz NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "user_id" bigint NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "idempotency_keys_users_idempotency_keys" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "idempotencykey_key_user_id" to table: "idempotency_keys"
+CREATE UNIQUE INDEX "idempotencykey_key_user_id" ON "idempotency_keys" ("key", "user_id");
+-- Create index "idempotencykey_key_anonymous" to table: "idempotency_keys"
+CREATE UNIQUE INDEX "idempotencykey_key_anonymous" ON "idempotency_keys" ("key") WHERE (user_id IS NULL);
+-- Create index "idempotencykey_expires_at" to table: "idempotency_keys"
+CREATE INDEX "idempotencykey_expires_at" ON "idempotency_keys" ("expires_at");
@@ -0,0 +1,5 @@
+-- Modify "polls" table
+ALTER TABLE "polls" ADD COLUMN "version" bigint NOT NULL DEFAULT 1, ADD COLUMN "updated_at" timestamptz NULL;
+-- Backfill the last change known: the manual close, if any
+UPDATE "polls" SET "updated_at" = COALESCE("closed_at", "created_at");
+ALTER TABLE "polls" ALTER COLUMN "updated_at" SET NOT NULL;
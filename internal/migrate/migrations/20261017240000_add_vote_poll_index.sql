@@ -0,0 +1,2 @@
+-- Create index "vote_poll_id_option_id" to table: "votes"
+CREATE INDEX "vote_poll_id_option_id" ON "votes" ("poll_id", "option_id");
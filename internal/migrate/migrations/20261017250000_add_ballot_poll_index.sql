@@ -0,0 +1,2 @@
+-- Create index "ballot_poll_id" to table: "ballots"
+CREATE INDEX "ballot_poll_id" ON "ballots" ("poll_id");
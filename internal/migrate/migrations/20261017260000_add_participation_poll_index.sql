@@ -0,0 +1,2 @@
+-- Create index "participation_poll_id" to table: "participations"
+CREATE INDEX "participation_poll_id" ON "participations" ("poll_id");
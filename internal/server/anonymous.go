@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
+	"github.com/ivankorhner/polling-app/internal/tally"
+)
+
+// Anonymous polls record that a user voted in participations and what was
+// chosen in anonymous_votes. Nothing links the two: anonymous votes have no
+// user, random IDs and no timestamps, and the vote history and webhooks name
+// neither the voter nor the options. With no way back to the ballot, votes on
+// anonymous polls cannot be changed or retracted.
+
+// errAnonymousBallot returns the error for changing or retracting a ballot
+// on an anonymous poll
+func errAnonymousBallot(action string) *ballotError {
+	return &ballotError{
+		message: "votes on anonymous polls cannot be " + action,
+		code:    ErrCodeConflict,
+		status:  http.StatusConflict,
+	}
+}
+
+// createAnonymousVote records the user's participation and their ballot,
+// apart, in a single transaction. The unique (user_id, poll_id) index on
+// participations rejects a second ballot.
+func createAnonymousVote(ctx context.Context, client *ent.Client, p *ent.Poll, optionIDs []int, scores map[int]int, userID int) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Participation.Create().
+		SetPollID(p.ID).
+		SetUserID(userID).
+		Exec(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = addAnonymousVotes(ctx, tx, p, uuid.New(), optionIDs, scores); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = recordVoteEvent(ctx, tx, p, userID, voteevent.ActionCast, optionIDs); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
+
+// addAnonymousVotes creates one anonymous vote per selected option on the
+// ballot with the given key, recording the rank or score the poll's voting
+// method counts
+func addAnonymousVotes(ctx context.Context, tx *ent.Tx, p *ent.Poll, ballotKey uuid.UUID, optionIDs []int, scores map[int]int) error {
+	kind := votingMethod(p.VotingMethod).Ballot()
+	creates := make([]*ent.AnonymousVoteCreate, len(optionIDs))
+	for i, optionID := range optionIDs {
+		creates[i] = tx.AnonymousVote.Create().
+			SetBallotKey(ballotKey).
+			SetPollID(p.ID).
+			SetOptionID(optionID)
+		switch kind {
+		case tally.RankedBallot:
+			creates[i].SetRank(i + 1)
+		case tally.ScoredBallot:
+			creates[i].SetScore(scores[optionID])
+		}
+	}
+	return tx.AnonymousVote.CreateBulk(creates...).Exec(ctx)
+}
+
+// loadAnonymousBallots returns the ballots of an anonymous poll like
+// loadBallots. Ballots come in the random order of their keys.
+func loadAnonymousBallots(ctx context.Context, client *ent.Client, pollID int) ([]tally.Ballot, error) {
+	votes, err := client.AnonymousVote.Query().
+		Where(anonymousvote.PollID(pollID)).
+		Order(ent.Asc(anonymousvote.FieldBallotKey), ent.Asc(anonymousvote.FieldRank), ent.Asc(anonymousvote.FieldOptionID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ballots []tally.Ballot
+	for i, v := range votes {
+		if i == 0 || v.BallotKey != votes[i-1].BallotKey {
+			ballots = append(ballots, tally.Ballot{})
+		}
+		b := &ballots[len(ballots)-1]
+		b.Options = append(b.Options, v.OptionID)
+		if v.Score != nil {
+			b.Scores = append(b.Scores, *v.Score)
+		}
+	}
+
+	return ballots, nil
+}
+
+// moveAnonymousVotes rewrites every anonymous ballot that selects the from
+// option so that it selects the to option in its place, as moveVotes does
+func moveAnonymousVotes(ctx context.Context, tx *ent.Tx, p *ent.Poll, from, to int) error {
+	moved, err := tx.AnonymousVote.Query().
+		Where(anonymousvote.OptionID(from)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	ballotKeys := make([]uuid.UUID, len(moved))
+	for i, v := range moved {
+		ballotKeys[i] = v.BallotKey
+	}
+
+	votes, err := tx.AnonymousVote.Query().
+		Where(anonymousvote.BallotKeyIn(ballotKeys...)).
+		Order(ent.Asc(anonymousvote.FieldBallotKey), ent.Asc(anonymousvote.FieldRank), ent.Asc(anonymousvote.FieldOptionID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for len(votes) > 0 {
+		n := 1
+		for n < len(votes) && votes[n].BallotKey == votes[0].BallotKey {
+			n++
+		}
+		ballot := make([]markedOption, n)
+		for i, v := range votes[:n] {
+			ballot[i] = markedOption{OptionID: v.OptionID, Score: v.Score}
+		}
+		key := votes[0].BallotKey
+		votes = votes[n:]
+
+		optionIDs, scores := moveSelection(ballot, from, to)
+		if _, err = tx.AnonymousVote.Delete().Where(anonymousvote.BallotKey(key)).Exec(ctx); err != nil {
+			return err
+		}
+		if err = addAnonymousVotes(ctx, tx, p, key, optionIDs, scores); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
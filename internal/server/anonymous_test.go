@@ -0,0 +1,269 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// anonymousFixture is an anonymous instant runoff poll with options A, B and
+// C, five voters who have ranked them and a logger that records everything the
+// handlers log
+type anonymousFixture struct {
+	owner   *ent.User
+	poll    *ent.Poll
+	options []*ent.PollOption
+	voters  []*ent.User
+	logs    *bytes.Buffer
+	logger  *slog.Logger
+}
+
+func setupAnonymousFixture(ctx context.Context, t *testing.T, testDB *testutil.TestDB) anonymousFixture {
+	t.Helper()
+
+	var logs bytes.Buffer
+	f := anonymousFixture{logs: &logs, logger: slog.New(slog.NewTextHandler(&logs, nil))}
+
+	f.owner = testutil.CreateUsers(ctx, t, testDB.Client, "owner")[0]
+
+	f.poll, f.options = testutil.CreatePoll(ctx, t, testDB.Client, f.owner.ID, "Team survey", []string{"A", "B", "C"}, func(c *ent.PollCreate) {
+		c.SetVotingMethod(entpoll.VotingMethodInstantRunoff).SetMaxSelections(3).SetAnonymous(true)
+	})
+
+	// Subscribe to votes to catch what webhooks would reveal
+	_, err := testDB.Client.Webhook.Create().
+		SetUserID(f.owner.ID).
+		SetURL("https://example.com/hook").
+		SetSecret(webhooks.NewSecret()).
+		SetEvents([]string{"vote"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	a, b, c := f.options[0].ID, f.options[1].ID, f.options[2].ID
+	f.voters = testutil.CreateUsers(ctx, t, testDB.Client, "voter0", "voter1", "voter2", "voter3", "voter4")
+	for i, ranking := range [][]int{{a, b}, {a, c}, {b, a}, {b, c}, {c, b}} {
+		rec := f.vote(testDB, http.MethodPost, f.voters[i].ID, server.VoteRequest{OptionIDs: ranking})
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	}
+	return f
+}
+
+// vote sends a ballot request for the fixture poll as the given user
+func (f anonymousFixture) vote(testDB *testutil.TestDB, method string, userID int, ballot server.VoteRequest) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(ballot)
+	req := httptest.NewRequest(method, fmt.Sprintf("/polls/%d/vote", f.poll.ID), bytes.NewReader(body))
+	req.SetPathValue("id", fmt.Sprintf("%d", f.poll.ID))
+	req = asUser(req, userID)
+	rec := httptest.NewRecorder()
+
+	bus := events.NewMemory(f.logger)
+	handler := server.HandleVote(f.logger, testDB.Client, bus)
+	switch method {
+	case http.MethodPut:
+		handler = server.HandleChangeVote(f.logger, testDB.Client, bus)
+	case http.MethodDelete:
+		handler = server.HandleRetractVote(f.logger, testDB.Client, bus)
+	}
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAnonymousPoll_Voting(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	f := setupAnonymousFixture(ctx, t, testDB)
+	a, b := f.options[0].ID, f.options[1].ID
+
+	tests := []struct {
+		name       string
+		method     string
+		userID     int
+		ballot     server.VoteRequest
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "second ballot",
+			method:     http.MethodPost,
+			userID:     f.voters[0].ID,
+			ballot:     server.VoteRequest{OptionIDs: []int{b}},
+			wantStatus: http.StatusConflict,
+			wantError:  "user has already voted on this poll",
+		},
+		{
+			name:       "change",
+			method:     http.MethodPut,
+			userID:     f.voters[0].ID,
+			ballot:     server.VoteRequest{OptionIDs: []int{b}},
+			wantStatus: http.StatusConflict,
+			wantError:  "votes on anonymous polls cannot be changed",
+		},
+		{
+			name:       "retract",
+			method:     http.MethodDelete,
+			userID:     f.voters[0].ID,
+			wantStatus: http.StatusConflict,
+			wantError:  "votes on anonymous polls cannot be retracted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.vote(testDB, tt.method, tt.userID, tt.ballot)
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			var errResp server.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+			assert.Equal(t, server.ErrCodeConflict, errResp.Code)
+			assert.Equal(t, tt.wantError, errResp.Detail)
+		})
+	}
+
+	// Counts and results come from the anonymous ballots
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/polls/%d/results", f.poll.ID), nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", f.poll.ID))
+	rec := httptest.NewRecorder()
+	server.HandleGetPollResults(f.logger, testDB.Client).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var results server.ResultsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &results))
+	assert.Equal(t, 5, results.BallotCount)
+	// C is eliminated first and its ballot transfers to B
+	assert.Equal(t, []int{b}, results.Winners)
+
+	// Moving votes off an option rewrites the anonymous ballots
+	req = httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/polls/%d/options/%d?move_to=%d", f.poll.ID, a, b), nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", f.poll.ID))
+	req.SetPathValue("optionID", fmt.Sprintf("%d", a))
+	req = asUser(req, f.owner.ID)
+	rec = httptest.NewRecorder()
+	server.HandleDeleteOption(f.logger, testDB.Client).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var poll server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+	assert.True(t, poll.Anonymous)
+	require.Len(t, poll.Options, 2)
+	assert.Equal(t, 4, *poll.Options[0].VoteCount)
+	assert.Equal(t, 1, *poll.Options[1].VoteCount)
+}
+
+func TestAnonymousPoll_NoUserChoiceLink(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	f := setupAnonymousFixture(ctx, t, testDB)
+
+	columns := func(table string) []string {
+		rows, err := testDB.DB.QueryContext(ctx,
+			`SELECT column_name FROM information_schema.columns WHERE table_name = $1 ORDER BY column_name`, table)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var names []string
+		for rows.Next() {
+			var name string
+			require.NoError(t, rows.Scan(&name))
+			names = append(names, name)
+		}
+		require.NoError(t, rows.Err())
+		return names
+	}
+	references := func(table string) []string {
+		rows, err := testDB.DB.QueryContext(ctx, `
+			SELECT DISTINCT ccu.table_name
+			FROM information_schema.table_constraints tc
+			JOIN information_schema.constraint_column_usage ccu ON ccu.constraint_name = tc.constraint_name
+			WHERE tc.table_name = $1 AND tc.constraint_type = 'FOREIGN KEY'
+			ORDER BY ccu.table_name`, table)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var tables []string
+		for rows.Next() {
+			var name string
+			require.NoError(t, rows.Scan(&name))
+			tables = append(tables, name)
+		}
+		require.NoError(t, rows.Err())
+		return tables
+	}
+
+	// The choices reference no user and carry nothing to order or time them
+	// by, and participations reference no choice
+	assert.Equal(t, []string{"ballot_key", "id", "option_id", "poll_id", "rank", "score"}, columns("anonymous_votes"))
+	assert.Equal(t, []string{"poll_options", "polls"}, references("anonymous_votes"))
+	assert.Equal(t, []string{"created_at", "id", "poll_id", "user_id"}, columns("participations"))
+	assert.Equal(t, []string{"polls", "users"}, references("participations"))
+
+	// The only column they share is the poll, so joining them pairs every
+	// participant with every ballot alike
+	var pairs int
+	err := testDB.DB.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT (p.user_id, a.ballot_key))
+		FROM participations p JOIN anonymous_votes a ON a.poll_id = p.poll_id
+		WHERE p.poll_id = $1`, f.poll.ID).Scan(&pairs)
+	require.NoError(t, err)
+	assert.Equal(t, 5*5, pairs, "each of 5 voters pairs with each of 5 ballots")
+
+	// Nothing is written where the user and the choice sit side by side
+	ballots, err := testDB.Client.Ballot.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, ballots)
+	votes, err := testDB.Client.Vote.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, votes)
+
+	history, err := testDB.Client.VoteEvent.Query().Where(voteevent.PollID(f.poll.ID)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, history, 5)
+	for _, e := range history {
+		assert.Nil(t, e.UserID)
+		assert.Empty(t, e.OptionIds)
+	}
+
+	deliveries, err := testDB.Client.WebhookDelivery.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 5)
+	for _, d := range deliveries {
+		var payload map[string]map[string]any
+		require.NoError(t, json.Unmarshal([]byte(d.Payload), &payload))
+		assert.Equal(t, map[string]any{"action": "cast"}, payload["vote"])
+	}
+
+	// The public history names no voters either
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/polls/%d/history", f.poll.ID), nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", f.poll.ID))
+	rec := httptest.NewRecorder()
+	server.HandleGetVoteHistory(f.logger, testDB.Client).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "user_id")
+
+	var response server.VoteHistoryResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, 5, response.Casts)
+
+	// Nor do the logs pair a voter with their choice
+	assert.NotContains(t, f.logs.String(), "option_ids")
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/apitoken"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	"github.com/ivankorhner/polling-app/internal/ent/user"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+const (
+	// tokenPrefix marks strings issued as API tokens
+	tokenPrefix = "pat_"
+	// tokenBytes is the number of random bytes in an API token
+	tokenBytes = 32
+	// tokenDisplayLength is the number of leading token characters kept for display
+	tokenDisplayLength = len(tokenPrefix) + 8
+)
+
+// newAPIToken generates a random API token and returns it along with the hash
+// and display prefix that are stored in its place
+func newAPIToken() (token, hash, prefix string) {
+	b := make([]byte, tokenBytes)
+	_, _ = rand.Read(b) // never returns an error
+	token = tokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, hashAPIToken(token), token[:tokenDisplayLength]
+}
+
+// hashAPIToken returns the hex-encoded SHA-256 of a token. Tokens carry 256
+// bits of randomness, so an unsalted fast hash is enough to make a leaked
+// table useless.
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueAPIToken stores a new token for the user and returns the token record
+// together with the plaintext token, which is not recoverable afterwards
+func issueAPIToken(ctx context.Context, tokens *ent.APITokenClient, userID int, name string) (*ent.APIToken, string, error) {
+	token, hash, prefix := newAPIToken()
+	t, err := tokens.Create().
+		SetUserID(userID).
+		SetName(name).
+		SetTokenHash(hash).
+		SetPrefix(prefix).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return t, token, nil
+}
+
+// resolveAPIToken returns a resolver that looks up unrevoked tokens by hash,
+// with the organizations their user manages, and records when they were last
+// used
+func resolveAPIToken(logger *slog.Logger, client *ent.Client) middleware.TokenResolver {
+	return func(ctx context.Context, token string) (middleware.Principal, error) {
+		t, err := client.APIToken.Query().
+			Where(apitoken.TokenHash(hashAPIToken(token)), apitoken.RevokedAtIsNil()).
+			WithUser(func(q *ent.UserQuery) {
+				q.WithMemberships(func(q *ent.MembershipQuery) {
+					q.Where(membership.RoleIn(membership.RoleOwner, membership.RoleAdmin))
+				})
+			}).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return middleware.Principal{}, middleware.ErrInvalidToken
+			}
+			return middleware.Principal{}, err
+		}
+
+		// Failing to record usage should not fail the request
+		if err := client.APIToken.UpdateOne(t).SetLastUsedAt(time.Now()).Exec(ctx); err != nil {
+			logger.LogAttrs(
+				ctx,
+				slog.LevelWarn,
+				"failed to record token use",
+				slog.String("error", err.Error()),
+				slog.Int("token_id", t.ID),
+			)
+		}
+
+		managed := make([]int, len(t.Edges.User.Edges.Memberships))
+		for i, m := range t.Edges.User.Edges.Memberships {
+			managed[i] = m.OrganizationID
+		}
+
+		return middleware.Principal{
+			UserID:               t.UserID,
+			TokenID:              t.ID,
+			Admin:                t.Edges.User.Role == user.RoleAdmin,
+			ManagedOrganizations: managed,
+		}, nil
+	}
+}
+
+// writeAuthenticationError responds to a request whose credentials could not
+// be resolved
+func writeAuthenticationError(logger *slog.Logger) func(w http.ResponseWriter, r *http.Request, err error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		if errors.Is(err, middleware.ErrMalformedAuthorization) || errors.Is(err, middleware.ErrInvalidToken) {
+			writeUnauthorizedError(w, r, err.Error())
+			return
+		}
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelError,
+			"failed to resolve token",
+			slog.String("error", err.Error()),
+		)
+		writeInternalError(w, r, "failed to authenticate request")
+	}
+}
+
+// requirePrincipal returns the authenticated principal of the request. It
+// writes a 401 response and returns false for unauthenticated requests.
+func requirePrincipal(w http.ResponseWriter, r *http.Request) (middleware.Principal, bool) {
+	p, ok := middleware.PrincipalFromContext(r.Context())
+	if !ok {
+		writeUnauthorizedError(w, r, "authentication required")
+	}
+	return p, ok
+}
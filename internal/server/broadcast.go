@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/ivankorhner/polling-app/internal/events"
+)
+
+// Broadcaster notifies subscribers when the votes on a poll change.
+// Notifications carry no payload: subscribers reload the poll when woken, so
+// a burst of votes collapses into a single pending notification.
+type Broadcaster struct {
+	mu          sync.Mutex
+	subscribers map[int]map[chan struct{}]struct{}
+}
+
+// NewBroadcaster creates a broadcaster with no subscribers
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{subscribers: make(map[int]map[chan struct{}]struct{})}
+}
+
+// Subscribe registers for notifications about the poll. The returned function
+// removes the subscription and must be called once the caller stops reading.
+func (b *Broadcaster) Subscribe(pollID int) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	b.mu.Lock()
+	if b.subscribers[pollID] == nil {
+		b.subscribers[pollID] = make(map[chan struct{}]struct{})
+	}
+	b.subscribers[pollID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers[pollID], ch)
+		if len(b.subscribers[pollID]) == 0 {
+			delete(b.subscribers, pollID)
+		}
+	}
+}
+
+// Publish wakes every subscriber of the poll. It never blocks: a subscriber
+// that has not yet consumed its previous notification keeps that one.
+func (b *Broadcaster) Publish(pollID int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers[pollID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Relay wakes the subscribers of every poll the bus reports an event for,
+// until ctx is canceled
+func (b *Broadcaster) Relay(ctx context.Context, bus events.Bus) {
+	received, unsubscribe := bus.Subscribe()
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-received:
+				if !ok {
+					return
+				}
+				b.Publish(e.PollID)
+			}
+		}
+	}()
+}
+
+// publishEvent publishes a poll event on the bus. The change it reports is
+// already committed, so a failure is logged rather than returned.
+func publishEvent(ctx context.Context, logger *slog.Logger, bus events.Bus, eventType events.Type, pollID int) {
+	if err := bus.Publish(ctx, events.Event{Type: eventType, PollID: pollID}); err != nil {
+		logger.LogAttrs(
+			ctx,
+			slog.LevelError,
+			"failed to publish event",
+			slog.String("error", err.Error()),
+			slog.String("type", string(eventType)),
+			slog.Int("poll_id", pollID),
+		)
+	}
+}
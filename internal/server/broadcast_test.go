@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ivankorhner/polling-app/internal/events"
+)
+
+func TestBroadcaster(t *testing.T) {
+	b := NewBroadcaster()
+
+	first, unsubscribeFirst := b.Subscribe(1)
+	second, unsubscribeSecond := b.Subscribe(1)
+	defer unsubscribeSecond()
+	other, unsubscribeOther := b.Subscribe(2)
+	defer unsubscribeOther()
+
+	// A burst of publishes leaves one pending notification per subscriber
+	b.Publish(1)
+	b.Publish(1)
+	assert.Len(t, first, 1)
+	assert.Len(t, second, 1)
+	assert.Empty(t, other)
+
+	<-first
+	unsubscribeFirst()
+	b.Publish(1)
+	assert.Empty(t, first)
+	assert.Len(t, second, 1)
+
+	// Publishing to a poll without subscribers is a no-op
+	b.Publish(3)
+}
+
+func TestBroadcaster_Relay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bus := events.NewMemory(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	b := NewBroadcaster()
+	b.Relay(ctx, bus)
+
+	notify, unsubscribe := b.Subscribe(1)
+	defer unsubscribe()
+
+	require.NoError(t, bus.Publish(ctx, events.Event{Type: events.TypeVote, PollID: 1}))
+
+	select {
+	case <-notify:
+	case <-time.After(time.Second):
+		t.Fatal("relayed event did not wake the subscriber")
+	}
+}
@@ -0,0 +1,326 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/config"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// contract checks responses against the OpenAPI document the server serves
+type contract struct {
+	t        *testing.T
+	spec     map[string]any
+	compiler *jsonschema.Compiler
+	schemas  map[string]*jsonschema.Schema
+	matcher  *http.ServeMux
+	covered  map[string]bool
+}
+
+// newContract loads the OpenAPI document from the handler itself and routes
+// each of its operations to the pattern it documents
+func newContract(t *testing.T, handler http.Handler) *contract {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/openapi.json", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var spec map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spec))
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(rec.Body.Bytes()))
+	require.NoError(t, err)
+
+	c := &contract{
+		t:        t,
+		spec:     spec,
+		compiler: jsonschema.NewCompiler(),
+		schemas:  map[string]*jsonschema.Schema{},
+		matcher:  http.NewServeMux(),
+		covered:  map[string]bool{},
+	}
+	c.compiler.DefaultDraft(jsonschema.Draft2020)
+	c.compiler.AssertFormat()
+	require.NoError(t, c.compiler.AddResource("openapi.json", doc))
+
+	for path, item := range spec["paths"].(map[string]any) {
+		for _, method := range []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+			if _, ok := item.(map[string]any)[strings.ToLower(method)]; ok {
+				c.matcher.Handle(method+" "+path, http.NotFoundHandler())
+				c.covered[method+" "+path] = false
+			}
+		}
+	}
+	return c
+}
+
+// resolve follows a local $ref of the OpenAPI document
+func (c *contract) resolve(value map[string]any) map[string]any {
+	for {
+		ref, ok := value["$ref"].(string)
+		if !ok {
+			return value
+		}
+		node := any(c.spec)
+		for _, key := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			node = node.(map[string]any)[key]
+		}
+		value = node.(map[string]any)
+	}
+}
+
+// schema compiles a response schema, which names one of the components or
+// is given inline
+func (c *contract) schema(key string, schema map[string]any) *jsonschema.Schema {
+	loc := "openapi.json" + fmt.Sprint(schema["$ref"])
+	if _, ok := schema["$ref"]; !ok {
+		loc = "inline:" + url.PathEscape(key)
+		if _, done := c.schemas[loc]; !done {
+			require.NoError(c.t, c.compiler.AddResource(loc, schema))
+		}
+	}
+	if compiled, ok := c.schemas[loc]; ok {
+		return compiled
+	}
+	compiled, err := c.compiler.Compile(loc)
+	require.NoError(c.t, err)
+	c.schemas[loc] = compiled
+	return compiled
+}
+
+// check asserts that a response to the request is one the OpenAPI document
+// describes for the operation the request was routed to
+func (c *contract) check(req *http.Request, rec *httptest.ResponseRecorder) {
+	c.t.Helper()
+
+	// Operations are served under the document's /v1 server unless they
+	// name a server of their own
+	match := req.Clone(req.Context())
+	var versioned bool
+	match.URL.Path, versioned = strings.CutPrefix(req.URL.Path, "/v1")
+	_, pattern := c.matcher.Handler(match)
+	require.NotEmpty(c.t, pattern, "%s %s is documented", req.Method, req.URL.Path)
+	c.covered[pattern] = true
+
+	method, path, _ := strings.Cut(pattern, " ")
+	item := c.spec["paths"].(map[string]any)[path].(map[string]any)
+	_, ownServers := item["servers"]
+	assert.Equal(c.t, !ownServers, versioned, "%s is served under /v1", pattern)
+	assert.Empty(c.t, rec.Header().Get("Deprecation"), "%s is current", req.URL.Path)
+	op := item[strings.ToLower(method)].(map[string]any)
+	responses := op["responses"].(map[string]any)
+	response, ok := responses[strconv.Itoa(rec.Code)].(map[string]any)
+	if !ok {
+		response, ok = responses["default"].(map[string]any)
+	}
+	require.True(c.t, ok, "%s documents status %d", pattern, rec.Code)
+	response = c.resolve(response)
+
+	content, ok := response["content"].(map[string]any)
+	if !ok {
+		assert.Empty(c.t, rec.Body.String(), "%s %d has no body", pattern, rec.Code)
+		return
+	}
+	mediaType, _, err := mime.ParseMediaType(rec.Header().Get("Content-Type"))
+	require.NoError(c.t, err, "%s %d has a content type", pattern, rec.Code)
+	media, ok := content[mediaType].(map[string]any)
+	require.True(c.t, ok, "%s %d documents %s", pattern, rec.Code, mediaType)
+	if mediaType != "application/json" && mediaType != server.ProblemContentType {
+		return
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(rec.Body.Bytes()))
+	require.NoError(c.t, err)
+	key := fmt.Sprintf("%s/%d/%s", pattern, rec.Code, mediaType)
+	assert.NoError(c.t, c.schema(key, media["schema"].(map[string]any)).Validate(instance), "%s %d: %s", pattern, rec.Code, rec.Body.String())
+}
+
+func TestOpenAPIContract(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	handler := server.AddRoutes(ctx, &config.Config{APITimeout: time.Minute}, logger, testDB.DB, testDB.Client, events.NewMemory(logger))
+	c := newContract(t, handler)
+
+	serve := func(method, path, token, body string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		c.check(req, rec)
+		return rec
+	}
+	decode := func(rec *httptest.ResponseRecorder, wantStatus int, v any) {
+		require.Equal(t, wantStatus, rec.Code, rec.Body.String())
+		if v != nil {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), v))
+		}
+	}
+	register := func(username string) server.RegisteredUserResponse {
+		body := fmt.Sprintf(`{"username": %q, "email": "%s@example.com"}`, username, username)
+		var registered server.RegisteredUserResponse
+		decode(serve(http.MethodPost, "/v1/users", "", body, map[string]string{"Idempotency-Key": "register-" + username}), http.StatusCreated, &registered)
+		return registered
+	}
+
+	decode(serve(http.MethodGet, "/health", "", "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, "/v1/openapi.json", "", "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, "/v1/docs", "", "", nil), http.StatusOK, nil)
+
+	// Users and tokens
+	owner := register("owner")
+	voter := register("voter")
+	ownerPath := fmt.Sprintf("/v1/users/%d", owner.ID)
+	voterPath := fmt.Sprintf("/v1/users/%d", voter.ID)
+	decode(serve(http.MethodPost, "/v1/users", "", `{"username": "x"}`, nil), http.StatusBadRequest, nil)
+	decode(serve(http.MethodGet, "/v1/users?limit=1", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, ownerPath, voter.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodPatch, ownerPath, owner.Token, `{"username": "owner2"}`, nil), http.StatusOK, nil)
+	decode(serve(http.MethodPatch, ownerPath, voter.Token, `{"username": "owner3"}`, nil), http.StatusForbidden, nil)
+	decode(serve(http.MethodGet, "/v1/me", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, "/v1/me", "", "", nil), http.StatusUnauthorized, nil)
+
+	var token server.CreatedTokenResponse
+	decode(serve(http.MethodPost, "/v1/tokens", owner.Token, `{"name": "cli"}`, nil), http.StatusCreated, &token)
+	decode(serve(http.MethodGet, "/v1/tokens", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodDelete, fmt.Sprintf("/v1/tokens/%d", token.ID), owner.Token, "", nil), http.StatusNoContent, nil)
+
+	// Organizations, their members and invitations
+	var org server.OrganizationResponse
+	decode(serve(http.MethodPost, "/v1/orgs", owner.Token, `{"name": "Acme"}`, nil), http.StatusCreated, &org)
+	orgPath := fmt.Sprintf("/v1/orgs/%d", org.ID)
+	decode(serve(http.MethodGet, "/v1/orgs", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, orgPath, owner.Token, "", nil), http.StatusOK, nil)
+
+	var invitation server.InvitationResponse
+	decode(serve(http.MethodPost, orgPath+"/invitations", owner.Token, `{"email": "voter@example.com"}`, nil), http.StatusCreated, &invitation)
+	decode(serve(http.MethodGet, orgPath+"/invitations", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, "/v1/invitations", voter.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodPost, fmt.Sprintf("/v1/invitations/%d/accept", invitation.ID), voter.Token, "", nil), http.StatusCreated, nil)
+	decode(serve(http.MethodGet, orgPath+"/members", owner.Token, "", nil), http.StatusOK, nil)
+	memberPath := fmt.Sprintf("%s/members/%d", orgPath, voter.ID)
+	decode(serve(http.MethodPatch, memberPath, owner.Token, `{"role": "admin"}`, nil), http.StatusOK, nil)
+
+	decode(serve(http.MethodPost, orgPath+"/invitations", owner.Token, `{"email": "guest@example.com", "role": "member"}`, nil), http.StatusCreated, &invitation)
+	decode(serve(http.MethodDelete, fmt.Sprintf("%s/invitations/%d", orgPath, invitation.ID), owner.Token, "", nil), http.StatusNoContent, nil)
+	decode(serve(http.MethodDelete, memberPath, owner.Token, "", nil), http.StatusNoContent, nil)
+
+	// A poll through its lifecycle
+	var poll server.PollResponse
+	decode(serve(http.MethodPost, "/v1/polls", owner.Token, `{"title": "Lunch?", "options": ["Soup", "Salad", "Stew"]}`,
+		map[string]string{"Idempotency-Key": "create-lunch"}), http.StatusCreated, &poll)
+	pollPath := fmt.Sprintf("/v1/polls/%d", poll.ID)
+	decode(serve(http.MethodPost, "/v1/polls", owner.Token, `{"title": " ", "options": ["Soup"]}`, nil), http.StatusBadRequest, nil)
+	decode(serve(http.MethodGet, "/v1/polls?sort=most_votes&status=open", voter.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, "/v1/polls/999999", voter.Token, "", nil), http.StatusNotFound, nil)
+
+	rec := serve(http.MethodGet, pollPath, owner.Token, "", nil)
+	decode(rec, http.StatusOK, nil)
+	etag := rec.Header().Get("ETag")
+	decode(serve(http.MethodGet, pollPath, owner.Token, "", map[string]string{"If-None-Match": etag}), http.StatusNotModified, nil)
+	rec = serve(http.MethodPatch, pollPath, owner.Token, `{"title": "Lunch today?"}`, map[string]string{"If-Match": etag})
+	decode(rec, http.StatusOK, nil)
+	decode(serve(http.MethodPatch, pollPath, owner.Token, `{"title": "Dinner?"}`, map[string]string{"If-Match": etag}), http.StatusPreconditionFailed, nil)
+
+	decode(serve(http.MethodPost, pollPath+"/options", owner.Token, `{"text": "Curry"}`, nil), http.StatusCreated, &poll)
+	decode(serve(http.MethodPatch, fmt.Sprintf("%s/options/%d", pollPath, poll.Options[1].ID), owner.Token, `{"text": "Salad bar"}`, nil), http.StatusOK, nil)
+	decode(serve(http.MethodDelete, fmt.Sprintf("%s/options/%d", pollPath, poll.Options[3].ID), owner.Token, "", nil), http.StatusOK, nil)
+
+	vote := fmt.Sprintf(`{"option_id": %d}`, poll.Options[0].ID)
+	decode(serve(http.MethodPost, pollPath+"/vote", voter.Token, vote, map[string]string{"Idempotency-Key": "vote-lunch"}), http.StatusOK, nil)
+	decode(serve(http.MethodPost, pollPath+"/vote", voter.Token, fmt.Sprintf(`{"option_id": %d}`, poll.Options[1].ID),
+		map[string]string{"Idempotency-Key": "vote-lunch"}), http.StatusUnprocessableEntity, nil)
+	decode(serve(http.MethodPut, pollPath+"/vote", voter.Token, fmt.Sprintf(`{"option_id": %d}`, poll.Options[1].ID), nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, pollPath+"/results", voter.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, pollPath+"/history", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, "/v1/me/votes", voter.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, voterPath+"/votes", voter.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodDelete, pollPath+"/vote", voter.Token, "", nil), http.StatusOK, nil)
+
+	decode(serve(http.MethodPost, pollPath+"/close", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodPost, pollPath+"/vote", voter.Token, vote, nil), http.StatusConflict, nil)
+	decode(serve(http.MethodPost, pollPath+"/reopen", owner.Token, "", nil), http.StatusOK, nil)
+
+	var draft server.PollResponse
+	decode(serve(http.MethodPost, "/v1/polls", owner.Token, `{"title": "Rank lunch", "options": ["Soup", "Salad"], "voting_method": "instant_runoff", "draft": true}`, nil),
+		http.StatusCreated, &draft)
+	decode(serve(http.MethodPost, fmt.Sprintf("/v1/polls/%d/publish", draft.ID), owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, "/v1/me/polls", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, ownerPath+"/polls?limit=1", voter.Token, "", nil), http.StatusOK, nil)
+
+	// A private poll reached by its share slug
+	var private server.PollResponse
+	decode(serve(http.MethodPost, "/v1/polls", owner.Token, `{"title": "Secret?", "options": ["Yes", "No"], "visibility": "private"}`, nil),
+		http.StatusCreated, &private)
+	require.NotNil(t, private.ShareSlug)
+	privatePath := fmt.Sprintf("/v1/polls/%d", private.ID)
+	slugPath := "/v1/p/" + *private.ShareSlug
+	decode(serve(http.MethodPost, privatePath+"/invitees", owner.Token, fmt.Sprintf(`{"user_id": %d}`, voter.ID), nil), http.StatusCreated, nil)
+	decode(serve(http.MethodGet, privatePath+"/invitees", owner.Token, "", nil), http.StatusOK, nil)
+
+	decode(serve(http.MethodGet, slugPath, voter.Token, "", nil), http.StatusOK, nil)
+	privateVote := fmt.Sprintf(`{"option_id": %d}`, private.Options[0].ID)
+	decode(serve(http.MethodPost, slugPath+"/vote", voter.Token, privateVote, map[string]string{"Idempotency-Key": "vote-secret"}), http.StatusOK, nil)
+	decode(serve(http.MethodPut, slugPath+"/vote", voter.Token, fmt.Sprintf(`{"option_id": %d}`, private.Options[1].ID), nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, slugPath+"/results", voter.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, slugPath+"/history", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodDelete, slugPath+"/vote", voter.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodDelete, fmt.Sprintf("%s/invitees/%d", privatePath, voter.ID), owner.Token, "", nil), http.StatusNoContent, nil)
+
+	// Webhooks
+	var webhook server.CreatedWebhookResponse
+	decode(serve(http.MethodPost, "/v1/webhooks", owner.Token, `{"url": "https://example.com/hook", "events": ["vote", "poll_closed"]}`, nil),
+		http.StatusCreated, &webhook)
+	webhookPath := fmt.Sprintf("/v1/webhooks/%d", webhook.ID)
+	decode(serve(http.MethodPost, "/v1/webhooks", owner.Token, `{"url": "ftp://example.com", "events": ["nope"]}`, nil), http.StatusBadRequest, nil)
+	decode(serve(http.MethodGet, "/v1/webhooks", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, webhookPath, owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodGet, webhookPath+"/deliveries?limit=5", owner.Token, "", nil), http.StatusOK, nil)
+	decode(serve(http.MethodDelete, webhookPath, owner.Token, "", nil), http.StatusNoContent, nil)
+
+	// Deletions
+	decode(serve(http.MethodDelete, pollPath, owner.Token, "", nil), http.StatusNoContent, nil)
+	decode(serve(http.MethodDelete, orgPath, owner.Token, "", nil), http.StatusNoContent, nil)
+	decode(serve(http.MethodDelete, voterPath, voter.Token, "", nil), http.StatusNoContent, nil)
+
+	// Streams stay open until the client leaves, so they are left to the
+	// tests of their own handlers
+	streams := map[string]bool{
+		"GET /polls/{id}/events": true,
+		"GET /p/{slug}/events":   true,
+		"GET /ws":                true,
+	}
+	for pattern, covered := range c.covered {
+		if !streams[pattern] {
+			assert.True(t, covered, "%s is exercised by the contract test", pattern)
+		}
+	}
+}
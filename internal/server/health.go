@@ -16,7 +16,7 @@ func HandleHealth(logger *slog.Logger, db *sql.DB) http.Handler {
 				"health check failed: database unavailable",
 				slog.String("error", err.Error()),
 			)
-			writeError(w, "database unavailable", ErrCodeInternal, http.StatusServiceUnavailable)
+			writeError(w, r, "database unavailable", ErrCodeInternal, http.StatusServiceUnavailable)
 			return
 		}
 
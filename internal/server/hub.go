@@ -0,0 +1,342 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// hubClientBuffer is how many messages a WebSocket client may fall behind
+// before the hub drops it
+const hubClientBuffer = 32
+
+// Hub fans live vote counts and viewer counts out to WebSocket clients. It
+// watches the broadcaster for every poll that has subscribers and never
+// blocks on a client: one whose queue is full is dropped instead. Vote
+// counts only reach clients the poll's results are visible to. Votes reach
+// every replica's hub through the event bus, but viewers are only counted
+// per replica: each hub reports the clients connected to it.
+type Hub struct {
+	ctx         context.Context
+	logger      *slog.Logger
+	client      *ent.Client
+	broadcaster *Broadcaster
+
+	mu    sync.Mutex
+	polls map[int]*hubPoll
+}
+
+// hubPoll is a poll with subscribers and the watcher counting its votes
+type hubPoll struct {
+	clients map[*hubClient]struct{}
+	stop    context.CancelFunc
+}
+
+// hubClient is the queue of messages waiting to be written to a WebSocket
+// connection. The hub closes dropped when the connection falls too far
+// behind.
+type hubClient struct {
+	principal middleware.Principal
+	send      chan []byte
+	dropped   chan struct{}
+	polls     map[int]struct{} // guarded by Hub.mu
+}
+
+// NewHub creates a hub that watches polls until ctx is canceled
+func NewHub(ctx context.Context, logger *slog.Logger, client *ent.Client, broadcaster *Broadcaster) *Hub {
+	return &Hub{
+		ctx:         ctx,
+		logger:      logger,
+		client:      client,
+		broadcaster: broadcaster,
+		polls:       make(map[int]*hubPoll),
+	}
+}
+
+// register adds a client for the principal, which is the zero value for
+// unauthenticated connections. The client is not yet subscribed to any poll.
+func (h *Hub) register(principal middleware.Principal) *hubClient {
+	return &hubClient{
+		principal: principal,
+		send:      make(chan []byte, hubClientBuffer),
+		dropped:   make(chan struct{}),
+		polls:     make(map[int]struct{}),
+	}
+}
+
+// unregister unsubscribes the client from every poll
+func (h *Hub) unregister(c *hubClient) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for pollID := range c.polls {
+		h.leaveLocked(c, pollID)
+	}
+}
+
+// subscribe adds the client to the poll's viewers and queues a snapshot of
+// the poll for it. Every viewer is then told the new viewer count. It returns
+// a not-found error if the poll does not exist or is hidden from the client.
+func (h *Hub) subscribe(ctx context.Context, c *hubClient, pollID int) error {
+	// Access is checked before joining, so clients who may not see the poll
+	// never count as its viewers nor receive its messages
+	if _, err := queryViewablePolls(ctx, h.client, c.principal).Where(entpoll.ID(pollID)).OnlyID(ctx); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	if _, ok := c.polls[pollID]; !ok {
+		h.joinLocked(c, pollID)
+	}
+	h.mu.Unlock()
+
+	// The watcher is already listening, so no vote after the snapshot is
+	// missed
+	snapshot, err := loadPollResponse(ctx, h.client, pollID, c.principal)
+	if err != nil {
+		h.unsubscribe(c, pollID)
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	p, ok := h.polls[pollID]
+	if _, subscribed := c.polls[pollID]; !ok || !subscribed {
+		return nil
+	}
+	h.sendLocked(c, WSMessage{Type: wsSubscribed, PollID: pollID, Poll: &snapshot})
+	h.fanOutLocked(pollID, WSMessage{Type: wsPresence, PollID: pollID, Viewers: len(p.clients)})
+	return nil
+}
+
+// unsubscribe removes the client from the poll's viewers and tells the
+// remaining viewers the new count
+func (h *Hub) unsubscribe(c *hubClient, pollID int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := c.polls[pollID]; ok {
+		h.leaveLocked(c, pollID)
+	}
+}
+
+// send queues a message for a single client
+func (h *Hub) send(c *hubClient, msg WSMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.sendLocked(c, msg)
+}
+
+// joinLocked adds the client to the poll, starting a watcher for the poll's
+// first viewer
+func (h *Hub) joinLocked(c *hubClient, pollID int) {
+	p, ok := h.polls[pollID]
+	if !ok {
+		ctx, stop := context.WithCancel(h.ctx)
+		p = &hubPoll{clients: make(map[*hubClient]struct{}), stop: stop}
+		h.polls[pollID] = p
+
+		notify, unsubscribe := h.broadcaster.Subscribe(pollID)
+		go h.watch(ctx, p, pollID, notify, unsubscribe)
+	}
+	p.clients[c] = struct{}{}
+	c.polls[pollID] = struct{}{}
+}
+
+// leaveLocked removes the client from the poll, stopping the watcher once
+// the poll has no viewers left
+func (h *Hub) leaveLocked(c *hubClient, pollID int) {
+	delete(c.polls, pollID)
+	p, ok := h.polls[pollID]
+	if !ok {
+		return
+	}
+	delete(p.clients, c)
+	if len(p.clients) == 0 {
+		p.stop()
+		delete(h.polls, pollID)
+		return
+	}
+	h.fanOutLocked(pollID, WSMessage{Type: wsPresence, PollID: pollID, Viewers: len(p.clients)})
+}
+
+// sendLocked queues a message for the client, dropping the client if its
+// queue is full
+func (h *Hub) sendLocked(c *hubClient, msg WSMessage) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		h.logger.LogAttrs(h.ctx, slog.LevelError, "failed to encode websocket message", slog.String("error", err.Error()))
+		return
+	}
+	if !c.enqueue(data) {
+		h.dropLocked(c)
+	}
+}
+
+// fanOutLocked queues a message for every viewer of the poll, dropping
+// viewers whose queue is full
+func (h *Hub) fanOutLocked(pollID int, msg WSMessage) {
+	h.fanOutWhereLocked(pollID, msg, func(*hubClient) bool { return true })
+}
+
+// fanOutWhereLocked queues a message for the viewers of the poll that
+// include accepts, dropping viewers whose queue is full
+func (h *Hub) fanOutWhereLocked(pollID int, msg WSMessage, include func(*hubClient) bool) {
+	p, ok := h.polls[pollID]
+	if !ok {
+		return
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		h.logger.LogAttrs(h.ctx, slog.LevelError, "failed to encode websocket message", slog.String("error", err.Error()))
+		return
+	}
+
+	var slow []*hubClient
+	for c := range p.clients {
+		if !include(c) {
+			continue
+		}
+		if !c.enqueue(data) {
+			slow = append(slow, c)
+		}
+	}
+	for _, c := range slow {
+		h.dropLocked(c)
+	}
+}
+
+// dropLocked disconnects a client that cannot keep up and removes it from
+// every poll
+func (h *Hub) dropLocked(c *hubClient) {
+	select {
+	case <-c.dropped:
+		return
+	default:
+		close(c.dropped)
+	}
+
+	h.logger.LogAttrs(h.ctx, slog.LevelWarn, "dropping slow websocket client", slog.Int("polls", len(c.polls)))
+	for pollID := range c.polls {
+		h.leaveLocked(c, pollID)
+	}
+}
+
+// watch sends the poll's viewers the options whose vote counts changed each
+// time the broadcaster reports a vote, until ctx is canceled
+func (h *Hub) watch(ctx context.Context, p *hubPoll, pollID int, notify <-chan struct{}, unsubscribe func()) {
+	defer unsubscribe()
+
+	counts, err := voteCounts(ctx, h.client, pollID)
+	if err != nil && ctx.Err() == nil {
+		h.logger.LogAttrs(
+			ctx,
+			slog.LevelError,
+			"failed to count votes",
+			slog.String("error", err.Error()),
+			slog.Int("poll_id", pollID),
+		)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-notify:
+			next, err := voteCounts(ctx, h.client, pollID)
+			if err != nil {
+				if ctx.Err() == nil {
+					h.logger.LogAttrs(
+						ctx,
+						slog.LevelError,
+						"failed to count votes",
+						slog.String("error", err.Error()),
+						slog.Int("poll_id", pollID),
+					)
+				}
+				continue
+			}
+
+			changes := countChanges(counts, next)
+			counts = next
+			if len(changes) == 0 {
+				continue
+			}
+
+			visible, err := h.resultsViewers(ctx, p, pollID)
+			if err != nil {
+				if ctx.Err() == nil {
+					h.logger.LogAttrs(
+						ctx,
+						slog.LevelError,
+						"failed to check results visibility",
+						slog.String("error", err.Error()),
+						slog.Int("poll_id", pollID),
+					)
+				}
+				continue
+			}
+
+			h.mu.Lock()
+			// A poll whose viewers all left may have been watched again since
+			if h.polls[pollID] == p {
+				h.fanOutWhereLocked(pollID, WSMessage{Type: wsDelta, PollID: pollID, Changes: changes}, func(c *hubClient) bool {
+					return visible[pollViewer{pollID: pollID, userID: c.principal.UserID}]
+				})
+			}
+			h.mu.Unlock()
+		}
+	}
+}
+
+// resultsViewers decides which of the poll's current viewers may see its
+// vote counts. Viewers who join later have loaded a snapshot after the
+// change and need no delta.
+func (h *Hub) resultsViewers(ctx context.Context, p *hubPoll, pollID int) (map[pollViewer]bool, error) {
+	h.mu.Lock()
+	principals := make([]middleware.Principal, 0, len(p.clients))
+	for c := range p.clients {
+		principals = append(principals, c.principal)
+	}
+	h.mu.Unlock()
+
+	poll, err := h.client.Poll.Get(ctx, pollID)
+	if err != nil {
+		return nil, err
+	}
+	return visibleResults(ctx, h.client, []*ent.Poll{poll}, principals)
+}
+
+// enqueue queues a message without blocking, reporting whether there was
+// room for it
+func (c *hubClient) enqueue(data []byte) bool {
+	select {
+	case c.send <- data:
+		return true
+	default:
+		return false
+	}
+}
+
+// countChanges returns the options whose vote count differs between two
+// counts, ordered by option ID
+func countChanges(before, after map[int]int) []OptionDelta {
+	var changes []OptionDelta
+	for optionID, count := range after {
+		if count != before[optionID] {
+			changes = append(changes, OptionDelta{OptionID: optionID, VoteCount: count, Delta: count - before[optionID]})
+		}
+	}
+	for optionID, count := range before {
+		if _, ok := after[optionID]; !ok && count != 0 {
+			changes = append(changes, OptionDelta{OptionID: optionID, VoteCount: 0, Delta: -count})
+		}
+	}
+	slices.SortFunc(changes, func(a, b OptionDelta) int { return a.OptionID - b.OptionID })
+	return changes
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+func TestCountChanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		before map[int]int
+		after  map[int]int
+		want   []OptionDelta
+	}{
+		{
+			name:   "no change",
+			before: map[int]int{1: 2},
+			after:  map[int]int{1: 2},
+			want:   nil,
+		},
+		{
+			name:   "first vote",
+			before: map[int]int{},
+			after:  map[int]int{1: 1},
+			want:   []OptionDelta{{OptionID: 1, VoteCount: 1, Delta: 1}},
+		},
+		{
+			name:   "vote moved to another option",
+			before: map[int]int{1: 1, 2: 3},
+			after:  map[int]int{2: 4, 3: 1},
+			want: []OptionDelta{
+				{OptionID: 1, VoteCount: 0, Delta: -1},
+				{OptionID: 2, VoteCount: 4, Delta: 1},
+				{OptionID: 3, VoteCount: 1, Delta: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, countChanges(tt.before, tt.after))
+		})
+	}
+}
+
+func TestHub_DropsSlowClients(t *testing.T) {
+	h := NewHub(context.Background(), slog.New(slog.NewTextHandler(io.Discard, nil)), nil, NewBroadcaster())
+
+	slow, fast := h.register(middleware.Principal{}), h.register(middleware.Principal{})
+	h.polls[1] = &hubPoll{
+		clients: map[*hubClient]struct{}{slow: {}, fast: {}},
+		stop:    func() {},
+	}
+	slow.polls[1] = struct{}{}
+	fast.polls[1] = struct{}{}
+
+	// Fill the slow client's queue without draining it
+	for range hubClientBuffer {
+		require.True(t, slow.enqueue([]byte("{}")))
+	}
+
+	h.mu.Lock()
+	h.fanOutLocked(1, WSMessage{Type: wsDelta, PollID: 1, Changes: []OptionDelta{{OptionID: 1, VoteCount: 1, Delta: 1}}})
+	h.mu.Unlock()
+
+	// The slow client is disconnected instead of blocking the fan-out
+	select {
+	case <-slow.dropped:
+	default:
+		t.Fatal("slow client was not dropped")
+	}
+	assert.Empty(t, slow.polls)
+	assert.NotContains(t, h.polls[1].clients, slow)
+
+	// The fast client gets the delta, then the reduced viewer count
+	require.Len(t, fast.send, 2)
+	var delta, presence WSMessage
+	require.NoError(t, json.Unmarshal(<-fast.send, &delta))
+	require.NoError(t, json.Unmarshal(<-fast.send, &presence))
+	assert.Equal(t, wsDelta, delta.Type)
+	assert.Equal(t, wsPresence, presence.Type)
+	assert.Equal(t, 1, presence.Viewers)
+}
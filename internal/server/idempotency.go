@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/idempotencykey"
+	"github.com/ivankorhner/polling-app/internal/ent/predicate"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// ErrCodeIdempotencyKeyReused is the error code for an idempotency key sent
+// again with a different request
+const ErrCodeIdempotencyKeyReused = "IDEMPOTENCY_KEY_REUSED"
+
+// idempotencyStore keeps the responses to idempotent requests in the
+// database for ttl
+type idempotencyStore struct {
+	client *ent.Client
+	ttl    time.Duration
+}
+
+// newIdempotencyStore returns a store that keeps responses for ttl
+func newIdempotencyStore(client *ent.Client, ttl time.Duration) *idempotencyStore {
+	return &idempotencyStore{client: client, ttl: ttl}
+}
+
+// Reserve claims the request's key by inserting it without a response. The
+// unique indexes on the key make a concurrent retry fail to claim it.
+func (s *idempotencyStore) Reserve(ctx context.Context, req middleware.IdempotentRequest) (*middleware.IdempotentResponse, error) {
+	now := time.Now()
+
+	// Expired keys are cleared first, so they can be used again
+	if _, err := s.client.IdempotencyKey.Delete().Where(idempotencykey.ExpiresAtLT(now)).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	create := s.client.IdempotencyKey.Create().
+		SetKey(req.Key).
+		SetFingerprint(req.Fingerprint).
+		SetExpiresAt(now.Add(s.ttl))
+	if req.UserID != 0 {
+		create.SetUserID(req.UserID)
+	}
+	err := create.Exec(ctx)
+	if err == nil {
+		return nil, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return nil, err
+	}
+
+	existing, err := s.client.IdempotencyKey.Query().
+		Where(idempotencyKeyOf(req)).
+		Only(ctx)
+	if err != nil {
+		// The key expired or was released since; the client may retry
+		if ent.IsNotFound(err) {
+			return nil, middleware.ErrIdempotencyKeyInFlight
+		}
+		return nil, err
+	}
+	if existing.Fingerprint != req.Fingerprint {
+		return nil, middleware.ErrIdempotencyKeyReused
+	}
+	if existing.StatusCode == nil {
+		return nil, middleware.ErrIdempotencyKeyInFlight
+	}
+	return &middleware.IdempotentResponse{
+		StatusCode:  *existing.StatusCode,
+		ContentType: existing.ContentType,
+		Body:        existing.Body,
+	}, nil
+}
+
+// Complete records the response to the reserved request
+func (s *idempotencyStore) Complete(ctx context.Context, req middleware.IdempotentRequest, resp middleware.IdempotentResponse) error {
+	return s.client.IdempotencyKey.Update().
+		Where(idempotencyKeyOf(req), idempotencykey.Fingerprint(req.Fingerprint)).
+		SetStatusCode(resp.StatusCode).
+		SetContentType(resp.ContentType).
+		SetBody(resp.Body).
+		Exec(ctx)
+}
+
+// Release deletes the reserved key if no response was recorded for it
+func (s *idempotencyStore) Release(ctx context.Context, req middleware.IdempotentRequest) error {
+	_, err := s.client.IdempotencyKey.Delete().
+		Where(
+			idempotencyKeyOf(req),
+			idempotencykey.Fingerprint(req.Fingerprint),
+			idempotencykey.StatusCodeIsNil(),
+		).
+		Exec(ctx)
+	return err
+}
+
+// idempotencyKeyOf matches the stored key of the request's user
+func idempotencyKeyOf(req middleware.IdempotentRequest) predicate.IdempotencyKey {
+	if req.UserID == 0 {
+		return idempotencykey.And(idempotencykey.Key(req.Key), idempotencykey.UserIDIsNil())
+	}
+	return idempotencykey.And(idempotencykey.Key(req.Key), idempotencykey.UserID(req.UserID))
+}
+
+// writeIdempotencyError responds to a request whose idempotency key could
+// not be used
+func writeIdempotencyError(logger *slog.Logger) func(w http.ResponseWriter, r *http.Request, err error) {
+	return func(w http.ResponseWriter, r *http.Request, err error) {
+		switch {
+		case errors.Is(err, middleware.ErrMalformedIdempotencyKey), errors.Is(err, middleware.ErrUnreadableBody):
+			writeValidationError(w, r, err.Error())
+		case errors.Is(err, middleware.ErrIdempotencyKeyReused):
+			writeError(w, r, err.Error(), ErrCodeIdempotencyKeyReused, http.StatusUnprocessableEntity)
+		case errors.Is(err, middleware.ErrIdempotencyKeyInFlight):
+			writeConflictError(w, r, err.Error())
+		default:
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to check idempotency key",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to process request")
+		}
+	}
+}
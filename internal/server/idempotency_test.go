@@ -0,0 +1,184 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/config"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := &config.Config{APITimeout: time.Minute, IdempotencyTTL: time.Hour}
+	handler := server.AddRoutes(ctx, cfg, logger, testDB.DB, testDB.Client, events.NewMemory(logger))
+
+	serve := func(method, path, token, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	register := `{"username": "alice", "email": "alice@example.com"}`
+	first := serve(http.MethodPost, "/users", "", "", register)
+	require.Equal(t, http.StatusCreated, first.Code, first.Body.String())
+
+	var registered server.RegisteredUserResponse
+	require.NoError(t, json.Unmarshal(first.Body.Bytes(), &registered))
+	token := registered.Token
+
+	// A retried poll creation creates one poll
+	create := `{"title": "Lunch?", "options": ["Soup", "Salad"]}`
+	first = serve(http.MethodPost, "/polls", token, "create-1", create)
+	require.Equal(t, http.StatusCreated, first.Code, first.Body.String())
+	retry := serve(http.MethodPost, "/polls", token, "create-1", create)
+	require.Equal(t, http.StatusCreated, retry.Code, retry.Body.String())
+	assert.Equal(t, first.Body.String(), retry.Body.String())
+
+	polls, err := testDB.Client.Poll.Query().Where(poll.OwnerID(registered.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, polls)
+
+	var created server.PollResponse
+	require.NoError(t, json.Unmarshal(first.Body.Bytes(), &created))
+
+	// Reusing the key for a different poll is refused
+	rec := serve(http.MethodPost, "/polls", token, "create-1", `{"title": "Dinner?", "options": ["Soup", "Salad"]}`)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code, rec.Body.String())
+	var errResp server.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+	assert.Equal(t, server.ErrCodeIdempotencyKeyReused, errResp.Code)
+
+	// A retried vote gets the original success rather than a conflict
+	vote := fmt.Sprintf(`{"option_id": %d}`, created.Options[0].ID)
+	votePath := fmt.Sprintf("/polls/%d/vote", created.ID)
+	first = serve(http.MethodPost, votePath, token, "vote-1", vote)
+	require.Equal(t, http.StatusOK, first.Code, first.Body.String())
+	retry = serve(http.MethodPost, votePath, token, "vote-1", vote)
+	assert.Equal(t, http.StatusOK, retry.Code, retry.Body.String())
+	assert.Equal(t, first.Body.String(), retry.Body.String())
+
+	ballots, err := testDB.Client.Ballot.Query().Where(ballot.PollID(created.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ballots)
+
+	// Without a key a repeated vote still conflicts
+	rec = serve(http.MethodPost, votePath, token, "", vote)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// Keys belong to their user: another user's key does not replay
+	other := serve(http.MethodPost, "/users", "", "", `{"username": "bob", "email": "bob@example.com"}`)
+	require.Equal(t, http.StatusCreated, other.Code, other.Body.String())
+	var bob server.RegisteredUserResponse
+	require.NoError(t, json.Unmarshal(other.Body.Bytes(), &bob))
+	rec = serve(http.MethodPost, "/polls", bob.Token, "create-1", create)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Empty(t, rec.Header().Get("Idempotent-Replayed"))
+}
+
+func TestIdempotencyKey_Registration(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := &config.Config{APITimeout: time.Minute, IdempotencyTTL: time.Hour}
+	handler := server.AddRoutes(ctx, cfg, logger, testDB.DB, testDB.Client, events.NewMemory(logger))
+
+	register := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBufferString(`{"username": "alice", "email": "alice@example.com"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", "register-1")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := register()
+	require.Equal(t, http.StatusCreated, first.Code, first.Body.String())
+	var registered server.RegisteredUserResponse
+	require.NoError(t, json.Unmarshal(first.Body.Bytes(), &registered))
+	require.NotEmpty(t, registered.Token)
+
+	// Whoever repeats the key and body is handled anew rather than handed
+	// the first caller's token
+	retry := register()
+	assert.Equal(t, http.StatusConflict, retry.Code, retry.Body.String())
+	assert.Empty(t, retry.Header().Get("Idempotent-Replayed"))
+	assert.NotContains(t, retry.Body.String(), registered.Token)
+	assert.NotContains(t, retry.Body.String(), `"token"`)
+
+	// Nor is the token kept in the database
+	keys, err := testDB.Client.IdempotencyKey.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, keys)
+}
+
+func TestIdempotencyKey_Expired(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := &config.Config{APITimeout: time.Minute, IdempotencyTTL: time.Millisecond}
+	handler := server.AddRoutes(ctx, cfg, logger, testDB.DB, testDB.Client, events.NewMemory(logger))
+
+	serve := func(path, token, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve("/users", "", "", `{"username": "alice", "email": "alice@example.com"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var registered server.RegisteredUserResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registered))
+	create := func(body string) *httptest.ResponseRecorder {
+		return serve("/polls", registered.Token, "create-1", body)
+	}
+
+	rec = create(`{"title": "Lunch?", "options": ["Soup", "Salad"]}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	// Once the key expires it can be used for another request
+	time.Sleep(10 * time.Millisecond)
+	rec = create(`{"title": "Dinner?", "options": ["Soup", "Salad"]}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	assert.Empty(t, rec.Header().Get("Idempotent-Replayed"))
+}
@@ -0,0 +1,419 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	"github.com/ivankorhner/polling-app/internal/ent/organizationinvitation"
+	"github.com/ivankorhner/polling-app/internal/ent/predicate"
+	"github.com/ivankorhner/polling-app/internal/ent/user"
+)
+
+// InvitationTTL is how long an organization invitation can be accepted
+const InvitationTTL = 7 * 24 * time.Hour
+
+// CreateInvitationRequest represents the request body for inviting someone
+// to an organization. The role defaults to member.
+type CreateInvitationRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role,omitempty"`
+}
+
+// InvitationResponse represents an invitation to join an organization
+type InvitationResponse struct {
+	ID               int        `json:"id"`
+	OrganizationID   int        `json:"organization_id"`
+	OrganizationName string     `json:"organization_name,omitempty"`
+	Email            string     `json:"email"`
+	Role             string     `json:"role"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	AcceptedAt       *time.Time `json:"accepted_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// InvitationListResponse represents pending organization invitations
+type InvitationListResponse struct {
+	Data []InvitationResponse `json:"data"`
+}
+
+// HandleCreateInvitation handles inviting an email address to an
+// organization by one of its admins or owners. The user registered with the
+// address accepts it to join.
+func HandleCreateInvitation(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		m, ok := loadMembership(logger, client, w, r, "create invitation")
+		if !ok {
+			return
+		}
+		if !canManageMembers(m.Role) {
+			writeForbiddenError(w, r, "only organization admins and owners can invite")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "create invitation: starting", slog.Int("organization_id", m.OrganizationID))
+
+		var req CreateInvitationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
+			return
+		}
+
+		errs := ValidateEmail(req.Email)
+		role := membership.RoleMember
+		if req.Role != "" {
+			errs = append(errs, ValidateMemberRole(req.Role)...)
+			role = membership.Role(req.Role)
+		}
+		if errs != nil {
+			writeFieldErrors(w, r, errs)
+			return
+		}
+		if !canGrantRole(m.Role, role) {
+			writeForbiddenError(w, r, "only organization owners can invite owners")
+			return
+		}
+
+		// Normalize the address the way registration does
+		email := strings.TrimSpace(strings.ToLower(req.Email))
+
+		member, err := client.Membership.Query().
+			Where(
+				membership.OrganizationID(m.OrganizationID),
+				membership.HasUserWith(user.Email(email)),
+			).
+			Exist(r.Context())
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to check membership", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to create invitation")
+			return
+		}
+		if member {
+			writeConflictError(w, r, "user is already a member")
+			return
+		}
+
+		pending, err := client.OrganizationInvitation.Query().
+			Where(
+				organizationinvitation.OrganizationID(m.OrganizationID),
+				organizationinvitation.Email(email),
+				pendingInvitation(time.Now()),
+			).
+			Exist(r.Context())
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to check invitations", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to create invitation")
+			return
+		}
+		if pending {
+			writeConflictError(w, r, "an invitation is already pending for this email")
+			return
+		}
+
+		invitation, err := client.OrganizationInvitation.Create().
+			SetOrganizationID(m.OrganizationID).
+			SetEmail(email).
+			SetRole(organizationinvitation.Role(role)).
+			SetExpiresAt(time.Now().Add(InvitationTTL)).
+			Save(r.Context())
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to create invitation", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to create invitation")
+			return
+		}
+		invitation.Edges.Organization = m.Edges.Organization
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"create invitation: completed",
+			slog.Int("organization_id", m.OrganizationID),
+			slog.Int("invitation_id", invitation.ID),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(mapInvitationToResponse(invitation)); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode invitation response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleListInvitations handles listing an organization's pending
+// invitations for its admins and owners
+func HandleListInvitations(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m, ok := loadMembership(logger, client, w, r, "list invitations")
+		if !ok {
+			return
+		}
+		if !canManageMembers(m.Role) {
+			writeForbiddenError(w, r, "only organization admins and owners can see invitations")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list invitations: starting", slog.Int("organization_id", m.OrganizationID))
+
+		writeInvitations(logger, client, w, r, "list invitations",
+			organizationinvitation.OrganizationID(m.OrganizationID),
+		)
+	})
+}
+
+// HandleRevokeInvitation handles withdrawing a pending invitation by one of
+// the organization's admins or owners
+func HandleRevokeInvitation(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m, ok := loadMembership(logger, client, w, r, "revoke invitation")
+		if !ok {
+			return
+		}
+		if !canManageMembers(m.Role) {
+			writeForbiddenError(w, r, "only organization admins and owners can revoke invitations")
+			return
+		}
+
+		invitationID, err := strconv.Atoi(r.PathValue("invitationID"))
+		if err != nil {
+			writeValidationError(w, r, "invalid invitation id")
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"revoke invitation: starting",
+			slog.Int("organization_id", m.OrganizationID),
+			slog.Int("invitation_id", invitationID),
+		)
+
+		deleted, err := client.OrganizationInvitation.Delete().
+			Where(
+				organizationinvitation.ID(invitationID),
+				organizationinvitation.OrganizationID(m.OrganizationID),
+				organizationinvitation.AcceptedAtIsNil(),
+			).
+			Exec(r.Context())
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to revoke invitation", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to revoke invitation")
+			return
+		}
+		if deleted == 0 {
+			writeNotFoundError(w, r, "invitation not found")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "revoke invitation: completed", slog.Int("invitation_id", invitationID))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListMyInvitations handles listing the pending invitations addressed
+// to the authenticated user's email
+func HandleListMyInvitations(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list my invitations: starting")
+
+		u, err := client.User.Get(r.Context(), principal.UserID)
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to query user", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to retrieve invitations")
+			return
+		}
+
+		writeInvitations(logger, client, w, r, "list my invitations",
+			organizationinvitation.Email(u.Email),
+		)
+	})
+}
+
+// HandleAcceptInvitation handles accepting an invitation addressed to the
+// authenticated user's email, joining its organization with its role.
+// Invitations for other addresses are reported as missing.
+func HandleAcceptInvitation(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		invitationID, err := strconv.Atoi(r.PathValue("id"))
+		if err != nil {
+			writeValidationError(w, r, "invalid invitation id")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "accept invitation: starting", slog.Int("invitation_id", invitationID))
+
+		u, err := client.User.Get(r.Context(), principal.UserID)
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to query user", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to accept invitation")
+			return
+		}
+
+		invitation, err := client.OrganizationInvitation.Query().
+			Where(
+				organizationinvitation.ID(invitationID),
+				organizationinvitation.Email(u.Email),
+				pendingInvitation(time.Now()),
+			).
+			WithOrganization().
+			Only(r.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeNotFoundError(w, r, "invitation not found")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to query invitation", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to accept invitation")
+			return
+		}
+
+		m, err := acceptInvitation(r.Context(), client, invitation, u.ID)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				writeConflictError(w, r, "user is already a member")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to accept invitation", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to accept invitation")
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"accept invitation: completed",
+			slog.Int("organization_id", m.OrganizationID),
+			slog.Int("user_id", u.ID),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(mapOrganizationToResponse(m)); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode organization response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// acceptInvitation adds the user to the invitation's organization and marks
+// the invitation accepted. It returns the new membership with the
+// organization loaded.
+func acceptInvitation(ctx context.Context, client *ent.Client, invitation *ent.OrganizationInvitation, userID int) (*ent.Membership, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := tx.Membership.Create().
+		SetOrganizationID(invitation.OrganizationID).
+		SetUserID(userID).
+		SetRole(membership.Role(invitation.Role)).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	err = tx.OrganizationInvitation.UpdateOne(invitation).
+		SetAcceptedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	m.Edges.Organization = invitation.Edges.Organization
+	return m, nil
+}
+
+// pendingInvitation matches invitations that are neither accepted nor
+// expired at the given moment
+func pendingInvitation(now time.Time) predicate.OrganizationInvitation {
+	return organizationinvitation.And(
+		organizationinvitation.AcceptedAtIsNil(),
+		organizationinvitation.ExpiresAtGT(now),
+	)
+}
+
+// writeInvitations writes the pending invitations matching the predicate,
+// oldest first
+func writeInvitations(logger *slog.Logger, client *ent.Client, w http.ResponseWriter, r *http.Request, action string, where predicate.OrganizationInvitation) {
+	invitations, err := client.OrganizationInvitation.Query().
+		Where(where, pendingInvitation(time.Now())).
+		WithOrganization().
+		Order(ent.Asc(organizationinvitation.FieldID)).
+		All(r.Context())
+	if err != nil {
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelError,
+			"failed to query invitations",
+			slog.String("error", err.Error()),
+		)
+		writeInternalError(w, r, "failed to retrieve invitations")
+		return
+	}
+
+	response := InvitationListResponse{Data: make([]InvitationResponse, len(invitations))}
+	for i, invitation := range invitations {
+		response.Data[i] = mapInvitationToResponse(invitation)
+	}
+
+	logger.LogAttrs(r.Context(), slog.LevelInfo, action+": completed", slog.Int("count", len(invitations)))
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelError,
+			"failed to encode invitations response",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func mapInvitationToResponse(invitation *ent.OrganizationInvitation) InvitationResponse {
+	response := InvitationResponse{
+		ID:             invitation.ID,
+		OrganizationID: invitation.OrganizationID,
+		Email:          invitation.Email,
+		Role:           string(invitation.Role),
+		ExpiresAt:      invitation.ExpiresAt,
+		AcceptedAt:     invitation.AcceptedAt,
+		CreatedAt:      invitation.CreatedAt,
+	}
+	if invitation.Edges.Organization != nil {
+		response.OrganizationName = invitation.Edges.Organization.Name
+	}
+	return response
+}
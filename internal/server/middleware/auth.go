@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/ivankorhner/polling-app/internal/logging"
+)
+
+// Principal identifies the user a request is made on behalf of
+type Principal struct {
+	UserID  int
+	TokenID int
+	Admin   bool
+	// ManagedOrganizations are the organizations the user owns or
+	// administers
+	ManagedOrganizations []int
+}
+
+// ManagesOrganization reports whether the principal owns or administers the
+// organization
+func (p Principal) ManagesOrganization(orgID int) bool {
+	return slices.Contains(p.ManagedOrganizations, orgID)
+}
+
+type principalKey struct{}
+
+// ErrMalformedAuthorization reports an Authorization header that does not
+// carry a bearer token
+var ErrMalformedAuthorization = errors.New("authorization header must be a bearer token")
+
+// ErrInvalidToken is returned by a TokenResolver for unknown or revoked tokens
+var ErrInvalidToken = errors.New("invalid or revoked token")
+
+// TokenResolver maps a bearer token to the principal it was issued to
+type TokenResolver func(ctx context.Context, token string) (Principal, error)
+
+// WithPrincipal returns a copy of ctx carrying the authenticated principal
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal the request was authenticated as
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// Authenticate resolves the bearer token in the Authorization header into a
+// principal on the request context. Requests without the header pass through
+// unauthenticated and handlers that need a principal reject them. When the
+// header is malformed or the token does not resolve, fail writes the response.
+func Authenticate(
+	resolve TokenResolver,
+	fail func(w http.ResponseWriter, r *http.Request, err error),
+) func(h http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			if header == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token, err := bearerToken(header)
+			if err != nil {
+				fail(w, r, err)
+				return
+			}
+
+			principal, err := resolve(r.Context(), token)
+			if err != nil {
+				fail(w, r, err)
+				return
+			}
+
+			ctx := WithPrincipal(r.Context(), principal)
+			ctx = logging.AppendCtx(ctx, slog.Int("principal_id", principal.UserID))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// bearerToken extracts the token from an Authorization header value
+func bearerToken(header string) (string, error) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", ErrMalformedAuthorization
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", ErrMalformedAuthorization
+	}
+	return token, nil
+}
@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthenticate(t *testing.T) {
+	errLookup := errors.New("lookup failed")
+	resolve := func(_ context.Context, token string) (Principal, error) {
+		switch token {
+		case "good":
+			return Principal{UserID: 7, TokenID: 3}, nil
+		case "broken":
+			return Principal{}, errLookup
+		default:
+			return Principal{}, ErrInvalidToken
+		}
+	}
+
+	tests := []struct {
+		name          string
+		header        string
+		wantPrincipal *Principal
+		wantErr       error
+	}{
+		{
+			name: "no header passes through unauthenticated",
+		},
+		{
+			name:          "valid token",
+			header:        "Bearer good",
+			wantPrincipal: &Principal{UserID: 7, TokenID: 3},
+		},
+		{
+			name:          "scheme is case insensitive",
+			header:        "bearer good",
+			wantPrincipal: &Principal{UserID: 7, TokenID: 3},
+		},
+		{
+			name:    "unknown token",
+			header:  "Bearer nope",
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "basic credentials",
+			header:  "Basic dXNlcjpwYXNz",
+			wantErr: ErrMalformedAuthorization,
+		},
+		{
+			name:    "empty token",
+			header:  "Bearer ",
+			wantErr: ErrMalformedAuthorization,
+		},
+		{
+			name:    "resolver failure",
+			header:  "Bearer broken",
+			wantErr: errLookup,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				reached  bool
+				got      Principal
+				gotFound bool
+				gotErr   error
+			)
+			next := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				reached = true
+				got, gotFound = PrincipalFromContext(r.Context())
+			})
+			fail := func(w http.ResponseWriter, _ *http.Request, err error) {
+				gotErr = err
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			Authenticate(resolve, fail)(next).ServeHTTP(httptest.NewRecorder(), req)
+
+			if tt.wantErr != nil {
+				assert.False(t, reached)
+				assert.ErrorIs(t, gotErr, tt.wantErr)
+				return
+			}
+			assert.True(t, reached)
+			assert.NoError(t, gotErr)
+			if tt.wantPrincipal == nil {
+				assert.False(t, gotFound)
+				return
+			}
+			assert.True(t, gotFound)
+			assert.Equal(t, *tt.wantPrincipal, got)
+		})
+	}
+}
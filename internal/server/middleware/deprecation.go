@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Deprecation describes routes that are still served but due to be removed
+type Deprecation struct {
+	// Since is when the routes were deprecated
+	Since time.Time
+	// Sunset is when the routes stop being served
+	Sunset time.Time
+	// Successor returns the path that replaces the request's
+	Successor func(r *http.Request) string
+}
+
+// Deprecated announces on every response that the routes are deprecated
+// (RFC 9745), when they stop being served (RFC 8594) and which path clients
+// should move to
+func Deprecated(d Deprecation) func(h http.Handler) http.Handler {
+	deprecation := fmt.Sprintf("@%d", d.Since.Unix())
+	sunset := d.Sunset.UTC().Format(http.TimeFormat)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Deprecation", deprecation)
+			w.Header().Set("Sunset", sunset)
+			if d.Successor != nil {
+				w.Header().Add("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, d.Successor(r)))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
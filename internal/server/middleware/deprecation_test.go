@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecated(t *testing.T) {
+	deprecation := Deprecation{
+		Since:  time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+		Sunset: time.Date(2027, 4, 17, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name      string
+		successor func(r *http.Request) string
+		wantLink  string
+	}{
+		{
+			name:      "with successor",
+			successor: func(r *http.Request) string { return "/v1" + r.URL.Path },
+			wantLink:  `</v1/polls/7>; rel="successor-version"`,
+		},
+		{
+			name: "without successor",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := deprecation
+			d.Successor = tt.successor
+			handler := Deprecated(d)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			}))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/polls/7", nil))
+
+			assert.Equal(t, http.StatusNotFound, rec.Code, "the handler still answers")
+			assert.Equal(t, "@1792195200", rec.Header().Get("Deprecation"))
+			assert.Equal(t, "Sat, 17 Apr 2027 00:00:00 GMT", rec.Header().Get("Sunset"))
+			assert.Equal(t, tt.wantLink, rec.Header().Get("Link"))
+		})
+	}
+}
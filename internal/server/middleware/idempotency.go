@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// IdempotencyKeyHeader carries the client's key for retrying a request safely
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// MaxIdempotencyKeyLength is the longest idempotency key accepted
+const MaxIdempotencyKeyLength = 255
+
+// ErrMalformedIdempotencyKey reports an empty or overlong idempotency key
+var ErrMalformedIdempotencyKey = errors.New("idempotency key must be between 1 and 255 characters")
+
+// ErrUnreadableBody reports a request body that is too large or could not be
+// read in full
+var ErrUnreadableBody = errors.New("invalid request body")
+
+// ErrIdempotencyKeyReused is returned by an IdempotencyStore for a key that
+// was already used with a different request
+var ErrIdempotencyKeyReused = errors.New("idempotency key was already used with a different request")
+
+// ErrIdempotencyKeyInFlight is returned by an IdempotencyStore while the
+// first request with a key is still being handled
+var ErrIdempotencyKeyInFlight = errors.New("a request with this idempotency key is still being processed")
+
+// IdempotentRequest identifies a request made with an idempotency key. Keys
+// belong to the user who sent them; UserID is zero for unauthenticated
+// requests. The fingerprint tells requests reusing a key apart.
+type IdempotentRequest struct {
+	Key         string
+	UserID      int
+	Fingerprint string
+}
+
+// IdempotentResponse is the response recorded for an idempotent request
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// IdempotencyStore records the responses to idempotent requests
+type IdempotencyStore interface {
+	// Reserve claims the request's key. It returns the recorded response when
+	// the key was already used for the same request, ErrIdempotencyKeyReused
+	// when it was used for a different one and ErrIdempotencyKeyInFlight while
+	// that request is still being handled.
+	Reserve(ctx context.Context, req IdempotentRequest) (*IdempotentResponse, error)
+	// Complete records the response to a reserved request
+	Complete(ctx context.Context, req IdempotentRequest, resp IdempotentResponse) error
+	// Release gives up a reserved key so the request can be retried
+	Release(ctx context.Context, req IdempotentRequest) error
+}
+
+// Idempotency replays the recorded response to requests that repeat the
+// Idempotency-Key header of an earlier one, instead of handling them again.
+// Requests without the header pass through. Server errors are not recorded,
+// so those requests can be retried with the same key. Request bodies beyond
+// maxBodySize are rejected. When the key is malformed or cannot be used,
+// fail writes the response.
+func Idempotency(
+	store IdempotencyStore,
+	maxBodySize int64,
+	fail func(w http.ResponseWriter, r *http.Request, err error),
+) func(h http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key, ok := r.Header[http.CanonicalHeaderKey(IdempotencyKeyHeader)]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) != 1 || key[0] == "" || len(key[0]) > MaxIdempotencyKeyLength {
+				fail(w, r, ErrMalformedIdempotencyKey)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+			if err != nil {
+				fail(w, r, ErrUnreadableBody)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			principal, _ := PrincipalFromContext(r.Context())
+			req := IdempotentRequest{
+				Key:         key[0],
+				UserID:      principal.UserID,
+				Fingerprint: fingerprint(r, body),
+			}
+
+			recorded, err := store.Reserve(r.Context(), req)
+			if err != nil {
+				fail(w, r, err)
+				return
+			}
+			if recorded != nil {
+				replay(w, *recorded)
+				return
+			}
+
+			// The response is recorded even when the request is cancelled
+			// or times out, since the handler may have finished its work
+			ctx := context.WithoutCancel(r.Context())
+			rec := &recordingWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			completed := false
+			defer func() {
+				if !completed {
+					_ = store.Release(ctx, req)
+				}
+			}()
+
+			next.ServeHTTP(rec, r)
+
+			if rec.statusCode >= http.StatusInternalServerError {
+				return
+			}
+			completed = store.Complete(ctx, req, IdempotentResponse{
+				StatusCode:  rec.statusCode,
+				ContentType: rec.Header().Get("Content-Type"),
+				Body:        rec.body.Bytes(),
+			}) == nil
+		})
+	}
+}
+
+// fingerprint returns a hash of the request method, path and body
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	_, _ = io.WriteString(h, r.Method+" "+r.URL.Path+"\n")
+	_, _ = h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// replay writes a recorded response, marked as a replay
+func replay(w http.ResponseWriter, resp IdempotentResponse) {
+	if resp.ContentType != "" {
+		w.Header().Set("Content-Type", resp.ContentType)
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(resp.Body)
+}
+
+// recordingWriter passes a response through while keeping a copy of its
+// status and body
+type recordingWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (rw *recordingWriter) WriteHeader(statusCode int) {
+	if !rw.wroteHeader {
+		rw.statusCode = statusCode
+		rw.wroteHeader = true
+	}
+	rw.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rw *recordingWriter) Write(b []byte) (int, error) {
+	rw.wroteHeader = true
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
+
+// Unwrap returns the wrapped ResponseWriter
+func (rw *recordingWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryIdempotencyStore keeps idempotent responses in memory, a nil
+// response marking a key still in flight
+type memoryIdempotencyStore struct {
+	mu         sync.Mutex
+	keys       map[IdempotentRequest]*IdempotentResponse
+	reserveErr error
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{keys: make(map[IdempotentRequest]*IdempotentResponse)}
+}
+
+func (s *memoryIdempotencyStore) Reserve(_ context.Context, req IdempotentRequest) (*IdempotentResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.reserveErr != nil {
+		return nil, s.reserveErr
+	}
+	for k, resp := range s.keys {
+		if k.Key != req.Key || k.UserID != req.UserID {
+			continue
+		}
+		switch {
+		case k.Fingerprint != req.Fingerprint:
+			return nil, ErrIdempotencyKeyReused
+		case resp == nil:
+			return nil, ErrIdempotencyKeyInFlight
+		default:
+			return resp, nil
+		}
+	}
+	s.keys[req] = nil
+	return nil, nil
+}
+
+func (s *memoryIdempotencyStore) Complete(_ context.Context, req IdempotentRequest, resp IdempotentResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[req] = &resp
+	return nil
+}
+
+func (s *memoryIdempotencyStore) Release(_ context.Context, req IdempotentRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, req)
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	calls := 0
+	status := http.StatusCreated
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = fmt.Fprintf(w, `{"call":%d}`, calls)
+	})
+
+	var failed error
+	fail := func(w http.ResponseWriter, _ *http.Request, err error) {
+		failed = err
+		w.WriteHeader(http.StatusTeapot)
+	}
+	serve := Idempotency(store, 64, fail)(handler)
+
+	send := func(userID int, key, body string) *httptest.ResponseRecorder {
+		failed = nil
+		req := httptest.NewRequest(http.MethodPost, "/polls", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		if userID != 0 {
+			req = req.WithContext(WithPrincipal(req.Context(), Principal{UserID: userID}))
+		}
+		rec := httptest.NewRecorder()
+		serve.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// The first request is handled and its response recorded
+	rec := send(1, "k1", `{"title":"a"}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, `{"call":1}`, rec.Body.String())
+	assert.Empty(t, rec.Header().Get("Idempotent-Replayed"))
+
+	// A retry gets the same response without handling it again
+	rec = send(1, "k1", `{"title":"a"}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, `{"call":1}`, rec.Body.String())
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "true", rec.Header().Get("Idempotent-Replayed"))
+	assert.Equal(t, 1, calls)
+
+	// A different payload under the same key is refused
+	send(1, "k1", `{"title":"b"}`)
+	assert.ErrorIs(t, failed, ErrIdempotencyKeyReused)
+	assert.Equal(t, 1, calls)
+
+	// Keys belong to their user
+	rec = send(2, "k1", `{"title":"a"}`)
+	assert.Equal(t, `{"call":2}`, rec.Body.String())
+	assert.Equal(t, 2, calls)
+
+	// Requests without a key are always handled
+	send(1, "", `{"title":"a"}`)
+	send(1, "", `{"title":"a"}`)
+	assert.Equal(t, 4, calls)
+
+	// Server errors are not recorded, so a retry is handled again
+	status = http.StatusInternalServerError
+	send(1, "k2", `{}`)
+	status = http.StatusCreated
+	rec = send(1, "k2", `{}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, 6, calls)
+}
+
+func TestIdempotency_Failures(t *testing.T) {
+	errStore := errors.New("store unavailable")
+
+	tests := []struct {
+		name       string
+		key        []string
+		body       string
+		reserveErr error
+		wantErr    error
+	}{
+		{name: "empty key", key: []string{""}, wantErr: ErrMalformedIdempotencyKey},
+		{name: "overlong key", key: []string{strings.Repeat("k", MaxIdempotencyKeyLength+1)}, wantErr: ErrMalformedIdempotencyKey},
+		{name: "repeated header", key: []string{"a", "b"}, wantErr: ErrMalformedIdempotencyKey},
+		{name: "body too large", key: []string{"k"}, body: strings.Repeat("x", 65), wantErr: ErrUnreadableBody},
+		{name: "in flight", key: []string{"k"}, reserveErr: ErrIdempotencyKeyInFlight, wantErr: ErrIdempotencyKeyInFlight},
+		{name: "store error", key: []string{"k"}, reserveErr: errStore, wantErr: errStore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newMemoryIdempotencyStore()
+			store.reserveErr = tt.reserveErr
+
+			handled := false
+			next := http.HandlerFunc(func(http.ResponseWriter, *http.Request) { handled = true })
+
+			var failed error
+			fail := func(w http.ResponseWriter, _ *http.Request, err error) {
+				failed = err
+				w.WriteHeader(http.StatusTeapot)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/polls", strings.NewReader(tt.body))
+			req.Header[IdempotencyKeyHeader] = tt.key
+			rec := httptest.NewRecorder()
+
+			Idempotency(store, 64, fail)(next).ServeHTTP(rec, req)
+
+			require.ErrorIs(t, failed, tt.wantErr)
+			assert.False(t, handled)
+			assert.Equal(t, http.StatusTeapot, rec.Code)
+		})
+	}
+}
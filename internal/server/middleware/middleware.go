@@ -8,19 +8,38 @@ import (
 	"github.com/ivankorhner/polling-app/internal/config"
 )
 
+// NewDefaults returns the middleware chain for regular API routes, which are
+// cut off once they run longer than the configured API timeout. When a
+// handler panics or times out, fail writes the response with ErrPanic or
+// ErrTimeout.
 func NewDefaults(
 	ctx context.Context,
 	config *config.Config,
 	logger *slog.Logger,
+	fail func(w http.ResponseWriter, r *http.Request, err error),
 ) func(h http.Handler) http.Handler {
 	return func(h http.Handler) http.Handler {
-		// Correct order: panic recovery outermost, then request tracking, then timeout
-		return panicRecovery(logger,
-			requestID(logger,
+		// Correct order: request ID outermost so every log line and error
+		// carries it, then panic recovery, request tracking and timeout
+		return requestID(logger,
+			panicRecovery(logger, fail,
 				httpRequest(logger,
-					timeout(config.APITimeout, h),
+					timeout(config.APITimeout, fail, h),
 				),
 			),
 		)
 	}
 }
+
+// NewStreaming returns the middleware chain for long-lived streaming routes.
+// It matches NewDefaults without the timeout, since the timeout buffers
+// responses and cannot flush them.
+func NewStreaming(logger *slog.Logger, fail func(w http.ResponseWriter, r *http.Request, err error)) func(h http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return requestID(logger,
+			panicRecovery(logger, fail,
+				httpRequest(logger, h),
+			),
+		)
+	}
+}
@@ -1,11 +1,15 @@
 package middleware
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
 )
 
-func panicRecovery(logger *slog.Logger, next http.Handler) http.Handler {
+// ErrPanic reports a request whose handler panicked
+var ErrPanic = errors.New("internal server error")
+
+func panicRecovery(logger *slog.Logger, fail func(w http.ResponseWriter, r *http.Request, err error), next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
 			if rec := recover(); rec != nil {
@@ -13,12 +17,10 @@ func panicRecovery(logger *slog.Logger, next http.Handler) http.Handler {
 					r.Context(),
 					slog.LevelError,
 					"panic recovered",
-					slog.String(RequestIDKey, requestIDFromContext(r.Context())),
-
 					slog.String("path", r.URL.Path),
 					slog.Any("panic", rec),
 				)
-				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				fail(w, r, ErrPanic)
 			}
 		}()
 		next.ServeHTTP(w, r)
@@ -10,11 +10,18 @@ import (
 	"github.com/ivankorhner/polling-app/internal/logging"
 )
 
-// RequestIDKey is the context key for storing request IDs
+// RequestIDKey is the log attribute key for request IDs
 const RequestIDKey string = "request_id"
 
-func requestIDFromContext(ctx context.Context) string {
-	if reqID, ok := ctx.Value(RequestIDKey).(string); ok {
+// RequestIDHeader is the response header carrying the request ID
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the ID assigned to the request, or an empty
+// string outside of a request
+func RequestIDFromContext(ctx context.Context) string {
+	if reqID, ok := ctx.Value(requestIDKey{}).(string); ok {
 		return reqID
 	}
 	return ""
@@ -23,11 +30,13 @@ func requestIDFromContext(ctx context.Context) string {
 func requestID(logger *slog.Logger, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		if existing := requestIDFromContext(ctx); existing == "" {
-			reqID := generateRequestID()
+		reqID := RequestIDFromContext(ctx)
+		if reqID == "" {
+			reqID = generateRequestID()
+			ctx = context.WithValue(ctx, requestIDKey{}, reqID)
 			ctx = logging.AppendCtx(ctx, slog.String(RequestIDKey, reqID))
-			r = r.WithContext(ctx)
 		}
+		w.Header().Set(RequestIDHeader, reqID)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -0,0 +1,77 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ivankorhner/polling-app/internal/logging"
+)
+
+// failStatus answers requests the middleware gave up on with the error's
+// message and a 500 status
+func failStatus(w http.ResponseWriter, _ *http.Request, err error) {
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+func TestRequestID(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(logging.ContextHandler{Handler: slog.NewJSONHandler(&logs, nil)})
+
+	var seen string
+	handler := NewStreaming(logger, failStatus)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/polls", nil))
+
+	require.NotEmpty(t, seen, "handlers see the request ID")
+	assert.Equal(t, seen, rec.Header().Get(RequestIDHeader))
+
+	// Every log line of the request carries its ID
+	for line := range bytes.Lines(logs.Bytes()) {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal(line, &entry))
+		assert.Equal(t, seen, entry[RequestIDKey])
+	}
+
+	// Each request gets its own ID
+	first := seen
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/polls", nil))
+	assert.NotEqual(t, first, seen)
+}
+
+func TestRequestID_Panic(t *testing.T) {
+	var logs bytes.Buffer
+	logger := slog.New(logging.ContextHandler{Handler: slog.NewJSONHandler(&logs, nil)})
+
+	var failed error
+	fail := func(w http.ResponseWriter, r *http.Request, err error) {
+		failed = err
+		failStatus(w, r, err)
+	}
+	handler := NewStreaming(logger, fail)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/polls", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.ErrorIs(t, failed, ErrPanic)
+	assert.Contains(t, logs.String(), `"msg":"panic recovered"`)
+	assert.Contains(t, logs.String(), `"request_id":"`+rec.Header().Get(RequestIDHeader)+`"`)
+}
+
+func TestRequestIDFromContext_Outside(t *testing.T) {
+	assert.Empty(t, RequestIDFromContext(t.Context()))
+}
@@ -1,10 +1,92 @@
 package middleware
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"maps"
 	"net/http"
+	"sync"
 	"time"
 )
 
-func timeout(timeout time.Duration, next http.Handler) http.Handler {
-	return http.TimeoutHandler(next, timeout, "request took too long")
+// ErrTimeout reports a request that was not handled within the API timeout
+var ErrTimeout = errors.New("request took too long")
+
+// timeout works like http.TimeoutHandler: the handler's response is
+// buffered and only sent if it finishes in time. Otherwise fail writes the
+// response and later writes by the handler return http.ErrHandlerTimeout.
+func timeout(timeout time.Duration, fail func(w http.ResponseWriter, r *http.Request, err error), next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		r = r.WithContext(ctx)
+
+		tw := &timeoutWriter{header: make(http.Header)}
+		done := make(chan struct{})
+		panicked := make(chan any, 1)
+		go func() {
+			defer func() {
+				if p := recover(); p != nil {
+					panicked <- p
+				}
+			}()
+			next.ServeHTTP(tw, r)
+			close(done)
+		}()
+
+		select {
+		case p := <-panicked:
+			// Re-raised here so panic recovery sees it
+			panic(p)
+		case <-done:
+			tw.mu.Lock()
+			defer tw.mu.Unlock()
+			maps.Copy(w.Header(), tw.header)
+			if tw.status == 0 {
+				tw.status = http.StatusOK
+			}
+			w.WriteHeader(tw.status)
+			_, _ = w.Write(tw.body.Bytes())
+		case <-ctx.Done():
+			tw.mu.Lock()
+			defer tw.mu.Unlock()
+			tw.timedOut = true
+			fail(w, r, ErrTimeout)
+		}
+	})
+}
+
+// timeoutWriter buffers a response until the handler finishes
+type timeoutWriter struct {
+	mu       sync.Mutex
+	header   http.Header
+	body     bytes.Buffer
+	status   int
+	timedOut bool
+}
+
+func (tw *timeoutWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if tw.status == 0 {
+		tw.status = http.StatusOK
+	}
+	return tw.body.Write(b)
+}
+
+func (tw *timeoutWriter) WriteHeader(status int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut || tw.status != 0 {
+		return
+	}
+	tw.status = status
 }
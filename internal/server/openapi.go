@@ -0,0 +1,47 @@
+package server
+
+import (
+	_ "embed"
+	"log/slog"
+	"net/http"
+)
+
+// openAPISpec is the OpenAPI 3.1 document describing every route in
+// AddRoutes. It is maintained by hand alongside the handlers, and the tests
+// check it against both the routes and the responses they write.
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// docsPage renders openAPISpec in a browser without fetching anything but
+// the document itself
+//
+//go:embed docs.html
+var docsPage []byte
+
+// HandleOpenAPI serves the OpenAPI document describing the API
+func HandleOpenAPI(logger *slog.Logger) http.Handler {
+	return serveStatic(logger, "application/json", openAPISpec)
+}
+
+// HandleDocs serves a page that renders the OpenAPI document
+func HandleDocs(logger *slog.Logger) http.Handler {
+	return serveStatic(logger, "text/html; charset=utf-8", docsPage)
+}
+
+// serveStatic returns a handler that writes a fixed body
+func serveStatic(logger *slog.Logger, contentType string, body []byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(body); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to write response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
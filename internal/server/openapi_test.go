@@ -0,0 +1,279 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openAPIMethods are the operations of an OpenAPI path item, by HTTP method
+var openAPIMethods = map[string]string{
+	http.MethodGet:    "get",
+	http.MethodPost:   "post",
+	http.MethodPut:    "put",
+	http.MethodPatch:  "patch",
+	http.MethodDelete: "delete",
+}
+
+// routePatterns returns the method patterns registered in AddRoutes, e.g.
+// "GET /polls/{id}", by reading them from routes.go. Each reports whether it
+// is registered on an API version rather than on one of the muxes directly.
+func routePatterns(t *testing.T) map[string]bool {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "routes.go", nil, 0)
+	require.NoError(t, err)
+
+	patterns := map[string]bool{}
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Handle" {
+			return true
+		}
+		// Only method patterns are API routes; "/" is the catch-all
+		pattern, ok := call.Args[0].(*ast.BinaryExpr)
+		if !ok {
+			return true
+		}
+		method, ok := pattern.X.(*ast.SelectorExpr)
+		require.True(t, ok, "route method at %s", fset.Position(pattern.Pos()))
+		path, ok := pattern.Y.(*ast.BasicLit)
+		require.True(t, ok, "route path at %s", fset.Position(pattern.Pos()))
+		unquoted, err := strconv.Unquote(path.Value)
+		require.NoError(t, err)
+		receiver, ok := sel.X.(*ast.Ident)
+		require.True(t, ok, "route receiver at %s", fset.Position(pattern.Pos()))
+
+		methodName := strings.ToUpper(strings.TrimPrefix(method.Sel.Name, "Method"))
+		patterns[methodName+unquoted] = receiver.Name != "mux" && receiver.Name != "root"
+		return true
+	})
+	require.NotEmpty(t, patterns)
+	return patterns
+}
+
+// loadOpenAPISpec decodes the embedded OpenAPI document
+func loadOpenAPISpec(t *testing.T) map[string]any {
+	t.Helper()
+	var spec map[string]any
+	require.NoError(t, json.Unmarshal(openAPISpec, &spec))
+	return spec
+}
+
+// openAPIOperations returns the operations of the OpenAPI document as method
+// patterns, e.g. "GET /polls/{id}". Each reports whether it is served under
+// the document's version prefix rather than at a server of its own.
+func openAPIOperations(t *testing.T, spec map[string]any) map[string]bool {
+	t.Helper()
+	operations := map[string]bool{}
+	for path, item := range spec["paths"].(map[string]any) {
+		_, ownServers := item.(map[string]any)["servers"]
+		for method, name := range openAPIMethods {
+			if _, ok := item.(map[string]any)[name]; ok {
+				operations[method+" "+path] = !ownServers
+			}
+		}
+	}
+	return operations
+}
+
+func TestOpenAPI_DocumentsEveryRoute(t *testing.T) {
+	spec := loadOpenAPISpec(t)
+	assert.Equal(t, "3.1.0", spec["openapi"])
+	assert.Equal(t, []any{map[string]any{"url": "/v1"}}, spec["servers"])
+
+	routes := routePatterns(t)
+	operations := openAPIOperations(t, spec)
+
+	assert.Equal(t, routes, operations, "every route in AddRoutes is documented, under /v1 unless unversioned")
+}
+
+func TestOpenAPI_OperationIDsAreUnique(t *testing.T) {
+	spec := loadOpenAPISpec(t)
+
+	seen := map[string]string{}
+	for path, item := range spec["paths"].(map[string]any) {
+		for method, name := range openAPIMethods {
+			op, ok := item.(map[string]any)[name].(map[string]any)
+			if !ok {
+				continue
+			}
+			id, _ := op["operationId"].(string)
+			require.NotEmpty(t, id, "%s %s has an operationId", method, path)
+			if prev, dup := seen[id]; dup {
+				t.Errorf("operationId %q is used by %s and %s %s", id, prev, method, path)
+			}
+			seen[id] = method + " " + path
+		}
+	}
+}
+
+// compileOpenAPISchema compiles one of the component schemas of the embedded
+// OpenAPI document
+func compileOpenAPISchema(t *testing.T, name string) *jsonschema.Schema {
+	t.Helper()
+
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(openAPISpec))
+	require.NoError(t, err)
+
+	c := jsonschema.NewCompiler()
+	c.DefaultDraft(jsonschema.Draft2020)
+	c.AssertFormat()
+	require.NoError(t, c.AddResource("openapi.json", doc))
+
+	schema, err := c.Compile("openapi.json#/components/schemas/" + name)
+	require.NoError(t, err)
+	return schema
+}
+
+func TestOpenAPI_SchemasCompile(t *testing.T) {
+	spec := loadOpenAPISpec(t)
+	schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+	for name := range schemas {
+		t.Run(name, func(t *testing.T) {
+			compileOpenAPISchema(t, name)
+		})
+	}
+}
+
+func TestOpenAPI_SchemasMatchResponses(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	count := 3
+	orgID := 4
+	slug := "abc123"
+	cursor := "next"
+	eliminated := 2
+	statusCode := 500
+
+	poll := PollResponse{
+		ID:                1,
+		OrganizationID:    &orgID,
+		Title:             "Lunch?",
+		Status:            PollStatusOpen,
+		VotingMethod:      "plurality",
+		MinSelections:     1,
+		MaxSelections:     1,
+		Visibility:        "unlisted",
+		ShareSlug:         &slug,
+		ResultsVisibility: "always",
+		ClosesAt:          &now,
+		CreatedAt:         now,
+		Options:           []OptionResponse{{ID: 1, Text: "Soup", VoteCount: &count}, {ID: 2, Text: "Salad"}},
+	}
+
+	problem := newErrorResponse("title is required", ErrCodeValidation)
+	problem.Status = http.StatusBadRequest
+	problem.Instance = "urn:uuid:0b5e4f5c-0d1f-4c8b-9d33-1f0d6b4c2a77"
+	problem.Errors = fieldError("/title", FieldCodeRequired, "title is required")
+
+	tests := []struct {
+		schema string
+		value  any
+	}{
+		{"Problem", problem},
+		{"Problem", newErrorResponse("poll not found", ErrCodeNotFound)},
+		{"Poll", poll},
+		{"Poll", PollResponse{Status: PollStatusDraft, VotingMethod: "score", Visibility: "public", ResultsVisibility: "owner_only", CreatedAt: now, Options: []OptionResponse{}}},
+		{"PollList", PollListResponse{Data: []PollResponse{poll}, NextCursor: &cursor}},
+		{"PollList", PollListResponse{Data: []PollResponse{}}},
+		{"UserPollList", UserPollListResponse{Data: []UserPollResponse{
+			{PollResponse: poll},
+			{PollResponse: poll, Choice: &ChoiceResponse{OptionIDs: []int{1}, Scores: map[int]int{1: 5}, VotedAt: now}},
+		}}},
+		{"Results", ResultsResponse{
+			PollID: 1, VotingMethod: "instant_runoff", Status: PollStatusClosed, BallotCount: 3, Winners: []int{1},
+			Rounds: []RoundResponse{{Round: 1, Tallies: []TallyResponse{{OptionID: 1, Text: "Soup", Votes: 3}}, Eliminated: &eliminated}},
+		}},
+		{"VoteHistory", mapVoteHistoryToResponse(1, nil)},
+		{"VoteHistory", VoteHistoryResponse{PollID: 1, Events: []VoteEventResponse{{ID: 1, UserID: 2, Action: "cast", OptionIDs: []int{1}, CreatedAt: now}}}},
+		{"InviteeList", InviteeListResponse{Data: []InviteeResponse{{UserID: 1, Username: "alice", CreatedAt: now}}}},
+		{"User", UserResponse{ID: 1, Username: "alice", CreatedAt: now}},
+		{"RegisteredUser", RegisteredUserResponse{UserResponse: UserResponse{ID: 1, Username: "alice", Email: "alice@example.com", CreatedAt: now}, Token: "pat_x"}},
+		{"UserList", UserListResponse{Data: []UserResponse{}}},
+		{"CreatedToken", CreatedTokenResponse{TokenResponse: TokenResponse{ID: 1, Name: "cli", Prefix: "pat_ab", CreatedAt: now}, Token: "pat_x"}},
+		{"TokenList", TokenListResponse{Data: []TokenResponse{{ID: 1, Name: "cli", Prefix: "pat_ab", CreatedAt: now, LastUsedAt: &now, RevokedAt: &now}}}},
+		{"CreatedWebhook", CreatedWebhookResponse{WebhookResponse: WebhookResponse{ID: 1, URL: "https://example.com/hook", Events: []string{"vote"}, PollID: &orgID, CreatedAt: now}, Secret: "whsec_x"}},
+		{"WebhookDeliveryList", WebhookDeliveryListResponse{Data: []WebhookDeliveryResponse{{
+			ID: 1, Event: "poll_closed", Status: "failed", Payload: json.RawMessage(`{"type":"poll_closed"}`), AttemptCount: 1, NextAttemptAt: &now, CreatedAt: now,
+			Attempts: []WebhookAttemptResponse{{ID: 1, StatusCode: &statusCode, Error: "server error", DurationMs: 12, CreatedAt: now}},
+		}}}},
+		{"OrganizationList", OrganizationListResponse{Data: []OrganizationResponse{{ID: 1, Name: "Acme", Role: "owner", CreatedAt: now}}}},
+		{"MemberList", MemberListResponse{Data: []MemberResponse{{UserID: 1, Username: "alice", Role: "admin", CreatedAt: now}}}},
+		{"InvitationList", InvitationListResponse{Data: []InvitationResponse{{ID: 1, OrganizationID: 1, OrganizationName: "Acme", Email: "bob@example.com", Role: "member", ExpiresAt: now, AcceptedAt: &now, CreatedAt: now}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schema, func(t *testing.T) {
+			body, err := json.Marshal(tt.value)
+			require.NoError(t, err)
+			instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+			require.NoError(t, err)
+
+			assert.NoError(t, compileOpenAPISchema(t, tt.schema).Validate(instance), string(body))
+		})
+	}
+}
+
+func TestOpenAPI_SchemasRejectMismatches(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		body   string
+	}{
+		{"missing field", "User", `{"id": 1, "created_at": "2026-03-01T12:00:00Z"}`},
+		{"wrong type", "Option", `{"id": "1", "text": "Soup"}`},
+		{"unknown status", "Poll", `{"id": 1, "title": "Lunch?", "status": "paused", "voting_method": "plurality", "min_selections": 1, "max_selections": 1, "anonymous": false, "visibility": "public", "results_visibility": "always", "results_hidden": false, "created_at": "2026-03-01T12:00:00Z", "options": []}`},
+		{"null list", "TokenList", `{"data": null}`},
+		{"malformed time", "Invitee", `{"user_id": 1, "username": "alice", "created_at": "yesterday"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance, err := jsonschema.UnmarshalJSON(strings.NewReader(tt.body))
+			require.NoError(t, err)
+			assert.Error(t, compileOpenAPISchema(t, tt.schema).Validate(instance))
+		})
+	}
+}
+
+func TestHandleOpenAPI(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name        string
+		handler     http.Handler
+		contentType string
+		contains    string
+	}{
+		{"document", HandleOpenAPI(logger), "application/json", `"openapi": "3.1.0"`},
+		{"docs page", HandleDocs(logger), "text/html; charset=utf-8", `fetch("openapi.json")`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.contentType, rec.Header().Get("Content-Type"))
+			assert.Contains(t, rec.Body.String(), tt.contains)
+		})
+	}
+}
@@ -0,0 +1,620 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	"github.com/ivankorhner/polling-app/internal/ent/organization"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+)
+
+// CreateOrganizationRequest represents the request body for creating an
+// organization
+type CreateOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+// OrganizationResponse represents an organization and the requester's role
+// in it
+type OrganizationResponse struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OrganizationListResponse represents the organizations the authenticated
+// user belongs to
+type OrganizationListResponse struct {
+	Data []OrganizationResponse `json:"data"`
+}
+
+// MemberResponse represents a member of an organization
+type MemberResponse struct {
+	UserID    int       `json:"user_id"`
+	Username  string    `json:"username"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MemberListResponse represents the members of an organization
+type MemberListResponse struct {
+	Data []MemberResponse `json:"data"`
+}
+
+// UpdateMemberRequest represents the request body for changing a member's
+// role
+type UpdateMemberRequest struct {
+	Role string `json:"role"`
+}
+
+// HandleCreateOrganization handles creating an organization. The
+// authenticated user becomes its owner.
+func HandleCreateOrganization(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "create organization: starting")
+
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		var req CreateOrganizationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
+			return
+		}
+
+		if errs := ValidateOrganizationName(req.Name); errs != nil {
+			writeFieldErrors(w, r, errs)
+			return
+		}
+
+		m, err := createOrganization(r.Context(), client, principal.UserID, strings.TrimSpace(req.Name))
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to create organization",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to create organization")
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"create organization: completed",
+			slog.Int("organization_id", m.OrganizationID),
+			slog.Int("user_id", principal.UserID),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(mapOrganizationToResponse(m)); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode organization response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// createOrganization creates an organization owned by the user and returns
+// the owner's membership with the organization loaded
+func createOrganization(ctx context.Context, client *ent.Client, userID int, name string) (*ent.Membership, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := tx.Organization.Create().
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	m, err := tx.Membership.Create().
+		SetOrganizationID(org.ID).
+		SetUserID(userID).
+		SetRole(membership.RoleOwner).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	m.Edges.Organization = org
+	return m, nil
+}
+
+// HandleListOrganizations handles listing the organizations the
+// authenticated user belongs to
+func HandleListOrganizations(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list organizations: starting")
+
+		memberships, err := client.Membership.Query().
+			Where(membership.UserID(principal.UserID)).
+			WithOrganization().
+			Order(ent.Asc(membership.FieldOrganizationID)).
+			All(r.Context())
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query organizations",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to retrieve organizations")
+			return
+		}
+
+		response := OrganizationListResponse{Data: make([]OrganizationResponse, len(memberships))}
+		for i, m := range memberships {
+			response.Data[i] = mapOrganizationToResponse(m)
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list organizations: completed", slog.Int("count", len(memberships)))
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode organizations response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleGetOrganization handles retrieving one of the authenticated user's
+// organizations
+func HandleGetOrganization(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m, ok := loadMembership(logger, client, w, r, "retrieve organization")
+		if !ok {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(mapOrganizationToResponse(m)); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode organization response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleDeleteOrganization handles deleting an organization by one of its
+// owners. Organizations that still have polls are kept, since their polls
+// would otherwise become visible outside it.
+func HandleDeleteOrganization(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m, ok := loadMembership(logger, client, w, r, "delete organization")
+		if !ok {
+			return
+		}
+		if m.Role != membership.RoleOwner {
+			writeForbiddenError(w, r, "only an organization owner can delete it")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "delete organization: starting", slog.Int("organization_id", m.OrganizationID))
+
+		if err := deleteOrganization(r.Context(), client, m.OrganizationID); err != nil {
+			switch {
+			case errors.Is(err, errOrganizationHasPolls):
+				writeConflictError(w, r, errOrganizationHasPolls.Error())
+				return
+			case ent.IsNotFound(err):
+				writeNotFoundError(w, r, "organization not found")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to delete organization", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to delete organization")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "delete organization: completed", slog.Int("organization_id", m.OrganizationID))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListMembers handles listing the members of an organization, in the
+// order they joined
+func HandleListMembers(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m, ok := loadMembership(logger, client, w, r, "list members")
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list members: starting", slog.Int("organization_id", m.OrganizationID))
+
+		members, err := client.Membership.Query().
+			Where(membership.OrganizationID(m.OrganizationID)).
+			WithUser().
+			Order(ent.Asc(membership.FieldID)).
+			All(r.Context())
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query members",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to retrieve members")
+			return
+		}
+
+		response := MemberListResponse{Data: make([]MemberResponse, len(members))}
+		for i, member := range members {
+			response.Data[i] = mapMemberToResponse(member)
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list members: completed", slog.Int("count", len(members)))
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode members response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleUpdateMember handles changing a member's role, which only owners
+// may do. The last owner cannot step down.
+func HandleUpdateMember(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		m, ok := loadMembership(logger, client, w, r, "update member")
+		if !ok {
+			return
+		}
+		if m.Role != membership.RoleOwner {
+			writeForbiddenError(w, r, "only an organization owner can change roles")
+			return
+		}
+
+		target, ok := loadPathMember(logger, client, w, r, m.OrganizationID, "update member")
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"update member: starting",
+			slog.Int("organization_id", m.OrganizationID),
+			slog.Int("user_id", target.UserID),
+		)
+
+		var req UpdateMemberRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
+			return
+		}
+		if errs := ValidateMemberRole(req.Role); errs != nil {
+			writeFieldErrors(w, r, errs)
+			return
+		}
+
+		updated, err := updateMemberRole(r.Context(), client, target, membership.Role(req.Role))
+		if err != nil {
+			switch {
+			case errors.Is(err, errLastOrganizationOwner):
+				writeConflictError(w, r, errLastOrganizationOwner.Error())
+				return
+			case ent.IsNotFound(err):
+				writeNotFoundError(w, r, "member not found")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to update member", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to update member")
+			return
+		}
+		updated.Edges.User = target.Edges.User
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"update member: completed",
+			slog.Int("organization_id", m.OrganizationID),
+			slog.Int("user_id", target.UserID),
+			slog.String("role", req.Role),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(mapMemberToResponse(updated)); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode member response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleRemoveMember handles removing a member from an organization, or a
+// member leaving it. The last owner cannot leave. Polls the member created
+// stay with the organization.
+func HandleRemoveMember(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m, ok := loadMembership(logger, client, w, r, "remove member")
+		if !ok {
+			return
+		}
+
+		target, ok := loadPathMember(logger, client, w, r, m.OrganizationID, "remove member")
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"remove member: starting",
+			slog.Int("organization_id", m.OrganizationID),
+			slog.Int("user_id", target.UserID),
+		)
+
+		if !canRemoveMember(m, target) {
+			writeForbiddenError(w, r, "admins can only remove members with a lower role")
+			return
+		}
+		if err := removeMember(r.Context(), client, target); err != nil {
+			switch {
+			case errors.Is(err, errLastOrganizationOwner):
+				writeConflictError(w, r, errLastOrganizationOwner.Error())
+				return
+			case ent.IsNotFound(err):
+				writeNotFoundError(w, r, "member not found")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to remove member", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to remove member")
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"remove member: completed",
+			slog.Int("organization_id", m.OrganizationID),
+			slog.Int("user_id", target.UserID),
+		)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// loadMembership loads the authenticated principal's membership of the
+// organization named in the request path, with the organization. Users
+// outside the organization are told it does not exist. It writes an error
+// response and returns false otherwise.
+func loadMembership(logger *slog.Logger, client *ent.Client, w http.ResponseWriter, r *http.Request, action string) (*ent.Membership, bool) {
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	orgID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeValidationError(w, r, "invalid organization id")
+		return nil, false
+	}
+
+	m, err := client.Membership.Query().
+		Where(membership.OrganizationID(orgID), membership.UserID(principal.UserID)).
+		WithOrganization().
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeNotFoundError(w, r, "organization not found")
+			return nil, false
+		}
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelError,
+			"failed to query membership",
+			slog.String("error", err.Error()),
+			slog.Int("organization_id", orgID),
+		)
+		writeInternalError(w, r, "failed to "+action)
+		return nil, false
+	}
+	return m, true
+}
+
+// loadPathMember loads the membership of the user named in the request path,
+// with the user. It writes an error response and returns false when the user
+// is not a member of the organization.
+func loadPathMember(logger *slog.Logger, client *ent.Client, w http.ResponseWriter, r *http.Request, orgID int, action string) (*ent.Membership, bool) {
+	userID, err := strconv.Atoi(r.PathValue("userID"))
+	if err != nil {
+		writeValidationError(w, r, "invalid user id")
+		return nil, false
+	}
+
+	m, err := client.Membership.Query().
+		Where(membership.OrganizationID(orgID), membership.UserID(userID)).
+		WithUser().
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeNotFoundError(w, r, "member not found")
+			return nil, false
+		}
+		logger.LogAttrs(r.Context(), slog.LevelError, "failed to query member", slog.String("error", err.Error()))
+		writeInternalError(w, r, "failed to "+action)
+		return nil, false
+	}
+	return m, true
+}
+
+// errOrganizationHasPolls is returned when deleting an organization that
+// still has polls
+var errOrganizationHasPolls = errors.New("organization still has polls; delete them first")
+
+// deleteOrganization deletes the organization with its memberships and
+// invitations. It returns errOrganizationHasPolls, changing nothing, while
+// the organization has polls.
+func deleteOrganization(ctx context.Context, client *ent.Client, orgID int) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Lock the organization, so a poll being created in it either commits
+	// before the check below sees it or waits for the deletion and fails
+	if _, err := tx.Organization.Query().Where(organization.ID(orgID)).ForUpdate().OnlyID(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	hasPolls, err := tx.Poll.Query().
+		Where(entpoll.OrganizationID(orgID)).
+		Exist(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if hasPolls {
+		return errors.Join(errOrganizationHasPolls, tx.Rollback())
+	}
+
+	if err := tx.Organization.DeleteOneID(orgID).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+// errLastOrganizationOwner is returned when a change would leave an
+// organization without an owner
+var errLastOrganizationOwner = errors.New("an organization must keep an owner")
+
+// updateMemberRole changes the member's role. It returns
+// errLastOrganizationOwner, changing nothing, when the member is the
+// organization's only owner and the role is not owner.
+func updateMemberRole(ctx context.Context, client *ent.Client, target *ent.Membership, role membership.Role) (*ent.Membership, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if role != membership.RoleOwner {
+		if err := requireAnotherOwner(ctx, tx, target); err != nil {
+			return nil, errors.Join(err, tx.Rollback())
+		}
+	}
+
+	updated, err := tx.Membership.UpdateOne(target).SetRole(role).Save(ctx)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// removeMember removes the member from the organization. It returns
+// errLastOrganizationOwner, changing nothing, when the member is the
+// organization's only owner.
+func removeMember(ctx context.Context, client *ent.Client, target *ent.Membership) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := requireAnotherOwner(ctx, tx, target); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err := tx.Membership.DeleteOne(target).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+// requireAnotherOwner returns errLastOrganizationOwner when the member is
+// the only owner of their organization. It locks the organization's owner
+// memberships until the transaction ends, so owners demoting or removing
+// each other at once cannot both see the other as the remaining owner.
+func requireAnotherOwner(ctx context.Context, tx *ent.Tx, target *ent.Membership) error {
+	owners, err := tx.Membership.Query().
+		Where(membership.OrganizationID(target.OrganizationID), membership.RoleEQ(membership.RoleOwner)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	isOwner, others := false, false
+	for _, o := range owners {
+		if o.UserID == target.UserID {
+			isOwner = true
+		} else {
+			others = true
+		}
+	}
+	if isOwner && !others {
+		return errLastOrganizationOwner
+	}
+	return nil
+}
+
+func mapOrganizationToResponse(m *ent.Membership) OrganizationResponse {
+	org := m.Edges.Organization
+	return OrganizationResponse{
+		ID:        org.ID,
+		Name:      org.Name,
+		Role:      string(m.Role),
+		CreatedAt: org.CreatedAt,
+	}
+}
+
+func mapMemberToResponse(m *ent.Membership) MemberResponse {
+	response := MemberResponse{
+		UserID:    m.UserID,
+		Role:      string(m.Role),
+		CreatedAt: m.CreatedAt,
+	}
+	if m.Edges.User != nil {
+		response.Username = m.Edges.User.Username
+	}
+	return response
+}
@@ -0,0 +1,582 @@
+//go:build integration
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/config"
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// orgFixture is an organization with an owner, an admin and a member, and a
+// user outside it
+type orgFixture struct {
+	org                           *ent.Organization
+	owner, admin, member, outside *ent.User
+}
+
+func setupOrgFixture(ctx context.Context, t *testing.T, testDB *testutil.TestDB) orgFixture {
+	t.Helper()
+
+	var f orgFixture
+	users := testutil.CreateUsers(ctx, t, testDB.Client, "owner", "admin", "member", "outside")
+	f.owner, f.admin, f.member, f.outside = users[0], users[1], users[2], users[3]
+
+	org, err := testDB.Client.Organization.Create().SetName("Platform").Save(ctx)
+	require.NoError(t, err)
+	f.org = org
+
+	for u, role := range map[*ent.User]membership.Role{
+		f.owner:  membership.RoleOwner,
+		f.admin:  membership.RoleAdmin,
+		f.member: membership.RoleMember,
+	} {
+		_, err := testDB.Client.Membership.Create().
+			SetOrganizationID(org.ID).
+			SetUserID(u.ID).
+			SetRole(role).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	return f
+}
+
+// orgRequest sends a request as the user with the path values set
+func orgRequest(handler http.Handler, method string, userID int, body any, pathValues map[string]string) *httptest.ResponseRecorder {
+	req := testutil.NewRequest(method, "/", userID, body)
+	for name, value := range pathValues {
+		req.SetPathValue(name, value)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func (f orgFixture) path(values ...string) map[string]string {
+	pathValues := map[string]string{"id": fmt.Sprintf("%d", f.org.ID)}
+	for i := 0; i+1 < len(values); i += 2 {
+		pathValues[values[i]] = values[i+1]
+	}
+	return pathValues
+}
+
+func TestHandleCreateOrganization(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupOrgFixture(ctx, t, testDB)
+
+	tests := []struct {
+		name       string
+		userID     int
+		body       any
+		wantStatus int
+	}{
+		{name: "valid", userID: f.outside.ID, body: server.CreateOrganizationRequest{Name: "  Research  "}, wantStatus: http.StatusCreated},
+		{name: "missing name", userID: f.outside.ID, body: server.CreateOrganizationRequest{}, wantStatus: http.StatusBadRequest},
+		{name: "unauthenticated", body: server.CreateOrganizationRequest{Name: "Research"}, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := orgRequest(server.HandleCreateOrganization(logger, testDB.Client), http.MethodPost, tt.userID, tt.body, nil)
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			var org server.OrganizationResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &org))
+			assert.Equal(t, "Research", org.Name)
+			assert.Equal(t, "owner", org.Role)
+		})
+	}
+
+	rec := orgRequest(server.HandleListOrganizations(logger, testDB.Client), http.MethodGet, f.outside.ID, nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list server.OrganizationListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, "Research", list.Data[0].Name)
+
+	// Outsiders are told the organization does not exist
+	rec = orgRequest(server.HandleGetOrganization(logger, testDB.Client), http.MethodGet, f.outside.ID, nil, f.path())
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+	rec = orgRequest(server.HandleGetOrganization(logger, testDB.Client), http.MethodGet, f.member.ID, nil, f.path())
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var org server.OrganizationResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &org))
+	assert.Equal(t, "member", org.Role)
+}
+
+func TestHandleUpdateMember(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupOrgFixture(ctx, t, testDB)
+	handler := server.HandleUpdateMember(logger, testDB.Client)
+
+	tests := []struct {
+		name       string
+		userID     int
+		target     *ent.User
+		role       string
+		wantStatus int
+	}{
+		{name: "by an admin", userID: f.admin.ID, target: f.member, role: "admin", wantStatus: http.StatusForbidden},
+		{name: "unknown role", userID: f.owner.ID, target: f.member, role: "guest", wantStatus: http.StatusBadRequest},
+		{name: "not a member", userID: f.owner.ID, target: f.outside, role: "admin", wantStatus: http.StatusNotFound},
+		{name: "last owner steps down", userID: f.owner.ID, target: f.owner, role: "member", wantStatus: http.StatusConflict},
+		{name: "promote to owner", userID: f.owner.ID, target: f.admin, role: "owner", wantStatus: http.StatusOK},
+		{name: "owner steps down", userID: f.owner.ID, target: f.owner, role: "member", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := server.UpdateMemberRequest{Role: tt.role}
+			rec := orgRequest(handler, http.MethodPatch, tt.userID, body, f.path("userID", fmt.Sprintf("%d", tt.target.ID)))
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var member server.MemberResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &member))
+			assert.Equal(t, tt.target.ID, member.UserID)
+			assert.Equal(t, tt.role, member.Role)
+		})
+	}
+}
+
+func TestHandleRemoveMember(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupOrgFixture(ctx, t, testDB)
+	handler := server.HandleRemoveMember(logger, testDB.Client)
+
+	tests := []struct {
+		name       string
+		userID     int
+		target     *ent.User
+		wantStatus int
+	}{
+		{name: "member removes admin", userID: f.member.ID, target: f.admin, wantStatus: http.StatusForbidden},
+		{name: "admin removes owner", userID: f.admin.ID, target: f.owner, wantStatus: http.StatusForbidden},
+		{name: "last owner leaves", userID: f.owner.ID, target: f.owner, wantStatus: http.StatusConflict},
+		{name: "outsider", userID: f.outside.ID, target: f.member, wantStatus: http.StatusNotFound},
+		{name: "admin removes member", userID: f.admin.ID, target: f.member, wantStatus: http.StatusNoContent},
+		{name: "admin leaves", userID: f.admin.ID, target: f.admin, wantStatus: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := orgRequest(handler, http.MethodDelete, tt.userID, nil, f.path("userID", fmt.Sprintf("%d", tt.target.ID)))
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+
+	rec := orgRequest(server.HandleListMembers(logger, testDB.Client), http.MethodGet, f.owner.ID, nil, f.path())
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list server.MemberListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, "owner", list.Data[0].Username)
+}
+
+func TestOrganizationOwners_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	tests := []struct {
+		name    string
+		handler http.Handler
+		method  string
+		body    any
+		success int
+	}{
+		{
+			name:    "owners demote each other",
+			handler: server.HandleUpdateMember(logger, testDB.Client),
+			method:  http.MethodPatch,
+			body:    server.UpdateMemberRequest{Role: "member"},
+			success: http.StatusOK,
+		},
+		{
+			name:    "owners remove each other",
+			handler: server.HandleRemoveMember(logger, testDB.Client),
+			method:  http.MethodDelete,
+			success: http.StatusNoContent,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owners := testutil.CreateUsers(ctx, t, testDB.Client, fmt.Sprintf("alice%d", i), fmt.Sprintf("bob%d", i))
+			org, err := testDB.Client.Organization.Create().SetName("Acme").Save(ctx)
+			require.NoError(t, err)
+			for _, u := range owners {
+				_, err := testDB.Client.Membership.Create().SetOrganizationID(org.ID).SetUserID(u.ID).SetRole(membership.RoleOwner).Save(ctx)
+				require.NoError(t, err)
+			}
+
+			// Each owner acts on the other at once; at most one may succeed
+			statuses := make([]int, len(owners))
+			var wg sync.WaitGroup
+			for j, u := range owners {
+				other := owners[1-j]
+				wg.Go(func() {
+					pathValues := map[string]string{"id": fmt.Sprint(org.ID), "userID": fmt.Sprint(other.ID)}
+					statuses[j] = orgRequest(tt.handler, tt.method, u.ID, tt.body, pathValues).Code
+				})
+			}
+			wg.Wait()
+
+			assert.Equal(t, 1, countStatus(statuses, tt.success), statuses)
+			remaining, err := testDB.Client.Membership.Query().
+				Where(membership.OrganizationID(org.ID), membership.RoleEQ(membership.RoleOwner)).
+				Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 1, remaining)
+		})
+	}
+}
+
+// countStatus returns how many of the statuses equal want
+func countStatus(statuses []int, want int) int {
+	n := 0
+	for _, status := range statuses {
+		if status == want {
+			n++
+		}
+	}
+	return n
+}
+
+func TestHandleDeleteOrganization(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupOrgFixture(ctx, t, testDB)
+	handler := server.HandleDeleteOrganization(logger, testDB.Client)
+
+	p, err := testDB.Client.Poll.Create().
+		SetOwnerID(f.member.ID).
+		SetOrganizationID(f.org.ID).
+		SetTitle("Retro day?").
+		Save(ctx)
+	require.NoError(t, err)
+
+	rec := orgRequest(handler, http.MethodDelete, f.admin.ID, nil, f.path())
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+
+	rec = orgRequest(handler, http.MethodDelete, f.owner.ID, nil, f.path())
+	assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+
+	require.NoError(t, testDB.Client.Poll.DeleteOne(p).Exec(ctx))
+	rec = orgRequest(handler, http.MethodDelete, f.owner.ID, nil, f.path())
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+	members, err := testDB.Client.Membership.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, members)
+}
+
+func TestHandleDeleteOrganization_ConcurrentPoll(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupOrgFixture(ctx, t, testDB)
+
+	// A poll created while the organization is deleted either keeps it or
+	// fails; the deletion never trips over it
+	var deleted int
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		body := server.CreatePollRequest{Title: "Retro day?", OrganizationID: &f.org.ID, Options: []string{"Yes", "No"}}
+		req := testutil.NewRequest(http.MethodPost, "/polls", f.member.ID, body)
+		server.HandleCreatePoll(logger, testDB.Client, events.NewMemory(logger)).ServeHTTP(httptest.NewRecorder(), req)
+	})
+	wg.Go(func() {
+		deleted = orgRequest(server.HandleDeleteOrganization(logger, testDB.Client), http.MethodDelete, f.owner.ID, nil, f.path()).Code
+	})
+	wg.Wait()
+
+	assert.Contains(t, []int{http.StatusNoContent, http.StatusConflict}, deleted)
+}
+
+func TestOrganizationInvitations(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupOrgFixture(ctx, t, testDB)
+	create := server.HandleCreateInvitation(logger, testDB.Client)
+
+	tests := []struct {
+		name       string
+		userID     int
+		body       server.CreateInvitationRequest
+		wantStatus int
+	}{
+		{name: "by a member", userID: f.member.ID, body: server.CreateInvitationRequest{Email: "outside@example.com"}, wantStatus: http.StatusForbidden},
+		{name: "admin invites owner", userID: f.admin.ID, body: server.CreateInvitationRequest{Email: "outside@example.com", Role: "owner"}, wantStatus: http.StatusForbidden},
+		{name: "invalid email", userID: f.admin.ID, body: server.CreateInvitationRequest{Email: "outside"}, wantStatus: http.StatusBadRequest},
+		{name: "existing member", userID: f.admin.ID, body: server.CreateInvitationRequest{Email: "member@example.com"}, wantStatus: http.StatusConflict},
+		{name: "admin invites admin", userID: f.admin.ID, body: server.CreateInvitationRequest{Email: "Outside@Example.com", Role: "admin"}, wantStatus: http.StatusCreated},
+		{name: "already pending", userID: f.owner.ID, body: server.CreateInvitationRequest{Email: "outside@example.com"}, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := orgRequest(create, http.MethodPost, tt.userID, tt.body, f.path())
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			var invitation server.InvitationResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &invitation))
+			assert.Equal(t, "outside@example.com", invitation.Email)
+			assert.Equal(t, "admin", invitation.Role)
+			assert.WithinDuration(t, time.Now().Add(server.InvitationTTL), invitation.ExpiresAt, time.Minute)
+		})
+	}
+
+	// The invitee sees the invitation, others cannot accept it
+	rec := orgRequest(server.HandleListMyInvitations(logger, testDB.Client), http.MethodGet, f.outside.ID, nil, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list server.InvitationListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, "Platform", list.Data[0].OrganizationName)
+	invitationID := fmt.Sprintf("%d", list.Data[0].ID)
+
+	accept := server.HandleAcceptInvitation(logger, testDB.Client)
+	rec = orgRequest(accept, http.MethodPost, f.member.ID, nil, map[string]string{"id": invitationID})
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = orgRequest(accept, http.MethodPost, f.outside.ID, nil, map[string]string{"id": invitationID})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var org server.OrganizationResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &org))
+	assert.Equal(t, f.org.ID, org.ID)
+	assert.Equal(t, "admin", org.Role)
+
+	// Accepted invitations are no longer pending
+	rec = orgRequest(accept, http.MethodPost, f.outside.ID, nil, map[string]string{"id": invitationID})
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+	rec = orgRequest(server.HandleListInvitations(logger, testDB.Client), http.MethodGet, f.owner.ID, nil, f.path())
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	assert.Empty(t, list.Data)
+}
+
+func TestRevokeInvitation(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupOrgFixture(ctx, t, testDB)
+
+	expired, err := testDB.Client.OrganizationInvitation.Create().
+		SetOrganizationID(f.org.ID).
+		SetEmail(f.outside.Email).
+		SetExpiresAt(time.Now().Add(-time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+	pending, err := testDB.Client.OrganizationInvitation.Create().
+		SetOrganizationID(f.org.ID).
+		SetEmail(f.outside.Email).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Expired invitations cannot be accepted
+	rec := orgRequest(server.HandleAcceptInvitation(logger, testDB.Client), http.MethodPost, f.outside.ID, nil,
+		map[string]string{"id": fmt.Sprintf("%d", expired.ID)})
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	revoke := server.HandleRevokeInvitation(logger, testDB.Client)
+	path := f.path("invitationID", fmt.Sprintf("%d", pending.ID))
+	rec = orgRequest(revoke, http.MethodDelete, f.member.ID, nil, path)
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+	rec = orgRequest(revoke, http.MethodDelete, f.admin.ID, nil, path)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	rec = orgRequest(revoke, http.MethodDelete, f.admin.ID, nil, path)
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+}
+
+func TestOrganizationPolls(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupOrgFixture(ctx, t, testDB)
+	bus := events.NewMemory(logger)
+
+	createBody := server.CreatePollRequest{Title: "Team lunch?", Options: []string{"Yes", "No"}, OrganizationID: &f.org.ID}
+	rec := orgRequest(server.HandleCreatePoll(logger, testDB.Client, bus), http.MethodPost, f.outside.ID, createBody, nil)
+	require.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = orgRequest(server.HandleCreatePoll(logger, testDB.Client, bus), http.MethodPost, f.member.ID, createBody, nil)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var poll server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+	require.NotNil(t, poll.OrganizationID)
+	assert.Equal(t, f.org.ID, *poll.OrganizationID)
+
+	_, err := testDB.Client.Poll.Create().SetOwnerID(f.outside.ID).SetTitle("Everyone").Save(ctx)
+	require.NoError(t, err)
+
+	pollPath := map[string]string{"id": fmt.Sprintf("%d", poll.ID)}
+	tests := []struct {
+		name       string
+		userID     int
+		wantStatus int
+		wantTitles []string
+	}{
+		{name: "member", userID: f.admin.ID, wantStatus: http.StatusOK, wantTitles: []string{"Everyone", "Team lunch?"}},
+		{name: "outsider", userID: f.outside.ID, wantStatus: http.StatusNotFound, wantTitles: []string{"Everyone"}},
+		{name: "unauthenticated", wantStatus: http.StatusNotFound, wantTitles: []string{"Everyone"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := orgRequest(server.HandleGetPoll(logger, testDB.Client), http.MethodGet, tt.userID, nil, pollPath)
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			ballot := server.VoteRequest{OptionID: poll.Options[0].ID}
+			rec = orgRequest(server.HandleVote(logger, testDB.Client, bus), http.MethodPost, tt.userID, ballot, pollPath)
+			if tt.userID != 0 {
+				require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/polls?limit=10", nil)
+			if tt.userID != 0 {
+				req = asUser(req, tt.userID)
+			}
+			rec = httptest.NewRecorder()
+			server.HandleListPolls(logger, testDB.Client).ServeHTTP(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			var page server.PollListResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+			titles := make([]string, len(page.Data))
+			for i, p := range page.Data {
+				titles[i] = p.Title
+			}
+			assert.Equal(t, tt.wantTitles, titles)
+		})
+	}
+
+	// Filtering by organization lists its polls only
+	req := asUser(httptest.NewRequest(http.MethodGet, fmt.Sprintf("/polls?organization_id=%d", f.org.ID), nil), f.member.ID)
+	rec = httptest.NewRecorder()
+	server.HandleListPolls(logger, testDB.Client).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var page server.PollListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	require.Len(t, page.Data, 1)
+	assert.Equal(t, poll.ID, page.Data[0].ID)
+}
+
+func TestOrganizationPolls_Management(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handler := server.AddRoutes(ctx, &config.Config{APITimeout: time.Minute}, logger, testDB.DB, testDB.Client, events.NewMemory(logger))
+
+	serve := func(method, path, token string, body any) *httptest.ResponseRecorder {
+		req := testutil.NewRequest(method, path, 0, body)
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	register := func(username string) server.RegisteredUserResponse {
+		rec := serve(http.MethodPost, "/v1/users", "", server.RegisterUserRequest{Username: username, Email: username + "@example.com"})
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var registered server.RegisteredUserResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registered))
+		return registered
+	}
+
+	owner, admin, member, other := register("owner"), register("admin"), register("member"), register("other")
+	rec := serve(http.MethodPost, "/v1/orgs", owner.Token, server.CreateOrganizationRequest{Name: "Platform"})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var org server.OrganizationResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &org))
+	for userID, role := range map[int]membership.Role{
+		admin.ID:  membership.RoleAdmin,
+		member.ID: membership.RoleMember,
+		other.ID:  membership.RoleMember,
+	} {
+		_, err := testDB.Client.Membership.Create().SetOrganizationID(org.ID).SetUserID(userID).SetRole(role).Save(ctx)
+		require.NoError(t, err)
+	}
+
+	// The member who created these polls may leave; the organization's
+	// owners and admins still manage them, other members do not
+	create := func(visibility string) server.PollResponse {
+		body := server.CreatePollRequest{Title: "Retro day?", Options: []string{"Thu", "Fri"}, OrganizationID: &org.ID, Visibility: visibility}
+		rec := serve(http.MethodPost, "/v1/polls", member.Token, body)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var poll server.PollResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+		return poll
+	}
+	public, private := create("public"), create("private")
+
+	rec = serve(http.MethodPost, fmt.Sprintf("/v1/polls/%d/close", public.ID), other.Token, nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+	rec = serve(http.MethodPost, fmt.Sprintf("/v1/polls/%d/close", public.ID), admin.Token, nil)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = serve(http.MethodGet, fmt.Sprintf("/v1/polls/%d", private.ID), other.Token, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+	rec = serve(http.MethodGet, fmt.Sprintf("/v1/polls/%d", private.ID), admin.Token, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var seen server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &seen))
+	assert.NotEmpty(t, seen.ShareSlug, "managers see the share link")
+
+	rec = serve(http.MethodDelete, fmt.Sprintf("/v1/polls/%d", private.ID), owner.Token, nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+}
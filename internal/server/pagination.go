@@ -0,0 +1,295 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/participation"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+)
+
+const (
+	// DefaultPageLimit is the page size used when no limit is requested
+	DefaultPageLimit = 20
+	// MaxPageLimit is the largest page size a client may request
+	MaxPageLimit = 100
+)
+
+// Poll list sort orders
+const (
+	SortNewest      = "newest"
+	SortMostVotes   = "most_votes"
+	SortClosingSoon = "closing_soon"
+)
+
+var pollSorts = []string{SortNewest, SortMostVotes, SortClosingSoon}
+
+var pollStatuses = []string{PollStatusDraft, PollStatusScheduled, PollStatusOpen, PollStatusClosed}
+
+// pollCursor marks the last poll of a page. It holds the sort key of that
+// poll so the next page can continue after it without an offset.
+type pollCursor struct {
+	Sort      string     `json:"s"`
+	ID        int        `json:"id"`
+	CreatedAt time.Time  `json:"c,omitzero"`
+	ClosesAt  *time.Time `json:"e,omitempty"`
+	Votes     int        `json:"v,omitempty"`
+}
+
+// encode returns the cursor as an opaque URL-safe string
+func (c pollCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodePollCursor parses a cursor produced by encode
+func decodePollCursor(s string) (pollCursor, error) {
+	var c pollCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, err
+	}
+	if c.ID <= 0 || !slices.Contains(pollSorts, c.Sort) {
+		return c, fmt.Errorf("malformed cursor")
+	}
+	return c, nil
+}
+
+// listPollsQuery holds the filters, sort order and page of a poll listing
+type listPollsQuery struct {
+	Limit          int
+	Sort           string
+	Cursor         *pollCursor
+	OrganizationID int
+	OwnerID        int
+	Status         string
+	Visibility     string
+	CreatedAfter   *time.Time
+	CreatedBefore  *time.Time
+}
+
+// parseListPollsQuery reads the poll listing parameters from the URL query
+// and returns an error message if any is invalid
+func parseListPollsQuery(values url.Values) (listPollsQuery, string) {
+	q := listPollsQuery{Limit: DefaultPageLimit, Sort: SortNewest}
+
+	if v := values.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > MaxPageLimit {
+			return q, fmt.Sprintf("limit must be between 1 and %d", MaxPageLimit)
+		}
+		q.Limit = limit
+	}
+
+	if v := values.Get("sort"); v != "" {
+		if !slices.Contains(pollSorts, v) {
+			return q, fmt.Sprintf("unsupported sort %q", v)
+		}
+		q.Sort = v
+	}
+
+	if v := values.Get("cursor"); v != "" {
+		c, err := decodePollCursor(v)
+		if err != nil || c.Sort != q.Sort {
+			return q, "invalid cursor"
+		}
+		q.Cursor = &c
+	}
+
+	if v := values.Get("organization_id"); v != "" {
+		orgID, err := strconv.Atoi(v)
+		if err != nil || orgID < 1 {
+			return q, "organization_id must be a positive integer"
+		}
+		q.OrganizationID = orgID
+	}
+
+	if v := values.Get("owner_id"); v != "" {
+		ownerID, err := strconv.Atoi(v)
+		if err != nil || ownerID < 1 {
+			return q, "owner_id must be a positive integer"
+		}
+		q.OwnerID = ownerID
+	}
+
+	if v := values.Get("status"); v != "" {
+		if !slices.Contains(pollStatuses, v) {
+			return q, fmt.Sprintf("unsupported status %q", v)
+		}
+		q.Status = v
+	}
+
+	if v := values.Get("visibility"); v != "" {
+		if errs := ValidatePollVisibility(v); errs != nil {
+			return q, errs.Detail()
+		}
+		q.Visibility = v
+	}
+
+	for _, param := range []struct {
+		name string
+		dst  **time.Time
+	}{
+		{"created_after", &q.CreatedAfter},
+		{"created_before", &q.CreatedBefore},
+	} {
+		if v := values.Get(param.name); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return q, param.name + " must be an RFC 3339 timestamp"
+			}
+			*param.dst = &t
+		}
+	}
+
+	return q, ""
+}
+
+// ballotCount returns an SQL expression counting the ballots cast on the
+// selected poll, the key of the most_votes sort. Ballots on anonymous polls
+// are counted by their participations.
+func ballotCount(s *entsql.Selector) string {
+	return fmt.Sprintf("((SELECT COUNT(*) FROM %s WHERE %s.%s = %s) + (SELECT COUNT(*) FROM %s WHERE %s.%s = %s))",
+		ballot.Table, ballot.Table, ballot.FieldPollID, s.C(entpoll.FieldID),
+		participation.Table, participation.Table, participation.FieldPollID, s.C(entpoll.FieldID))
+}
+
+// orderPolls applies the sort order to the query and, when a cursor is
+// given, restricts it to polls after the cursor in that order
+func orderPolls(q *ent.PollQuery, sort string, cursor *pollCursor) *ent.PollQuery {
+	switch sort {
+	case SortMostVotes:
+		q.Order(
+			func(s *entsql.Selector) { s.OrderBy(entsql.Desc(ballotCount(s))) },
+			entpoll.ByID(entsql.OrderDesc()),
+		)
+		if cursor != nil {
+			q.Where(func(s *entsql.Selector) {
+				votes := ballotCount(s)
+				s.Where(entsql.Or(
+					entsql.LT(votes, cursor.Votes),
+					entsql.And(entsql.EQ(votes, cursor.Votes), entsql.LT(s.C(entpoll.FieldID), cursor.ID)),
+				))
+			})
+		}
+	case SortClosingSoon:
+		// Polls without a closing time come last
+		q.Order(
+			entpoll.ByClosesAt(entsql.OrderNullsLast()),
+			entpoll.ByID(),
+		)
+		switch {
+		case cursor != nil && cursor.ClosesAt != nil:
+			q.Where(entpoll.Or(
+				entpoll.ClosesAtGT(*cursor.ClosesAt),
+				entpoll.And(entpoll.ClosesAt(*cursor.ClosesAt), entpoll.IDGT(cursor.ID)),
+				entpoll.ClosesAtIsNil(),
+			))
+		case cursor != nil:
+			q.Where(entpoll.ClosesAtIsNil(), entpoll.IDGT(cursor.ID))
+		}
+	default:
+		q.Order(
+			entpoll.ByCreatedAt(entsql.OrderDesc()),
+			entpoll.ByID(entsql.OrderDesc()),
+		)
+		if cursor != nil {
+			q.Where(entpoll.Or(
+				entpoll.CreatedAtLT(cursor.CreatedAt),
+				entpoll.And(entpoll.CreatedAt(cursor.CreatedAt), entpoll.IDLT(cursor.ID)),
+			))
+		}
+	}
+	return q
+}
+
+// cursorAfter returns the cursor that continues a listing after p
+func cursorAfter(ctx context.Context, client *ent.Client, sort string, p *ent.Poll) (pollCursor, error) {
+	c := pollCursor{Sort: sort, ID: p.ID}
+	switch sort {
+	case SortMostVotes:
+		votes, err := countBallots(ctx, client, p)
+		if err != nil {
+			return c, err
+		}
+		c.Votes = votes
+	case SortClosingSoon:
+		c.ClosesAt = p.ClosesAt
+	default:
+		c.CreatedAt = p.CreatedAt
+	}
+	return c, nil
+}
+
+// countBallots returns the number of ballots cast on the poll
+func countBallots(ctx context.Context, client *ent.Client, p *ent.Poll) (int, error) {
+	if p.Anonymous {
+		return client.Participation.Query().Where(participation.PollID(p.ID)).Count(ctx)
+	}
+	return client.Ballot.Query().Where(ballot.PollID(p.ID)).Count(ctx)
+}
+
+// pageQuery holds the page of a listing keyed by ID. Cursor is the ID of the
+// last row of the previous page, or zero on the first page.
+type pageQuery struct {
+	Limit  int
+	Cursor int
+}
+
+// encodeIDCursor returns the ID of a page's last row as an opaque cursor
+func encodeIDCursor(id int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(id)))
+}
+
+// decodeIDCursor parses a cursor produced by encodeIDCursor
+func decodeIDCursor(s string) (int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.Atoi(string(b))
+	if err != nil {
+		return 0, err
+	}
+	if id <= 0 {
+		return 0, fmt.Errorf("malformed cursor")
+	}
+	return id, nil
+}
+
+// parsePageQuery reads the limit and cursor of a listing keyed by ID from the
+// URL query and returns an error message if either is invalid
+func parsePageQuery(values url.Values) (pageQuery, string) {
+	q := pageQuery{Limit: DefaultPageLimit}
+
+	if v := values.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > MaxPageLimit {
+			return q, fmt.Sprintf("limit must be between 1 and %d", MaxPageLimit)
+		}
+		q.Limit = limit
+	}
+
+	if v := values.Get("cursor"); v != "" {
+		id, err := decodeIDCursor(v)
+		if err != nil {
+			return q, "invalid cursor"
+		}
+		q.Cursor = id
+	}
+
+	return q, ""
+}
@@ -0,0 +1,206 @@
+package server
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollCursor_RoundTrip(t *testing.T) {
+	closesAt := time.Date(2026, 3, 1, 9, 30, 0, 123456000, time.UTC)
+
+	tests := []struct {
+		name   string
+		cursor pollCursor
+	}{
+		{
+			name:   "newest",
+			cursor: pollCursor{Sort: SortNewest, ID: 7, CreatedAt: time.Date(2026, 1, 15, 12, 0, 0, 1000, time.UTC)},
+		},
+		{
+			name:   "most votes",
+			cursor: pollCursor{Sort: SortMostVotes, ID: 3, Votes: 42},
+		},
+		{
+			name:   "closing soon",
+			cursor: pollCursor{Sort: SortClosingSoon, ID: 9, ClosesAt: &closesAt},
+		},
+		{
+			name:   "closing soon past polls without closing time",
+			cursor: pollCursor{Sort: SortClosingSoon, ID: 12},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodePollCursor(tt.cursor.encode())
+			require.NoError(t, err)
+			assert.Equal(t, tt.cursor, got)
+		})
+	}
+}
+
+func TestDecodePollCursor_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "%%%"},
+		{name: "not json", cursor: "bm90IGpzb24"},
+		{name: "unknown sort", cursor: pollCursor{Sort: "oldest", ID: 1}.encode()},
+		{name: "missing id", cursor: pollCursor{Sort: SortNewest}.encode()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodePollCursor(tt.cursor)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseListPollsQuery(t *testing.T) {
+	createdAfter := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newestCursor := pollCursor{Sort: SortNewest, ID: 5, CreatedAt: createdAfter}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    listPollsQuery
+		wantErr string
+	}{
+		{
+			name:  "defaults",
+			query: "",
+			want:  listPollsQuery{Limit: DefaultPageLimit, Sort: SortNewest},
+		},
+		{
+			name:  "all parameters",
+			query: "limit=5&sort=newest&organization_id=2&owner_id=3&status=open&visibility=private&created_after=2026-01-01T00:00:00Z&cursor=" + newestCursor.encode(),
+			want: listPollsQuery{
+				Limit:          5,
+				Sort:           SortNewest,
+				Cursor:         &newestCursor,
+				OrganizationID: 2,
+				OwnerID:        3,
+				Status:         PollStatusOpen,
+				Visibility:     "private",
+				CreatedAfter:   &createdAfter,
+			},
+		},
+		{
+			name:    "limit too large",
+			query:   "limit=101",
+			wantErr: "limit must be between 1 and 100",
+		},
+		{
+			name:    "limit not a number",
+			query:   "limit=ten",
+			wantErr: "limit must be between 1 and 100",
+		},
+		{
+			name:    "unknown sort",
+			query:   "sort=oldest",
+			wantErr: `unsupported sort "oldest"`,
+		},
+		{
+			name:    "cursor from another sort",
+			query:   "sort=most_votes&cursor=" + newestCursor.encode(),
+			wantErr: "invalid cursor",
+		},
+		{
+			name:    "garbage cursor",
+			query:   "cursor=abc",
+			wantErr: "invalid cursor",
+		},
+		{
+			name:    "invalid organization",
+			query:   "organization_id=abc",
+			wantErr: "organization_id must be a positive integer",
+		},
+		{
+			name:    "invalid owner",
+			query:   "owner_id=-1",
+			wantErr: "owner_id must be a positive integer",
+		},
+		{
+			name:    "unknown status",
+			query:   "status=archived",
+			wantErr: `unsupported status "archived"`,
+		},
+		{
+			name:    "unknown visibility",
+			query:   "visibility=secret",
+			wantErr: `unsupported visibility "secret"`,
+		},
+		{
+			name:    "invalid created_before",
+			query:   "created_before=yesterday",
+			wantErr: "created_before must be an RFC 3339 timestamp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			got, errMsg := parseListPollsQuery(values)
+			assert.Equal(t, tt.wantErr, errMsg)
+			if tt.wantErr == "" {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParsePageQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    pageQuery
+		wantErr string
+	}{
+		{
+			name:  "defaults",
+			query: "",
+			want:  pageQuery{Limit: DefaultPageLimit},
+		},
+		{
+			name:  "limit and cursor",
+			query: "limit=5&cursor=" + encodeIDCursor(42),
+			want:  pageQuery{Limit: 5, Cursor: 42},
+		},
+		{
+			name:    "limit too small",
+			query:   "limit=0",
+			wantErr: "limit must be between 1 and 100",
+		},
+		{
+			name:    "garbage cursor",
+			query:   "cursor=not-a-cursor",
+			wantErr: "invalid cursor",
+		},
+		{
+			name:    "cursor not an id",
+			query:   "cursor=" + encodeIDCursor(-3),
+			wantErr: "invalid cursor",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			got, errMsg := parsePageQuery(values)
+			assert.Equal(t, tt.wantErr, errMsg)
+			if tt.wantErr == "" {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
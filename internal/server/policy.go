@@ -0,0 +1,154 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/polloption"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// canManagePoll reports whether the principal may edit, close, reopen or
+// delete the poll. Owners manage their own polls, organization owners and
+// admins manage their organization's polls, so polls outlive the members who
+// created them, and admins manage every poll.
+func canManagePoll(principal middleware.Principal, p *ent.Poll) bool {
+	if principal.Admin || principal.UserID == p.OwnerID {
+		return true
+	}
+	return p.OrganizationID != nil && principal.ManagesOrganization(*p.OrganizationID)
+}
+
+// canManageUser reports whether the principal may edit or delete the user.
+// Users manage their own account and admins manage every account.
+func canManageUser(principal middleware.Principal, u *ent.User) bool {
+	return principal.Admin || principal.UserID == u.ID
+}
+
+// authorizePollManagement writes a 403 response and returns false when the
+// principal may not manage the poll
+func authorizePollManagement(w http.ResponseWriter, r *http.Request, principal middleware.Principal, p *ent.Poll) bool {
+	if !canManagePoll(principal, p) {
+		writeForbiddenError(w, r, "only the poll owner or an admin can modify this poll")
+		return false
+	}
+	return true
+}
+
+// resultsVisible reports whether the principal may see the poll's vote
+// counts and results at the given moment. voted is whether the principal has
+// a ballot on the poll. Owners and admins always see them, and polls that
+// show results after voting show them to everyone once they close.
+func resultsVisible(p *ent.Poll, principal middleware.Principal, voted bool, now time.Time) bool {
+	if canManagePoll(principal, p) {
+		return true
+	}
+
+	closed := pollStatus(p, now) == PollStatusClosed
+	switch p.ResultsVisibility {
+	case entpoll.ResultsVisibilityAlways:
+		return true
+	case entpoll.ResultsVisibilityAfterVote:
+		return voted || closed
+	case entpoll.ResultsVisibilityAfterClose:
+		return closed
+	default:
+		return false
+	}
+}
+
+// hiddenResultsMessage explains why a poll's results are hidden
+func hiddenResultsMessage(p *ent.Poll) string {
+	switch p.ResultsVisibility {
+	case entpoll.ResultsVisibilityAfterVote:
+		return "results are visible after voting"
+	case entpoll.ResultsVisibilityAfterClose:
+		return "results are visible once the poll closes"
+	default:
+		return "results are only visible to the poll owner"
+	}
+}
+
+// roleRank orders organization roles from least to most privileged
+func roleRank(role membership.Role) int {
+	switch role {
+	case membership.RoleOwner:
+		return 2
+	case membership.RoleAdmin:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// canManageMembers reports whether a member with the role may invite and
+// remove members of the organization
+func canManageMembers(role membership.Role) bool {
+	return roleRank(role) >= roleRank(membership.RoleAdmin)
+}
+
+// canGrantRole reports whether a member with the actor role may invite users
+// with the given role. Admins invite admins and members; only owners make
+// other owners.
+func canGrantRole(actor, role membership.Role) bool {
+	return canManageMembers(actor) && roleRank(role) <= roleRank(actor)
+}
+
+// canRemoveMember reports whether actor may remove target from their
+// organization. Anyone may leave, admins remove members and owners remove
+// anyone.
+func canRemoveMember(actor, target *ent.Membership) bool {
+	if actor.UserID == target.UserID || actor.Role == membership.RoleOwner {
+		return true
+	}
+	return canManageMembers(actor.Role) && roleRank(target.Role) < roleRank(actor.Role)
+}
+
+// loadManagedPoll loads the poll named in the request path, with its options
+// in creation order, after checking that the authenticated principal may
+// manage it. It writes an error response and returns false otherwise.
+func loadManagedPoll(logger *slog.Logger, client *ent.Client, w http.ResponseWriter, r *http.Request, action string) (*ent.Poll, bool) {
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		writeValidationError(w, r, "invalid poll id")
+		return nil, false
+	}
+
+	p, err := queryViewablePolls(r.Context(), client, principal).
+		Where(entpoll.ID(id)).
+		WithOptions(func(q *ent.PollOptionQuery) {
+			q.Order(ent.Asc(polloption.FieldID))
+		}).
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeNotFoundError(w, r, "poll not found")
+			return nil, false
+		}
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelError,
+			"failed to query poll",
+			slog.String("error", err.Error()),
+			slog.Int("poll_id", id),
+		)
+		writeInternalError(w, r, "failed to "+action)
+		return nil, false
+	}
+
+	if !authorizePollManagement(w, r, principal, p) {
+		return nil, false
+	}
+	return p, true
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+func TestCanManagePoll(t *testing.T) {
+	orgID := 3
+	p := &ent.Poll{ID: 1, OwnerID: 7, OrganizationID: &orgID}
+
+	tests := []struct {
+		name      string
+		principal middleware.Principal
+		want      bool
+	}{
+		{name: "owner", principal: middleware.Principal{UserID: 7}, want: true},
+		{name: "other user", principal: middleware.Principal{UserID: 8}, want: false},
+		{name: "admin", principal: middleware.Principal{UserID: 8, Admin: true}, want: true},
+		{name: "organization admin", principal: middleware.Principal{UserID: 8, ManagedOrganizations: []int{3}}, want: true},
+		{name: "other organization admin", principal: middleware.Principal{UserID: 8, ManagedOrganizations: []int{4}}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canManagePoll(tt.principal, p))
+		})
+	}
+}
+
+func TestCanManageUser(t *testing.T) {
+	u := &ent.User{ID: 7}
+
+	tests := []struct {
+		name      string
+		principal middleware.Principal
+		want      bool
+	}{
+		{name: "the user", principal: middleware.Principal{UserID: 7}, want: true},
+		{name: "other user", principal: middleware.Principal{UserID: 8}, want: false},
+		{name: "admin", principal: middleware.Principal{UserID: 8, Admin: true}, want: true},
+		{name: "unauthenticated", principal: middleware.Principal{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canManageUser(tt.principal, u))
+		})
+	}
+}
+
+func TestResultsVisible(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+
+	owner := middleware.Principal{UserID: 7}
+	admin := middleware.Principal{UserID: 9, Admin: true}
+	voter := middleware.Principal{UserID: 8}
+	anonymous := middleware.Principal{}
+
+	tests := []struct {
+		name       string
+		visibility entpoll.ResultsVisibility
+		closed     bool
+		principal  middleware.Principal
+		voted      bool
+		want       bool
+	}{
+		{name: "always", visibility: entpoll.ResultsVisibilityAlways, principal: anonymous, want: true},
+		{name: "after vote before voting", visibility: entpoll.ResultsVisibilityAfterVote, principal: voter, want: false},
+		{name: "after vote once voted", visibility: entpoll.ResultsVisibilityAfterVote, principal: voter, voted: true, want: true},
+		{name: "after vote once closed", visibility: entpoll.ResultsVisibilityAfterVote, closed: true, principal: anonymous, want: true},
+		{name: "after vote to the owner", visibility: entpoll.ResultsVisibilityAfterVote, principal: owner, want: true},
+		{name: "after close while open", visibility: entpoll.ResultsVisibilityAfterClose, principal: voter, voted: true, want: false},
+		{name: "after close once closed", visibility: entpoll.ResultsVisibilityAfterClose, closed: true, principal: anonymous, want: true},
+		{name: "after close to an admin", visibility: entpoll.ResultsVisibilityAfterClose, principal: admin, want: true},
+		{name: "owner only to a voter", visibility: entpoll.ResultsVisibilityOwnerOnly, closed: true, principal: voter, voted: true, want: false},
+		{name: "owner only to the owner", visibility: entpoll.ResultsVisibilityOwnerOnly, principal: owner, want: true},
+		{name: "owner only to an admin", visibility: entpoll.ResultsVisibilityOwnerOnly, principal: admin, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ent.Poll{ID: 1, OwnerID: owner.UserID, ResultsVisibility: tt.visibility}
+			if tt.closed {
+				p.ClosedAt = &past
+			}
+			assert.Equal(t, tt.want, resultsVisible(p, tt.principal, tt.voted, now))
+		})
+	}
+}
+
+func TestCanGrantRole(t *testing.T) {
+	tests := []struct {
+		name  string
+		actor membership.Role
+		role  membership.Role
+		want  bool
+	}{
+		{name: "owner grants owner", actor: membership.RoleOwner, role: membership.RoleOwner, want: true},
+		{name: "owner grants member", actor: membership.RoleOwner, role: membership.RoleMember, want: true},
+		{name: "admin grants admin", actor: membership.RoleAdmin, role: membership.RoleAdmin, want: true},
+		{name: "admin grants owner", actor: membership.RoleAdmin, role: membership.RoleOwner, want: false},
+		{name: "member grants member", actor: membership.RoleMember, role: membership.RoleMember, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canGrantRole(tt.actor, tt.role))
+		})
+	}
+}
+
+func TestCanRemoveMember(t *testing.T) {
+	owner := &ent.Membership{UserID: 1, Role: membership.RoleOwner}
+	admin := &ent.Membership{UserID: 2, Role: membership.RoleAdmin}
+	otherAdmin := &ent.Membership{UserID: 3, Role: membership.RoleAdmin}
+	member := &ent.Membership{UserID: 4, Role: membership.RoleMember}
+	otherMember := &ent.Membership{UserID: 5, Role: membership.RoleMember}
+
+	tests := []struct {
+		name          string
+		actor, target *ent.Membership
+		want          bool
+	}{
+		{name: "member leaves", actor: member, target: member, want: true},
+		{name: "owner leaves", actor: owner, target: owner, want: true},
+		{name: "owner removes admin", actor: owner, target: admin, want: true},
+		{name: "admin removes member", actor: admin, target: member, want: true},
+		{name: "admin removes admin", actor: admin, target: otherAdmin, want: false},
+		{name: "admin removes owner", actor: admin, target: owner, want: false},
+		{name: "member removes member", actor: member, target: otherMember, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, canRemoveMember(tt.actor, tt.target))
+		})
+	}
+}
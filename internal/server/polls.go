@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	"github.com/ivankorhner/polling-app/internal/ent/organization"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
+)
+
+// The functions here load, authorize and change polls in terms of domain
+// values. Handlers decode their version's request into them and encode the
+// result into their version's response, so each API version only adds its
+// own request and response types.
+
+// pollSpec describes a poll to create, with every default applied
+type pollSpec struct {
+	OrganizationID    *int
+	Title             string
+	Options           []string
+	VotingMethod      entpoll.VotingMethod
+	MinSelections     int
+	MaxSelections     int
+	Draft             bool
+	Anonymous         bool
+	Visibility        entpoll.Visibility
+	ResultsVisibility entpoll.ResultsVisibility
+	OpensAt           *time.Time
+	ClosesAt          *time.Time
+}
+
+// createPoll creates the poll for the principal, who owns it, announces it
+// to subscribers and webhooks, and returns it with its options. Polls are
+// only created in organizations the principal belongs to; for any other
+// organization it returns a not-found error.
+func createPoll(ctx context.Context, logger *slog.Logger, client *ent.Client, bus events.Bus, principal middleware.Principal, spec pollSpec) (*ent.Poll, error) {
+	if spec.OrganizationID != nil {
+		_, err := client.Organization.Query().
+			Where(
+				organization.ID(*spec.OrganizationID),
+				organization.HasMembershipsWith(membership.UserID(principal.UserID)),
+			).
+			OnlyID(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := createPollWithOptions(ctx, client, principal.UserID, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	publishEvent(ctx, logger, bus, events.TypePollCreated, p.ID)
+	return loadViewablePoll(ctx, client, p.ID, principal)
+}
+
+func createPollWithOptions(ctx context.Context, client *ent.Client, ownerID int, spec pollSpec) (*ent.Poll, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := tx.Poll.Create().
+		SetOwnerID(ownerID).
+		SetNillableOrganizationID(spec.OrganizationID).
+		SetTitle(spec.Title).
+		SetVotingMethod(spec.VotingMethod).
+		SetMinSelections(spec.MinSelections).
+		SetMaxSelections(spec.MaxSelections).
+		SetDraft(spec.Draft).
+		SetAnonymous(spec.Anonymous).
+		SetVisibility(spec.Visibility).
+		SetResultsVisibility(spec.ResultsVisibility).
+		SetNillableOpensAt(spec.OpensAt).
+		SetNillableClosesAt(spec.ClosesAt)
+	if spec.Visibility != entpoll.VisibilityPublic {
+		create.SetShareSlug(newShareSlug())
+	}
+
+	poll, err := create.Save(ctx)
+	if err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	for _, optionText := range spec.Options {
+		_, err := tx.PollOption.Create().
+			SetPollID(poll.ID).
+			SetText(optionText).
+			Save(ctx)
+		if err != nil {
+			return nil, errors.Join(err, tx.Rollback())
+		}
+	}
+
+	if err := webhooks.Enqueue(ctx, tx, events.TypePollCreated, poll, nil); err != nil {
+		return nil, errors.Join(err, tx.Rollback())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return poll, nil
+}
+
+// getPoll loads a poll the principal may see, with its options, and the
+// validators of the principal's view of it. It returns a not-found error for
+// polls hidden from the principal.
+func getPoll(ctx context.Context, client *ent.Client, id int, principal middleware.Principal) (*ent.Poll, pollValidators, error) {
+	p, err := loadViewablePoll(ctx, client, id, principal)
+	if err != nil {
+		return nil, pollValidators{}, err
+	}
+
+	validators, err := loadPollValidators(ctx, client, p, principal)
+	if err != nil {
+		return nil, pollValidators{}, err
+	}
+	return p, validators, nil
+}
+
+// pollView is a poll, loaded with its options, as a principal sees it
+type pollView struct {
+	Poll *ent.Poll
+	// VoteCounts are keyed by option ID
+	VoteCounts map[int]int
+	// ResultsVisible is false when the vote counts must be withheld
+	ResultsVisible bool
+	// Manages reports whether the principal may manage the poll, which
+	// reveals its share slug
+	Manages bool
+}
+
+// viewPoll returns the poll, loaded with its options, as the principal sees
+// it
+func viewPoll(ctx context.Context, client *ent.Client, p *ent.Poll, principal middleware.Principal) (pollView, error) {
+	counts, err := voteCounts(ctx, client, p.ID)
+	if err != nil {
+		return pollView{}, err
+	}
+
+	visible, err := resultsVisibleTo(ctx, client, principal, p)
+	if err != nil {
+		return pollView{}, err
+	}
+
+	return pollView{
+		Poll:           p,
+		VoteCounts:     counts,
+		ResultsVisible: visible,
+		Manages:        canManagePoll(principal, p),
+	}, nil
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	"github.com/ivankorhner/polling-app/internal/ent/organization"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/pollinvitee"
+	"github.com/ivankorhner/polling-app/internal/ent/predicate"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// shareSlugBytes is the number of random bytes in a share slug
+const shareSlugBytes = 16
+
+// newShareSlug generates the unguessable slug that unlisted and private
+// polls are shared by
+func newShareSlug() string {
+	b := make([]byte, shareSlugBytes)
+	_, _ = rand.Read(b) // never returns an error
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// shareSlugKey is the context key for the share slug a request reached its
+// poll by
+type shareSlugKey struct{}
+
+// withShareSlug returns a context carrying the share slug the request
+// presented
+func withShareSlug(ctx context.Context, slug string) context.Context {
+	return context.WithValue(ctx, shareSlugKey{}, slug)
+}
+
+// shareSlugFromContext returns the share slug the request presented, if any
+func shareSlugFromContext(ctx context.Context) string {
+	slug, _ := ctx.Value(shareSlugKey{}).(string)
+	return slug
+}
+
+// viewablePolls matches the polls the principal may see: public polls, polls
+// the principal manages, private polls the principal is invited to and the
+// unlisted poll whose share slug was presented. Polls of an organization are
+// further limited to its members. Admins see every poll.
+func viewablePolls(principal middleware.Principal, slug string) predicate.Poll {
+	if principal.Admin {
+		return func(*sql.Selector) {}
+	}
+
+	tenant := []predicate.Poll{entpoll.OrganizationIDIsNil()}
+	if principal.UserID != 0 {
+		tenant = append(tenant, entpoll.HasOrganizationWith(
+			organization.HasMembershipsWith(membership.UserID(principal.UserID)),
+		))
+	}
+
+	viewable := []predicate.Poll{entpoll.VisibilityEQ(entpoll.VisibilityPublic)}
+	if principal.UserID != 0 {
+		viewable = append(viewable,
+			entpoll.OwnerID(principal.UserID),
+			entpoll.And(
+				entpoll.VisibilityEQ(entpoll.VisibilityPrivate),
+				entpoll.HasInviteesWith(pollinvitee.UserID(principal.UserID)),
+			),
+		)
+	}
+	if len(principal.ManagedOrganizations) > 0 {
+		viewable = append(viewable, entpoll.OrganizationIDIn(principal.ManagedOrganizations...))
+	}
+	if slug != "" {
+		viewable = append(viewable, entpoll.And(
+			entpoll.VisibilityEQ(entpoll.VisibilityUnlisted),
+			entpoll.ShareSlug(slug),
+		))
+	}
+	return entpoll.And(entpoll.Or(tenant...), entpoll.Or(viewable...))
+}
+
+// queryViewablePolls starts a query over the polls the principal may see,
+// honoring the share slug the request presented. Polls outside it are
+// reported as not found so their IDs reveal nothing.
+func queryViewablePolls(ctx context.Context, client *ent.Client, principal middleware.Principal) *ent.PollQuery {
+	return client.Poll.Query().Where(viewablePolls(principal, shareSlugFromContext(ctx)))
+}
+
+// HandleSharedPoll serves a poll route by share slug: it looks the poll up
+// by the slug in the request path and hands the request to next as if the
+// poll's ID had been given, with the slug granting access to unlisted polls.
+// Private polls still admit only their invitees.
+func HandleSharedPoll(logger *slog.Logger, client *ent.Client, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slug := r.PathValue("slug")
+
+		id, err := client.Poll.Query().
+			Where(entpoll.ShareSlug(slug)).
+			OnlyID(r.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeNotFoundError(w, r, "poll not found")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to query shared poll", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to retrieve poll")
+			return
+		}
+
+		r = r.WithContext(withShareSlug(r.Context(), slug))
+		r.SetPathValue("id", strconv.Itoa(id))
+		next.ServeHTTP(w, r)
+	})
+}
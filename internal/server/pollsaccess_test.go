@@ -0,0 +1,355 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accessFixture is an owner, an invitee, a stranger and a poll per
+// visibility, each created through the API so non-public polls get a share
+// slug
+type accessFixture struct {
+	owner, invitee, stranger *ent.User
+	polls                    map[entpoll.Visibility]server.PollResponse
+}
+
+func setupAccessFixture(ctx context.Context, t *testing.T, testDB *testutil.TestDB, logger *slog.Logger) accessFixture {
+	t.Helper()
+
+	f := accessFixture{polls: make(map[entpoll.Visibility]server.PollResponse)}
+	users := testutil.CreateUsers(ctx, t, testDB.Client, "owner", "invitee", "stranger")
+	f.owner, f.invitee, f.stranger = users[0], users[1], users[2]
+
+	bus := events.NewMemory(logger)
+	for _, visibility := range []entpoll.Visibility{
+		entpoll.VisibilityPublic,
+		entpoll.VisibilityUnlisted,
+		entpoll.VisibilityPrivate,
+	} {
+		req := testutil.NewRequest(http.MethodPost, "/polls", f.owner.ID, server.CreatePollRequest{
+			Title:      string(visibility),
+			Options:    []string{"Yes", "No"},
+			Visibility: string(visibility),
+		})
+		rec := httptest.NewRecorder()
+		server.HandleCreatePoll(logger, testDB.Client, bus).ServeHTTP(rec, req)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+		var poll server.PollResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+		f.polls[visibility] = poll
+	}
+
+	_, err := testDB.Client.PollInvitee.Create().
+		SetPollID(f.polls[entpoll.VisibilityPrivate].ID).
+		SetUserID(f.invitee.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	return f
+}
+
+// byID sends a request for the poll by its ID as the user, unauthenticated
+// when userID is zero
+func (f accessFixture) byID(handler http.Handler, method string, poll server.PollResponse, userID int, body any) *httptest.ResponseRecorder {
+	req := testutil.NewRequest(method, fmt.Sprintf("/polls/%d", poll.ID), userID, body)
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// bySlug sends a request for the poll by share slug as the user through
+// HandleSharedPoll
+func (f accessFixture) bySlug(logger *slog.Logger, client *ent.Client, handler http.Handler, method, slug string, userID int, body any) *httptest.ResponseRecorder {
+	req := testutil.NewRequest(method, "/p/"+slug, userID, body)
+	req.SetPathValue("slug", slug)
+	rec := httptest.NewRecorder()
+	server.HandleSharedPoll(logger, client, handler).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestPollAccess_Create(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupAccessFixture(ctx, t, testDB, logger)
+
+	public := f.polls[entpoll.VisibilityPublic]
+	assert.Equal(t, "public", public.Visibility)
+	assert.Nil(t, public.ShareSlug)
+
+	for _, visibility := range []entpoll.Visibility{entpoll.VisibilityUnlisted, entpoll.VisibilityPrivate} {
+		poll := f.polls[visibility]
+		assert.Equal(t, string(visibility), poll.Visibility)
+		require.NotNil(t, poll.ShareSlug, visibility)
+		assert.Len(t, *poll.ShareSlug, 22, visibility)
+	}
+	assert.NotEqual(t, *f.polls[entpoll.VisibilityUnlisted].ShareSlug, *f.polls[entpoll.VisibilityPrivate].ShareSlug)
+
+	body, _ := json.Marshal(server.CreatePollRequest{Title: "Secret", Options: []string{"A", "B"}, Visibility: "secret"})
+	req := asUser(httptest.NewRequest(http.MethodPost, "/polls", bytes.NewReader(body)), f.owner.ID)
+	rec := httptest.NewRecorder()
+	server.HandleCreatePoll(logger, testDB.Client, events.NewMemory(logger)).ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func TestPollAccess_GetPoll(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupAccessFixture(ctx, t, testDB, logger)
+	handler := server.HandleGetPoll(logger, testDB.Client)
+
+	tests := []struct {
+		name       string
+		visibility entpoll.Visibility
+		userID     int
+		bySlug     bool
+		wantStatus int
+	}{
+		{name: "public unauthenticated", visibility: entpoll.VisibilityPublic, wantStatus: http.StatusOK},
+		{name: "unlisted by id", visibility: entpoll.VisibilityUnlisted, userID: f.stranger.ID, wantStatus: http.StatusNotFound},
+		{name: "unlisted by slug", visibility: entpoll.VisibilityUnlisted, bySlug: true, wantStatus: http.StatusOK},
+		{name: "unlisted to its owner", visibility: entpoll.VisibilityUnlisted, userID: f.owner.ID, wantStatus: http.StatusOK},
+		{name: "private to a stranger", visibility: entpoll.VisibilityPrivate, userID: f.stranger.ID, wantStatus: http.StatusNotFound},
+		{name: "private by slug to a stranger", visibility: entpoll.VisibilityPrivate, userID: f.stranger.ID, bySlug: true, wantStatus: http.StatusNotFound},
+		{name: "private unauthenticated", visibility: entpoll.VisibilityPrivate, wantStatus: http.StatusNotFound},
+		{name: "private to an invitee", visibility: entpoll.VisibilityPrivate, userID: f.invitee.ID, wantStatus: http.StatusOK},
+		{name: "private by slug to an invitee", visibility: entpoll.VisibilityPrivate, userID: f.invitee.ID, bySlug: true, wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll := f.polls[tt.visibility]
+
+			var rec *httptest.ResponseRecorder
+			if tt.bySlug {
+				rec = f.bySlug(logger, testDB.Client, handler, http.MethodGet, *poll.ShareSlug, tt.userID, nil)
+			} else {
+				rec = f.byID(handler, http.MethodGet, poll, tt.userID, nil)
+			}
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var got server.PollResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.Equal(t, poll.ID, got.ID)
+			// Only the owner is shown the share slug
+			assert.Equal(t, tt.userID == f.owner.ID, got.ShareSlug != nil)
+		})
+	}
+
+	rec := f.bySlug(logger, testDB.Client, handler, http.MethodGet, "unknown", 0, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+}
+
+func TestPollAccess_ListPolls(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupAccessFixture(ctx, t, testDB, logger)
+
+	tests := []struct {
+		name       string
+		query      string
+		userID     int
+		wantTitles []string
+	}{
+		{name: "unauthenticated", wantTitles: []string{"public"}},
+		{name: "stranger", userID: f.stranger.ID, wantTitles: []string{"public"}},
+		{name: "invitee", userID: f.invitee.ID, wantTitles: []string{"private", "public"}},
+		{name: "owner", userID: f.owner.ID, wantTitles: []string{"private", "unlisted", "public"}},
+		{name: "owner filtered", query: "&visibility=unlisted", userID: f.owner.ID, wantTitles: []string{"unlisted"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutil.NewRequest(http.MethodGet, "/polls?limit=10"+tt.query, tt.userID, nil)
+			rec := httptest.NewRecorder()
+			server.HandleListPolls(logger, testDB.Client).ServeHTTP(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+			var page server.PollListResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+			titles := make([]string, len(page.Data))
+			for i, poll := range page.Data {
+				titles[i] = poll.Title
+			}
+			assert.Equal(t, tt.wantTitles, titles)
+		})
+	}
+}
+
+func TestPollAccess_Vote(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupAccessFixture(ctx, t, testDB, logger)
+	handler := server.HandleVote(logger, testDB.Client, events.NewMemory(logger))
+
+	tests := []struct {
+		name       string
+		visibility entpoll.Visibility
+		userID     int
+		bySlug     bool
+		wantStatus int
+	}{
+		{name: "unlisted by id", visibility: entpoll.VisibilityUnlisted, userID: f.stranger.ID, wantStatus: http.StatusNotFound},
+		{name: "unlisted by slug", visibility: entpoll.VisibilityUnlisted, userID: f.stranger.ID, bySlug: true, wantStatus: http.StatusOK},
+		{name: "private to a stranger", visibility: entpoll.VisibilityPrivate, userID: f.stranger.ID, bySlug: true, wantStatus: http.StatusNotFound},
+		{name: "private to an invitee", visibility: entpoll.VisibilityPrivate, userID: f.invitee.ID, wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			poll := f.polls[tt.visibility]
+			ballot := server.VoteRequest{OptionID: poll.Options[0].ID}
+
+			var rec *httptest.ResponseRecorder
+			if tt.bySlug {
+				rec = f.bySlug(logger, testDB.Client, handler, http.MethodPost, *poll.ShareSlug, tt.userID, ballot)
+			} else {
+				rec = f.byID(handler, http.MethodPost, poll, tt.userID, ballot)
+			}
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+
+	// Only the two permitted ballots were recorded
+	count, err := testDB.Client.Ballot.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestPollAccess_Invitees(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupAccessFixture(ctx, t, testDB, logger)
+	private := f.polls[entpoll.VisibilityPrivate]
+
+	tests := []struct {
+		name       string
+		userID     int
+		body       any
+		wantStatus int
+	}{
+		{name: "invite a stranger", userID: f.owner.ID, body: server.InviteUserRequest{UserID: f.stranger.ID}, wantStatus: http.StatusCreated},
+		{name: "invite twice", userID: f.owner.ID, body: server.InviteUserRequest{UserID: f.stranger.ID}, wantStatus: http.StatusConflict},
+		{name: "unknown user", userID: f.owner.ID, body: server.InviteUserRequest{UserID: 999999}, wantStatus: http.StatusNotFound},
+		{name: "missing user", userID: f.owner.ID, body: server.InviteUserRequest{}, wantStatus: http.StatusBadRequest},
+		{name: "by an invitee", userID: f.invitee.ID, body: server.InviteUserRequest{UserID: f.stranger.ID}, wantStatus: http.StatusForbidden},
+		{name: "unauthenticated", body: server.InviteUserRequest{UserID: f.stranger.ID}, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := f.byID(server.HandleInviteUser(logger, testDB.Client), http.MethodPost, private, tt.userID, tt.body)
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+
+			var invitee server.InviteeResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &invitee))
+			assert.Equal(t, f.stranger.ID, invitee.UserID)
+			assert.Equal(t, "stranger", invitee.Username)
+		})
+	}
+
+	// The stranger now sees the poll
+	rec := f.byID(server.HandleGetPoll(logger, testDB.Client), http.MethodGet, private, f.stranger.ID, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = f.byID(server.HandleListInvitees(logger, testDB.Client), http.MethodGet, private, f.owner.ID, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var list server.InviteeListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Data, 2)
+	assert.Equal(t, "invitee", list.Data[0].Username)
+	assert.Equal(t, "stranger", list.Data[1].Username)
+
+	// Withdrawing the invitation hides the poll again
+	uninvite := func(userID int) *httptest.ResponseRecorder {
+		req := asUser(httptest.NewRequest(http.MethodDelete, "/", nil), f.owner.ID)
+		req.SetPathValue("id", fmt.Sprintf("%d", private.ID))
+		req.SetPathValue("userID", fmt.Sprintf("%d", userID))
+		rec := httptest.NewRecorder()
+		server.HandleUninviteUser(logger, testDB.Client).ServeHTTP(rec, req)
+		return rec
+	}
+	rec = uninvite(f.stranger.ID)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	rec = uninvite(f.stranger.ID)
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+
+	rec = f.byID(server.HandleGetPoll(logger, testDB.Client), http.MethodGet, private, f.stranger.ID, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+}
+
+func TestPollAccess_Update(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupAccessFixture(ctx, t, testDB, logger)
+	public := f.polls[entpoll.VisibilityPublic]
+	handler := server.HandleUpdatePoll(logger, testDB.Client)
+
+	unlisted := "unlisted"
+	rec := f.byID(handler, http.MethodPatch, public, f.owner.ID, server.UpdatePollRequest{Visibility: &unlisted})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var poll server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+	assert.Equal(t, "unlisted", poll.Visibility)
+	require.NotNil(t, poll.ShareSlug)
+	slug := *poll.ShareSlug
+
+	// The poll left the public listing and is reached by its new slug
+	rec = f.byID(server.HandleGetPoll(logger, testDB.Client), http.MethodGet, public, f.stranger.ID, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code, rec.Body.String())
+	rec = f.bySlug(logger, testDB.Client, server.HandleGetPoll(logger, testDB.Client), http.MethodGet, slug, f.stranger.ID, nil)
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// Going private keeps the slug
+	private := "private"
+	rec = f.byID(handler, http.MethodPatch, public, f.owner.ID, server.UpdatePollRequest{Visibility: &private})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+	require.NotNil(t, poll.ShareSlug)
+	assert.Equal(t, slug, *poll.ShareSlug)
+
+	invalid := "secret"
+	rec = f.byID(handler, http.MethodPatch, public, f.owner.ID, server.UpdatePollRequest{Visibility: &invalid})
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+}
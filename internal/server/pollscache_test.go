@@ -0,0 +1,162 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/config"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollConditionalRequests(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	handler := server.AddRoutes(ctx, &config.Config{APITimeout: time.Minute}, logger, testDB.DB, testDB.Client, events.NewMemory(logger))
+
+	serve := func(method, path, token, body string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	// Responses differ by caller, so shared caches must not reuse them
+	assertPrivate := func(rec *httptest.ResponseRecorder) {
+		t.Helper()
+		assert.Equal(t, "private", rec.Header().Get("Cache-Control"))
+		assert.Contains(t, rec.Header().Values("Vary"), "Authorization")
+	}
+	register := func(username string) string {
+		body := fmt.Sprintf(`{"username": %q, "email": "%s@example.com"}`, username, username)
+		rec := serve(http.MethodPost, "/users", "", body, nil)
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		var registered server.RegisteredUserResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registered))
+		return registered.Token
+	}
+
+	owner := register("owner")
+	voter := register("voter")
+
+	rec := serve(http.MethodPost, "/polls", owner, `{"title": "Lunch?", "options": ["Soup", "Salad"]}`, nil)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	pollPath := fmt.Sprintf("/polls/%d", created.ID)
+
+	// A poll read carries validators that spare a repeated read
+	rec = serve(http.MethodGet, pollPath, voter, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	lastModified := rec.Header().Get("Last-Modified")
+	require.NotEmpty(t, lastModified)
+	assertPrivate(rec)
+
+	rec = serve(http.MethodGet, pollPath, voter, "", map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+	assert.Equal(t, etag, rec.Header().Get("ETag"))
+	assertPrivate(rec)
+
+	rec = serve(http.MethodGet, pollPath, voter, "", map[string]string{"If-Modified-Since": lastModified})
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+
+	// Another viewer gets a response of their own
+	rec = serve(http.MethodGet, pollPath, owner, "", map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	ownerETag := rec.Header().Get("ETag")
+	assert.NotEqual(t, etag, ownerETag)
+
+	// The list page is cached the same way
+	rec = serve(http.MethodGet, "/polls", voter, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	listETag := rec.Header().Get("ETag")
+	require.NotEmpty(t, listETag)
+	assertPrivate(rec)
+	rec = serve(http.MethodGet, "/polls", voter, "", map[string]string{"If-None-Match": listETag})
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+
+	// A vote changes the poll and the list
+	vote := fmt.Sprintf(`{"option_id": %d}`, created.Options[0].ID)
+	rec = serve(http.MethodPost, pollPath+"/vote", voter, vote, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = serve(http.MethodGet, pollPath, voter, "", map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+	rec = serve(http.MethodGet, "/polls", voter, "", map[string]string{"If-None-Match": listETag})
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// An edit against the current ETag applies and returns the next one
+	rec = serve(http.MethodGet, pollPath, owner, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	ownerETag = rec.Header().Get("ETag")
+
+	rec = serve(http.MethodPatch, pollPath, owner, `{"title": "Lunch today?"}`, map[string]string{"If-Match": ownerETag})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	nextETag := rec.Header().Get("ETag")
+	assert.NotEqual(t, ownerETag, nextETag)
+	assertPrivate(rec)
+
+	rec = serve(http.MethodGet, pollPath, owner, "", map[string]string{"If-None-Match": nextETag})
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+
+	// Edits against a stale ETag are refused
+	for _, edit := range []struct {
+		path string
+		body string
+	}{
+		{pollPath, `{"title": "Dinner?"}`},
+		{fmt.Sprintf("%s/options/%d", pollPath, created.Options[1].ID), `{"text": "Salad bar"}`},
+	} {
+		rec = serve(http.MethodPatch, edit.path, owner, edit.body, map[string]string{"If-Match": ownerETag})
+		require.Equal(t, http.StatusPreconditionFailed, rec.Code, rec.Body.String())
+		var errResp server.ErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+		assert.Equal(t, server.ErrCodePreconditionFailed, errResp.Code)
+	}
+
+	rec = serve(http.MethodGet, pollPath, owner, "", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var current server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &current))
+	assert.Equal(t, "Lunch today?", current.Title)
+	assert.Equal(t, "Salad", current.Options[1].Text)
+
+	// Renaming an option moves the poll's version too
+	rec = serve(http.MethodPatch, fmt.Sprintf("%s/options/%d", pollPath, created.Options[1].ID), owner,
+		`{"text": "Salad bar"}`, map[string]string{"If-Match": nextETag})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	renamedETag := rec.Header().Get("ETag")
+	assert.NotEqual(t, nextETag, renamedETag)
+
+	// A vote cast between reading and editing does not conflict with the edit
+	rec = serve(http.MethodPost, pollPath+"/vote", register("late"), vote, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = serve(http.MethodPatch, pollPath, owner, `{"title": "Lunch today?"}`, map[string]string{"If-Match": renamedETag})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+}
@@ -1,92 +1,157 @@
 package server
 
 import (
-	"context"
 	"encoding/json"
-	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/ivankorhner/polling-app/internal/ent"
 	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
-	"github.com/ivankorhner/polling-app/internal/ent/user"
+	"github.com/ivankorhner/polling-app/internal/events"
 )
 
-// CreatePollRequest represents the request body for poll creation
+// CreatePollRequest represents the request body for poll creation. Polls
+// created in an organization are only seen by its members.
 type CreatePollRequest struct {
-	OwnerID int      `json:"owner_id"`
-	Title   string   `json:"title"`
-	Options []string `json:"options"`
+	Title             string     `json:"title"`
+	OrganizationID    *int       `json:"organization_id,omitempty"`
+	Options           []string   `json:"options"`
+	VotingMethod      string     `json:"voting_method,omitempty"`
+	MinSelections     *int       `json:"min_selections,omitempty"`
+	MaxSelections     *int       `json:"max_selections,omitempty"`
+	Draft             bool       `json:"draft"`
+	Anonymous         bool       `json:"anonymous"`
+	Visibility        string     `json:"visibility,omitempty"`
+	ResultsVisibility string     `json:"results_visibility,omitempty"`
+	OpensAt           *time.Time `json:"opens_at,omitempty"`
+	ClosesAt          *time.Time `json:"closes_at,omitempty"`
 }
 
-// HandleCreatePoll handles poll creation
-func HandleCreatePoll(logger *slog.Logger, client *ent.Client) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		logger.LogAttrs(r.Context(), slog.LevelInfo, "create poll: starting")
+// votingMethod returns the requested voting method, defaulting to plurality
+func (req CreatePollRequest) votingMethod() entpoll.VotingMethod {
+	if req.VotingMethod == "" {
+		return entpoll.DefaultVotingMethod
+	}
+	return entpoll.VotingMethod(req.VotingMethod)
+}
 
-		// Limit request body size
-		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+// visibility returns the requested visibility, defaulting to public
+func (req CreatePollRequest) visibility() entpoll.Visibility {
+	if req.Visibility == "" {
+		return entpoll.DefaultVisibility
+	}
+	return entpoll.Visibility(req.Visibility)
+}
 
-		var req CreatePollRequest
-		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			writeValidationError(w, "invalid request body")
-			return
-		}
+// resultsVisibility returns the requested results visibility, defaulting to
+// always
+func (req CreatePollRequest) resultsVisibility() entpoll.ResultsVisibility {
+	if req.ResultsVisibility == "" {
+		return entpoll.DefaultResultsVisibility
+	}
+	return entpoll.ResultsVisibility(req.ResultsVisibility)
+}
 
-		// Validate title
-		if errMsg := ValidatePollTitle(req.Title); errMsg != "" {
-			writeValidationError(w, errMsg)
-			return
-		}
+// selectionRule returns the requested selection bounds. Plurality polls
+// default to a single choice, and when only the minimum is given the maximum
+// matches it. Other methods default to marking up to every option.
+func (req CreatePollRequest) selectionRule() (minSelections, maxSelections int) {
+	minSelections = 1
+	if req.MinSelections != nil {
+		minSelections = *req.MinSelections
+	}
 
-		// Validate owner_id
-		if req.OwnerID == 0 {
-			writeValidationError(w, "owner_id is required")
-			return
-		}
+	maxSelections = max(minSelections, 1)
+	if req.votingMethod() != entpoll.VotingMethodPlurality {
+		maxSelections = len(req.Options)
+	}
+	if req.MaxSelections != nil {
+		maxSelections = *req.MaxSelections
+	}
+
+	return minSelections, maxSelections
+}
+
+// validate returns the invalid fields of the request
+func (req CreatePollRequest) validate(now time.Time) FieldErrors {
+	errs := append(ValidatePollTitle(req.Title), ValidatePollOptions(req.Options)...)
+
+	if req.VotingMethod != "" {
+		errs = append(errs, ValidateVotingMethod(req.VotingMethod)...)
+	}
+	if req.Visibility != "" {
+		errs = append(errs, ValidatePollVisibility(req.Visibility)...)
+	}
+	if req.ResultsVisibility != "" {
+		errs = append(errs, ValidateResultsVisibility(req.ResultsVisibility)...)
+	}
+
+	minSelections, maxSelections := req.selectionRule()
+	errs = append(errs, ValidateSelectionRule(minSelections, maxSelections, len(req.Options))...)
+
+	return append(errs, ValidatePollSchedule(req.OpensAt, req.ClosesAt, now)...)
+}
+
+// spec returns the poll the request describes
+func (req CreatePollRequest) spec() pollSpec {
+	minSelections, maxSelections := req.selectionRule()
+	return pollSpec{
+		OrganizationID:    req.OrganizationID,
+		Title:             req.Title,
+		Options:           req.Options,
+		VotingMethod:      req.votingMethod(),
+		MinSelections:     minSelections,
+		MaxSelections:     maxSelections,
+		Draft:             req.Draft,
+		Anonymous:         req.Anonymous,
+		Visibility:        req.visibility(),
+		ResultsVisibility: req.resultsVisibility(),
+		OpensAt:           req.OpensAt,
+		ClosesAt:          req.ClosesAt,
+	}
+}
 
-		// Validate options
-		if errMsg := ValidatePollOptions(req.Options); errMsg != "" {
-			writeValidationError(w, errMsg)
+// HandleCreatePoll handles poll creation. The authenticated user owns the poll.
+func HandleCreatePoll(logger *slog.Logger, client *ent.Client, bus events.Bus) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
 			return
 		}
 
-		// Verify owner exists
-		exists, err := client.User.Query().Where(user.ID(req.OwnerID)).Exist(r.Context())
-		if err != nil {
-			logger.LogAttrs(
-				r.Context(),
-				slog.LevelError,
-				"failed to check user existence",
-				slog.String("error", err.Error()),
-			)
-			writeInternalError(w, "failed to create poll")
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "create poll: starting")
+
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		var req CreatePollRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
 			return
 		}
-		if !exists {
-			writeValidationError(w, "owner not found")
+		if errs := req.validate(time.Now()); errs != nil {
+			writeFieldErrors(w, r, errs)
 			return
 		}
 
-		poll, err := createPollWithOptions(r.Context(), client, req)
+		poll, err := createPoll(r.Context(), logger, client, bus, principal, req.spec())
 		if err != nil {
+			if ent.IsNotFound(err) {
+				writeNotFoundError(w, r, "organization not found")
+				return
+			}
 			logger.LogAttrs(
 				r.Context(),
 				slog.LevelError,
 				"failed to create poll",
 				slog.String("error", err.Error()),
 			)
-			writeInternalError(w, "failed to create poll")
+			writeInternalError(w, r, "failed to create poll")
 			return
 		}
 
-		// Reload poll with options and vote counts
-		poll, err = client.Poll.Query().
-			Where(entpoll.ID(poll.ID)).
-			WithOptions(func(q *ent.PollOptionQuery) {
-				q.WithVotes()
-			}).
-			Only(r.Context())
+		view, err := viewPoll(r.Context(), client, poll, principal)
 		if err != nil {
 			logger.LogAttrs(
 				r.Context(),
@@ -94,22 +159,23 @@ func HandleCreatePoll(logger *slog.Logger, client *ent.Client) http.Handler {
 				"failed to reload poll",
 				slog.String("error", err.Error()),
 			)
-			writeInternalError(w, "failed to create poll")
+			writeInternalError(w, r, "failed to create poll")
 			return
 		}
+		response := mapPollViewToResponse(view)
 
 		logger.LogAttrs(
 			r.Context(),
 			slog.LevelInfo,
 			"create poll: completed",
-			slog.Int("poll_id", poll.ID),
-			slog.String("title", poll.Title),
-			slog.Int("options_count", len(poll.Edges.Options)),
+			slog.Int("poll_id", response.ID),
+			slog.String("title", response.Title),
+			slog.Int("options_count", len(response.Options)),
 		)
 
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusCreated)
-		if err := json.NewEncoder(w).Encode(mapPollToResponse(poll)); err != nil {
+		if err := json.NewEncoder(w).Encode(response); err != nil {
 			logger.LogAttrs(
 				r.Context(),
 				slog.LevelError,
@@ -119,34 +185,3 @@ func HandleCreatePoll(logger *slog.Logger, client *ent.Client) http.Handler {
 		}
 	})
 }
-
-func createPollWithOptions(ctx context.Context, client *ent.Client, req CreatePollRequest) (*ent.Poll, error) {
-	tx, err := client.Tx(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	poll, err := tx.Poll.Create().
-		SetOwnerID(req.OwnerID).
-		SetTitle(req.Title).
-		Save(ctx)
-	if err != nil {
-		return nil, errors.Join(err, tx.Rollback())
-	}
-
-	for _, optionText := range req.Options {
-		_, err := tx.PollOption.Create().
-			SetPollID(poll.ID).
-			SetText(optionText).
-			Save(ctx)
-		if err != nil {
-			return nil, errors.Join(err, tx.Rollback())
-		}
-	}
-
-	if err := tx.Commit(); err != nil {
-		return nil, err
-	}
-
-	return poll, nil
-}
@@ -13,6 +13,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/ivankorhner/polling-app/internal/events"
 	"github.com/ivankorhner/polling-app/internal/server"
 	"github.com/ivankorhner/polling-app/internal/testutil"
 	"github.com/stretchr/testify/assert"
@@ -33,12 +34,13 @@ func TestHandleCreatePoll_Success(t *testing.T) {
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 
-	body := fmt.Sprintf(`{"owner_id": %d, "title": "Favorite Color?", "options": ["Red", "Blue", "Green"]}`, user.ID)
+	body := `{"title": "Favorite Color?", "options": ["Red", "Blue", "Green"]}`
 	req := httptest.NewRequest(http.MethodPost, "/polls", bytes.NewBufferString(body))
 	req.Header.Set("Content-Type", "application/json")
+	req = asUser(req, user.ID)
 	rec := httptest.NewRecorder()
 
-	handler := server.HandleCreatePoll(logger, testDB.Client)
+	handler := server.HandleCreatePoll(logger, testDB.Client, events.NewMemory(logger))
 	handler.ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusCreated, rec.Code)
@@ -54,62 +56,64 @@ func TestHandleCreatePoll_Success(t *testing.T) {
 	assert.Equal(t, "Red", result.Options[0].Text)
 	assert.Equal(t, "Blue", result.Options[1].Text)
 	assert.Equal(t, "Green", result.Options[2].Text)
+
+	poll, err := testDB.Client.Poll.Get(ctx, result.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, poll.OwnerID, "authenticated user should own the poll")
 	// Verify vote counts start at 0
 	for _, opt := range result.Options {
-		assert.Equal(t, 0, opt.VoteCount)
+		assert.Equal(t, 0, *opt.VoteCount)
 	}
 }
 
 func TestHandleCreatePoll_Validation(t *testing.T) {
 	tests := []struct {
 		name       string
-		setupUser  bool
-		body       func(userID int) string
+		anonymous  bool
+		body       string
 		wantStatus int
 		wantError  string
 	}{
 		{
-			name:      "missing title",
-			setupUser: true,
-			body: func(userID int) string {
-				return fmt.Sprintf(`{"owner_id": %d, "options": ["A", "B"]}`, userID)
-			},
+			name:       "missing title",
+			body:       `{"options": ["A", "B"]}`,
 			wantStatus: http.StatusBadRequest,
 			wantError:  "title is required",
 		},
 		{
-			name:      "missing owner_id",
-			setupUser: false,
-			body: func(_ int) string {
-				return `{"title": "Test Poll", "options": ["A", "B"]}`
-			},
-			wantStatus: http.StatusBadRequest,
-			wantError:  "owner_id is required",
+			name:       "unauthenticated",
+			anonymous:  true,
+			body:       `{"title": "Test Poll", "options": ["A", "B"]}`,
+			wantStatus: http.StatusUnauthorized,
+			wantError:  "authentication required",
 		},
 		{
-			name:      "too few options",
-			setupUser: true,
-			body: func(userID int) string {
-				return fmt.Sprintf(`{"owner_id": %d, "title": "Test Poll", "options": ["Only One"]}`, userID)
-			},
+			name:       "too few options",
+			body:       `{"title": "Test Poll", "options": ["Only One"]}`,
 			wantStatus: http.StatusBadRequest,
 			wantError:  "at least 2 options are required",
 		},
 		{
-			name:      "owner not found",
-			setupUser: false,
-			body: func(_ int) string {
-				return `{"owner_id": 99999, "title": "Test Poll", "options": ["A", "B"]}`
-			},
+			name:       "max selections above option count",
+			body:       `{"title": "Test Poll", "options": ["A", "B"], "max_selections": 3}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "max_selections must not exceed the number of options",
+		},
+		{
+			name:       "closes in the past",
+			body:       `{"title": "Test Poll", "options": ["A", "B"], "closes_at": "2020-01-01T00:00:00Z"}`,
 			wantStatus: http.StatusBadRequest,
-			wantError:  "owner not found",
+			wantError:  "closes_at must be in the future",
 		},
 		{
-			name:      "invalid JSON",
-			setupUser: false,
-			body: func(_ int) string {
-				return `{invalid json}`
-			},
+			name:       "unsupported voting method",
+			body:       `{"title": "Test Poll", "options": ["A", "B"], "voting_method": "coin_toss"}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  `unsupported voting_method "coin_toss"`,
+		},
+		{
+			name:       "invalid JSON",
+			body:       `{invalid json}`,
 			wantStatus: http.StatusBadRequest,
 			wantError:  "invalid request body",
 		},
@@ -121,31 +125,102 @@ func TestHandleCreatePoll_Validation(t *testing.T) {
 			testDB := testutil.SetupTestDB(ctx, t)
 			defer testDB.Teardown(ctx)
 
-			var userID int
-			if tt.setupUser {
-				user, err := testDB.Client.User.Create().
-					SetUsername("testuser").
-					SetEmail("test@example.com").
-					Save(ctx)
-				require.NoError(t, err)
-				userID = user.ID
-			}
+			user, err := testDB.Client.User.Create().
+				SetUsername("testuser").
+				SetEmail("test@example.com").
+				Save(ctx)
+			require.NoError(t, err)
 
 			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 
-			req := httptest.NewRequest(http.MethodPost, "/polls", bytes.NewBufferString(tt.body(userID)))
+			req := httptest.NewRequest(http.MethodPost, "/polls", bytes.NewBufferString(tt.body))
 			req.Header.Set("Content-Type", "application/json")
+			if !tt.anonymous {
+				req = asUser(req, user.ID)
+			}
 			rec := httptest.NewRecorder()
 
-			handler := server.HandleCreatePoll(logger, testDB.Client)
+			handler := server.HandleCreatePoll(logger, testDB.Client, events.NewMemory(logger))
 			handler.ServeHTTP(rec, req)
 
 			assert.Equal(t, tt.wantStatus, rec.Code)
 
 			var errResp server.ErrorResponse
-			err := json.Unmarshal(rec.Body.Bytes(), &errResp)
+			err = json.Unmarshal(rec.Body.Bytes(), &errResp)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantError, errResp.Detail)
+		})
+	}
+}
+
+func TestHandleCreatePoll_SelectionRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		wantMin int
+		wantMax int
+	}{
+		{
+			name:    "defaults to single choice",
+			rule:    "",
+			wantMin: 1,
+			wantMax: 1,
+		},
+		{
+			name:    "pick up to N",
+			rule:    `, "max_selections": 2`,
+			wantMin: 1,
+			wantMax: 2,
+		},
+		{
+			name:    "min only picks exactly M",
+			rule:    `, "min_selections": 2`,
+			wantMin: 2,
+			wantMax: 2,
+		},
+		{
+			name:    "ranked polls rank every option by default",
+			rule:    `, "voting_method": "instant_runoff"`,
+			wantMin: 1,
+			wantMax: 3,
+		},
+		{
+			name:    "pick between M and N",
+			rule:    `, "min_selections": 2, "max_selections": 3`,
+			wantMin: 2,
+			wantMax: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			user, err := testDB.Client.User.Create().
+				SetUsername("pollowner").
+				SetEmail("owner@example.com").
+				Save(ctx)
 			require.NoError(t, err)
-			assert.Equal(t, tt.wantError, errResp.Error)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			body := fmt.Sprintf(`{"title": "Toppings?", "options": ["Ham", "Olives", "Basil"]%s}`, tt.rule)
+			req := httptest.NewRequest(http.MethodPost, "/polls", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			req = asUser(req, user.ID)
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleCreatePoll(logger, testDB.Client, events.NewMemory(logger))
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusCreated, rec.Code)
+
+			var result server.PollResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			assert.Equal(t, tt.wantMin, result.MinSelections)
+			assert.Equal(t, tt.wantMax, result.MaxSelections)
 		})
 	}
 }
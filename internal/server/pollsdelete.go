@@ -8,53 +8,91 @@ import (
 	"strconv"
 
 	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/participation"
 	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
 	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
+	"github.com/ivankorhner/polling-app/internal/ent/webhook"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookdelivery"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
 )
 
-// HandleDeletePoll handles poll deletion
-func HandleDeletePoll(logger *slog.Logger, client *ent.Client) http.Handler {
+// HandleDeletePoll handles poll deletion by the poll's owner or an admin
+func HandleDeletePoll(logger *slog.Logger, client *ent.Client, bus events.Bus) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
 		idStr := r.PathValue("id")
 		id, err := strconv.Atoi(idStr)
 		if err != nil {
-			writeValidationError(w, "invalid poll id")
+			writeValidationError(w, r, "invalid poll id")
 			return
 		}
 
 		logger.LogAttrs(r.Context(), slog.LevelInfo, "delete poll: starting", slog.Int("poll_id", id))
 
-		// Check if poll exists
-		exists, err := client.Poll.Query().Where(entpoll.ID(id)).Exist(r.Context())
+		// Check if poll exists and may be deleted by the caller
+		p, err := queryViewablePolls(r.Context(), client, principal).Where(entpoll.ID(id)).Only(r.Context())
 		if err != nil {
+			if ent.IsNotFound(err) {
+				writeNotFoundError(w, r, "poll not found")
+				return
+			}
 			logger.LogAttrs(r.Context(), slog.LevelError, "failed to check poll", slog.String("error", err.Error()))
-			writeInternalError(w, "failed to delete poll")
+			writeInternalError(w, r, "failed to delete poll")
 			return
 		}
-		if !exists {
-			writeNotFoundError(w, "poll not found")
+		if !authorizePollManagement(w, r, principal, p) {
 			return
 		}
 
 		// Delete poll and related entities in transaction
-		if err := deletePollWithRelations(r.Context(), client, id); err != nil {
+		if err := deletePollWithRelations(r.Context(), client, p); err != nil {
 			logger.LogAttrs(r.Context(), slog.LevelError, "failed to delete poll", slog.String("error", err.Error()))
-			writeInternalError(w, "failed to delete poll")
+			writeInternalError(w, r, "failed to delete poll")
 			return
 		}
 
+		publishEvent(r.Context(), logger, bus, events.TypePollDeleted, id)
+
 		logger.LogAttrs(r.Context(), slog.LevelInfo, "delete poll: completed", slog.Int("poll_id", id))
 
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
 
-func deletePollWithRelations(ctx context.Context, client *ent.Client, pollID int) error {
+// deletePollWithRelations deletes the poll, its options, ballots, vote
+// history and webhooks, queuing poll_deleted for the poll's webhooks
+// beforehand
+func deletePollWithRelations(ctx context.Context, client *ent.Client, p *ent.Poll) error {
 	tx, err := client.Tx(ctx)
 	if err != nil {
 		return err
 	}
+	pollID := p.ID
+
+	if err = webhooks.Enqueue(ctx, tx, events.TypePollDeleted, p, nil); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	// Webhooks on the poll with nothing left to send go with it. The worker
+	// removes the rest once their last delivery settles.
+	_, err = tx.Webhook.Delete().
+		Where(
+			webhook.PollID(pollID),
+			webhook.Not(webhook.HasDeliveriesWith(webhookdelivery.StatusEQ(webhookdelivery.StatusPending))),
+		).
+		Exec(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
 
 	// Delete votes for this poll first
 	_, err = tx.Vote.Delete().Where(vote.PollID(pollID)).Exec(ctx)
@@ -62,6 +100,29 @@ func deletePollWithRelations(ctx context.Context, client *ent.Client, pollID int
 		return errors.Join(err, tx.Rollback())
 	}
 
+	// Delete ballots for this poll
+	_, err = tx.Ballot.Delete().Where(ballot.PollID(pollID)).Exec(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	// Delete the anonymous ballots and who cast them
+	_, err = tx.AnonymousVote.Delete().Where(anonymousvote.PollID(pollID)).Exec(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	_, err = tx.Participation.Delete().Where(participation.PollID(pollID)).Exec(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	// Delete the poll's vote history
+	_, err = tx.VoteEvent.Delete().Where(voteevent.PollID(pollID)).Exec(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
 	// Delete poll options
 	_, err = tx.PollOption.Delete().Where(polloption.PollID(pollID)).Exec(ctx)
 	if err != nil {
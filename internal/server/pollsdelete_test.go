@@ -12,8 +12,12 @@ import (
 	"os"
 	"testing"
 
+	"github.com/ivankorhner/polling-app/internal/ent/webhook"
+	"github.com/ivankorhner/polling-app/internal/events"
 	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
 	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -21,14 +25,14 @@ import (
 func TestHandleDeletePoll(t *testing.T) {
 	tests := []struct {
 		name       string
-		setup      func(ctx context.Context, testDB *testutil.TestDB) int
+		setup      func(ctx context.Context, testDB *testutil.TestDB) (pollID int, ownerID int)
 		pathID     string
 		wantStatus int
 		checkBody  func(t *testing.T, rec *httptest.ResponseRecorder)
 	}{
 		{
 			name: "success",
-			setup: func(ctx context.Context, testDB *testutil.TestDB) int {
+			setup: func(ctx context.Context, testDB *testutil.TestDB) (int, int) {
 				user, _ := testDB.Client.User.Create().
 					SetUsername("testuser").
 					SetEmail("test@example.com").
@@ -41,28 +45,28 @@ func TestHandleDeletePoll(t *testing.T) {
 					SetPollID(poll.ID).
 					SetText("Option 1").
 					Save(ctx)
-				return poll.ID
+				return poll.ID, user.ID
 			},
 			wantStatus: http.StatusNoContent,
 			checkBody:  nil,
 		},
 		{
 			name: "not found",
-			setup: func(ctx context.Context, testDB *testutil.TestDB) int {
-				return 99999
+			setup: func(ctx context.Context, testDB *testutil.TestDB) (int, int) {
+				return 99999, 1
 			},
 			wantStatus: http.StatusNotFound,
 			checkBody: func(t *testing.T, rec *httptest.ResponseRecorder) {
 				var errResp server.ErrorResponse
 				err := json.Unmarshal(rec.Body.Bytes(), &errResp)
 				require.NoError(t, err)
-				assert.Equal(t, "poll not found", errResp.Error)
+				assert.Equal(t, "poll not found", errResp.Detail)
 			},
 		},
 		{
 			name: "invalid ID",
-			setup: func(ctx context.Context, testDB *testutil.TestDB) int {
-				return 0
+			setup: func(ctx context.Context, testDB *testutil.TestDB) (int, int) {
+				return 0, 1
 			},
 			pathID:     "invalid",
 			wantStatus: http.StatusBadRequest,
@@ -70,7 +74,7 @@ func TestHandleDeletePoll(t *testing.T) {
 				var errResp server.ErrorResponse
 				err := json.Unmarshal(rec.Body.Bytes(), &errResp)
 				require.NoError(t, err)
-				assert.Equal(t, "invalid poll id", errResp.Error)
+				assert.Equal(t, "invalid poll id", errResp.Detail)
 			},
 		},
 	}
@@ -81,7 +85,7 @@ func TestHandleDeletePoll(t *testing.T) {
 			testDB := testutil.SetupTestDB(ctx, t)
 			defer testDB.Teardown(ctx)
 
-			pollID := tt.setup(ctx, testDB)
+			pollID, ownerID := tt.setup(ctx, testDB)
 			pathID := tt.pathID
 			if pathID == "" {
 				pathID = fmt.Sprintf("%d", pollID)
@@ -91,9 +95,10 @@ func TestHandleDeletePoll(t *testing.T) {
 
 			req := httptest.NewRequest(http.MethodDelete, "/polls/"+pathID, nil)
 			req.SetPathValue("id", pathID)
+			req = asUser(req, ownerID)
 			rec := httptest.NewRecorder()
 
-			handler := server.HandleDeletePoll(logger, testDB.Client)
+			handler := server.HandleDeletePoll(logger, testDB.Client, events.NewMemory(logger))
 			handler.ServeHTTP(rec, req)
 
 			assert.Equal(t, tt.wantStatus, rec.Code)
@@ -129,7 +134,14 @@ func TestHandleDeletePoll_CascadeDelete(t *testing.T) {
 		Save(ctx)
 	require.NoError(t, err)
 
+	ballot, err := testDB.Client.Ballot.Create().
+		SetPollID(poll.ID).
+		SetUserID(user.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
 	_, err = testDB.Client.Vote.Create().
+		SetBallotID(ballot.ID).
 		SetPollID(poll.ID).
 		SetOptionID(option.ID).
 		SetUserID(user.ID).
@@ -140,17 +152,20 @@ func TestHandleDeletePoll_CascadeDelete(t *testing.T) {
 	pollCount, _ := testDB.Client.Poll.Query().Count(ctx)
 	optionCount, _ := testDB.Client.PollOption.Query().Count(ctx)
 	voteCount, _ := testDB.Client.Vote.Query().Count(ctx)
+	ballotCount, _ := testDB.Client.Ballot.Query().Count(ctx)
 	assert.Equal(t, 1, pollCount)
 	assert.Equal(t, 1, optionCount)
 	assert.Equal(t, 1, voteCount)
+	assert.Equal(t, 1, ballotCount)
 
 	// Delete poll
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/polls/%d", poll.ID), nil)
 	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	req = asUser(req, user.ID)
 	rec := httptest.NewRecorder()
 
-	handler := server.HandleDeletePoll(logger, testDB.Client)
+	handler := server.HandleDeletePoll(logger, testDB.Client, events.NewMemory(logger))
 	handler.ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusNoContent, rec.Code)
@@ -159,11 +174,168 @@ func TestHandleDeletePoll_CascadeDelete(t *testing.T) {
 	pollCount, _ = testDB.Client.Poll.Query().Count(ctx)
 	optionCount, _ = testDB.Client.PollOption.Query().Count(ctx)
 	voteCount, _ = testDB.Client.Vote.Query().Count(ctx)
+	ballotCount, _ = testDB.Client.Ballot.Query().Count(ctx)
 	assert.Equal(t, 0, pollCount)
 	assert.Equal(t, 0, optionCount)
 	assert.Equal(t, 0, voteCount)
+	assert.Equal(t, 0, ballotCount)
 
 	// User should still exist
 	userCount, _ := testDB.Client.User.Query().Count(ctx)
 	assert.Equal(t, 1, userCount)
 }
+
+func TestHandleDeletePoll_Authorization(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  func(ownerID, otherID int) *middleware.Principal
+		wantStatus int
+		wantCode   string
+		wantPolls  int
+	}{
+		{
+			name: "owner",
+			principal: func(ownerID, _ int) *middleware.Principal {
+				return &middleware.Principal{UserID: ownerID}
+			},
+			wantStatus: http.StatusNoContent,
+			wantPolls:  0,
+		},
+		{
+			name: "admin",
+			principal: func(_, otherID int) *middleware.Principal {
+				return &middleware.Principal{UserID: otherID, Admin: true}
+			},
+			wantStatus: http.StatusNoContent,
+			wantPolls:  0,
+		},
+		{
+			name: "other user",
+			principal: func(_, otherID int) *middleware.Principal {
+				return &middleware.Principal{UserID: otherID}
+			},
+			wantStatus: http.StatusForbidden,
+			wantCode:   server.ErrCodeForbidden,
+			wantPolls:  1,
+		},
+		{
+			name: "unauthenticated",
+			principal: func(_, _ int) *middleware.Principal {
+				return nil
+			},
+			wantStatus: http.StatusUnauthorized,
+			wantCode:   server.ErrCodeUnauthorized,
+			wantPolls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			owner, err := testDB.Client.User.Create().
+				SetUsername("owner").
+				SetEmail("owner@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			other, err := testDB.Client.User.Create().
+				SetUsername("other").
+				SetEmail("other@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			poll, err := testDB.Client.Poll.Create().
+				SetOwnerID(owner.ID).
+				SetTitle("Test Poll").
+				Save(ctx)
+			require.NoError(t, err)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/polls/%d", poll.ID), nil)
+			req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+			if p := tt.principal(owner.ID, other.ID); p != nil {
+				req = req.WithContext(middleware.WithPrincipal(req.Context(), *p))
+			}
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleDeletePoll(logger, testDB.Client, events.NewMemory(logger))
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantCode != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantCode, errResp.Code)
+			}
+
+			pollCount, err := testDB.Client.Poll.Query().Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPolls, pollCount)
+		})
+	}
+}
+
+func TestHandleDeletePoll_Webhooks(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	owner, err := testDB.Client.User.Create().SetUsername("owner").SetEmail("owner@example.com").Save(ctx)
+	require.NoError(t, err)
+	poll, err := testDB.Client.Poll.Create().SetOwnerID(owner.ID).SetTitle("Lunch?").Save(ctx)
+	require.NoError(t, err)
+
+	delivered := make(chan string, 4)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		delivered <- r.Header.Get("X-Webhook-Event")
+	}))
+	defer receiver.Close()
+
+	newHook := func(pollID *int, types ...string) int {
+		h, err := testDB.Client.Webhook.Create().
+			SetUserID(owner.ID).
+			SetNillablePollID(pollID).
+			SetURL(receiver.URL).
+			SetSecret(webhooks.NewSecret()).
+			SetEvents(types).
+			Save(ctx)
+		require.NoError(t, err)
+		return h.ID
+	}
+	notified := newHook(&poll.ID, "poll_deleted")
+	votesOnly := newHook(&poll.ID, "vote")
+	everyPoll := newHook(nil, "poll_deleted")
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/polls/%d", poll.ID), nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	req = asUser(req, owner.ID)
+	rec := httptest.NewRecorder()
+	server.HandleDeletePoll(logger, testDB.Client, events.NewMemory(logger)).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+	exists := func(id int) bool {
+		ok, err := testDB.Client.Webhook.Query().Where(webhook.ID(id)).Exist(ctx)
+		require.NoError(t, err)
+		return ok
+	}
+
+	// Nothing was queued for the vote-only webhook, so it went with the poll
+	assert.False(t, exists(votesOnly))
+	assert.True(t, exists(notified))
+	assert.True(t, exists(everyPoll))
+
+	// Once poll_deleted is delivered, the poll's webhook is removed as well
+	worker := webhooks.NewWorker(testDB.Client, logger)
+	worker.AllowPrivateTargets = true
+	require.Equal(t, 2, worker.ProcessDue(ctx))
+	assert.Equal(t, "poll_deleted", <-delivered)
+	assert.Equal(t, "poll_deleted", <-delivered)
+
+	assert.False(t, exists(notified))
+	assert.True(t, exists(everyPoll))
+}
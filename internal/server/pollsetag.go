@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// A poll's response changes when the poll or its options are edited, which
+// moves its version, when a ballot is cast, changed or retracted, which
+// records a vote event, and when the clock opens or closes it. It also
+// depends on who asks, since results and share slugs are shown selectively.
+// Poll ETags are built from those inputs alone, so an unchanged poll is
+// recognized before its vote counts are loaded. They start with the poll's ID
+// and version, which is all that If-Match on edits compares.
+
+// pollValidators are the ETag and Last-Modified time of a response
+type pollValidators struct {
+	ETag         string
+	LastModified time.Time
+}
+
+// voteMark is the latest vote event on a poll
+type voteMark struct {
+	PollID    int       `json:"poll_id"`
+	EventID   int       `json:"event_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// loadVoteMarks returns the latest vote event on each of the polls that
+// have any, keyed by poll ID
+func loadVoteMarks(ctx context.Context, client *ent.Client, pollIDs ...int) (map[int]voteMark, error) {
+	var rows []voteMark
+	err := client.VoteEvent.Query().
+		Where(voteevent.PollIDIn(pollIDs...)).
+		GroupBy(voteevent.FieldPollID).
+		Aggregate(
+			ent.As(ent.Max(voteevent.FieldID), "event_id"),
+			ent.As(ent.Max(voteevent.FieldCreatedAt), "created_at"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	marks := make(map[int]voteMark, len(rows))
+	for _, row := range rows {
+		marks[row.PollID] = row
+	}
+	return marks, nil
+}
+
+// pollETag returns the strong ETag of the poll's response to the principal
+func pollETag(p *ent.Poll, mark voteMark, principal middleware.Principal, now time.Time) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%d|%s|%d|%t", mark.EventID, pollStatus(p, now), principal.UserID, canManagePoll(principal, p))
+	return fmt.Sprintf(`"%d.%d.%s"`, p.ID, p.Version, hex.EncodeToString(h.Sum(nil)[:8]))
+}
+
+// pollLastModified returns when the poll's response last changed: the latest
+// edit, vote, or scheduled opening or closing that has passed
+func pollLastModified(p *ent.Poll, mark voteMark, now time.Time) time.Time {
+	modified := p.UpdatedAt
+	for _, t := range []*time.Time{&mark.CreatedAt, p.OpensAt, p.ClosesAt} {
+		if t != nil && t.After(modified) && !t.After(now) {
+			modified = *t
+		}
+	}
+	return modified
+}
+
+// loadPollValidators returns the validators of the poll's response to the
+// principal
+func loadPollValidators(ctx context.Context, client *ent.Client, p *ent.Poll, principal middleware.Principal) (pollValidators, error) {
+	marks, err := loadVoteMarks(ctx, client, p.ID)
+	if err != nil {
+		return pollValidators{}, err
+	}
+
+	now := time.Now()
+	return pollValidators{
+		ETag:         pollETag(p, marks[p.ID], principal, now),
+		LastModified: pollLastModified(p, marks[p.ID], now),
+	}, nil
+}
+
+// pollListETag returns the strong ETag of a page of polls listed for the
+// principal. It changes whenever any listed poll's ETag does, or the page
+// holds other polls.
+func pollListETag(polls []*ent.Poll, marks map[int]voteMark, principal middleware.Principal, nextCursor *string, now time.Time) string {
+	h := sha256.New()
+	for _, p := range polls {
+		_, _ = fmt.Fprintln(h, pollETag(p, marks[p.ID], principal, now))
+	}
+	if nextCursor != nil {
+		_, _ = fmt.Fprintln(h, *nextCursor)
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// setHeaders sets the validators on the response. Responses differ by
+// caller, so they are marked private and varying with Authorization to keep
+// shared caches from serving one caller's copy to another.
+func (v pollValidators) setHeaders(h http.Header) {
+	h.Set("ETag", v.ETag)
+	if !v.LastModified.IsZero() {
+		h.Set("Last-Modified", v.LastModified.UTC().Format(http.TimeFormat))
+	}
+	h.Set("Cache-Control", "private")
+	h.Add("Vary", "Authorization")
+}
+
+// writeNotModified sets the validators on the response and, when the
+// request's If-None-Match, or failing that its If-Modified-Since, shows the
+// client's copy is current, responds 304 Not Modified and returns true
+func writeNotModified(w http.ResponseWriter, r *http.Request, v pollValidators) bool {
+	v.setHeaders(w.Header())
+
+	current := false
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		current = etagListMatches(inm, v.ETag, true)
+	} else if ims := r.Header.Get("If-Modified-Since"); ims != "" && !v.LastModified.IsZero() {
+		t, err := http.ParseTime(ims)
+		current = err == nil && !v.LastModified.Truncate(time.Second).After(t)
+	}
+	if current {
+		w.WriteHeader(http.StatusNotModified)
+	}
+	return current
+}
+
+// checkIfMatch reports whether the request's If-Match header, if any, names
+// an ETag of the poll's current version. Otherwise it responds 412
+// Precondition Failed. Only edits move the version, so votes cast and the
+// clock passing since the ETag was fetched do not conflict with an edit.
+func checkIfMatch(w http.ResponseWriter, r *http.Request, p *ent.Poll) bool {
+	im := r.Header.Get("If-Match")
+	if im == "" {
+		return true
+	}
+
+	version := fmt.Sprintf(`"%d.%d"`, p.ID, p.Version)
+	for tag := range strings.SplitSeq(im, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || pollETagVersion(tag) == version {
+			return true
+		}
+	}
+	writePreconditionFailedError(w, r, "poll has changed since it was fetched")
+	return false
+}
+
+// pollETagVersion returns the quoted poll ID and version a strong poll ETag
+// was issued for, dropping the hash of its votes, status and caller. Other
+// tags yield an empty string.
+func pollETagVersion(tag string) string {
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return ""
+	}
+	i := strings.LastIndexByte(tag, '.')
+	if i < 0 {
+		return ""
+	}
+	return tag[:i] + `"`
+}
+
+// etagListMatches reports whether the list of entity tags in an If-Match or
+// If-None-Match header matches etag. Weak tags only match under weak
+// comparison, as If-None-Match uses.
+func etagListMatches(header, etag string, weak bool) bool {
+	for tag := range strings.SplitSeq(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		if weak {
+			tag = strings.TrimPrefix(tag, "W/")
+		}
+		if tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// nextPollVersion returns an update that moves the poll to its next version.
+// A guarded update only applies to the version the request's If-Match header
+// was checked against, and fails as not found once another change commits.
+func nextPollVersion(polls *ent.PollClient, p *ent.Poll, guarded bool) *ent.PollUpdateOne {
+	upd := polls.UpdateOne(p).AddVersion(1)
+	if guarded {
+		upd.Where(entpoll.Version(p.Version))
+	}
+	return upd
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+func TestPollETag(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	orgID := 5
+	p := &ent.Poll{ID: 7, Version: 3, OrganizationID: &orgID, CreatedAt: now.Add(-time.Hour)}
+	mark := voteMark{PollID: 7, EventID: 41}
+	owner := middleware.Principal{UserID: 1}
+
+	etag := pollETag(p, mark, owner, now)
+	assert.Regexp(t, `^"7\.3\.[0-9a-f]{16}"$`, etag)
+	assert.Equal(t, etag, pollETag(p, mark, owner, now), "ETags are stable")
+
+	edited := *p
+	edited.Version++
+	closed := *p
+	closed.ClosedAt = &now
+
+	tests := []struct {
+		name string
+		etag string
+	}{
+		{"edited", pollETag(&edited, mark, owner, now)},
+		{"voted", pollETag(p, voteMark{PollID: 7, EventID: 42}, owner, now)},
+		{"closed", pollETag(&closed, mark, owner, now)},
+		{"another user", pollETag(p, mark, middleware.Principal{UserID: 2}, now)},
+		{"admin", pollETag(p, mark, middleware.Principal{UserID: 1, Admin: true}, now)},
+		{"organization admin", pollETag(p, mark, middleware.Principal{UserID: 1, ManagedOrganizations: []int{orgID}}, now)},
+		{"anonymous", pollETag(p, mark, middleware.Principal{}, now)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NotEqual(t, etag, tt.etag)
+		})
+	}
+}
+
+func TestPollLastModified(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	updated := now.Add(-3 * time.Hour)
+	voted := now.Add(-2 * time.Hour)
+	opened := now.Add(-time.Hour)
+	closes := now.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		poll *ent.Poll
+		mark voteMark
+		want time.Time
+	}{
+		{
+			name: "edited only",
+			poll: &ent.Poll{UpdatedAt: updated},
+			want: updated,
+		},
+		{
+			name: "voted since",
+			poll: &ent.Poll{UpdatedAt: updated},
+			mark: voteMark{CreatedAt: voted},
+			want: voted,
+		},
+		{
+			name: "opened on schedule",
+			poll: &ent.Poll{UpdatedAt: updated, OpensAt: &opened},
+			mark: voteMark{CreatedAt: voted},
+			want: opened,
+		},
+		{
+			name: "closing later",
+			poll: &ent.Poll{UpdatedAt: updated, ClosesAt: &closes},
+			want: updated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pollLastModified(tt.poll, tt.mark, now))
+		})
+	}
+}
+
+func TestWriteNotModified(t *testing.T) {
+	modified := time.Date(2026, 3, 1, 12, 0, 0, 500, time.UTC)
+	v := pollValidators{ETag: `"7.3.abc"`, LastModified: modified}
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    bool
+	}{
+		{"unconditional", nil, false},
+		{"matching ETag", map[string]string{"If-None-Match": `"7.3.abc"`}, true},
+		{"weak ETag", map[string]string{"If-None-Match": `W/"7.3.abc"`}, true},
+		{"one of several", map[string]string{"If-None-Match": `"7.2.abc", "7.3.abc"`}, true},
+		{"any", map[string]string{"If-None-Match": "*"}, true},
+		{"stale ETag", map[string]string{"If-None-Match": `"7.2.abc"`}, false},
+		{"not modified since", map[string]string{"If-Modified-Since": "Sun, 01 Mar 2026 12:00:00 GMT"}, true},
+		{"modified since", map[string]string{"If-Modified-Since": "Sun, 01 Mar 2026 11:59:59 GMT"}, false},
+		{"malformed date", map[string]string{"If-Modified-Since": "yesterday"}, false},
+		{
+			name: "ETag takes precedence",
+			headers: map[string]string{
+				"If-None-Match":     `"7.2.abc"`,
+				"If-Modified-Since": "Sun, 01 Mar 2026 12:00:00 GMT",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/polls/7", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+
+			assert.Equal(t, tt.want, writeNotModified(rec, req, v))
+			assert.Equal(t, `"7.3.abc"`, rec.Header().Get("ETag"))
+			assert.Equal(t, "Sun, 01 Mar 2026 12:00:00 GMT", rec.Header().Get("Last-Modified"))
+			// Responses differ by caller, so shared caches must not reuse them
+			assert.Equal(t, "private", rec.Header().Get("Cache-Control"))
+			assert.Equal(t, "Authorization", rec.Header().Get("Vary"))
+			if tt.want {
+				assert.Equal(t, http.StatusNotModified, rec.Code)
+				assert.Empty(t, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestCheckIfMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		ifMatch string
+		want    bool
+	}{
+		{"unconditional", "", true},
+		{"matching ETag", `"7.3.abc"`, true},
+		{"ETag from before a vote", `"7.3.def"`, true},
+		{"one of several", `"7.2.abc", "7.3.def"`, true},
+		{"any", "*", true},
+		{"stale ETag", `"7.2.abc"`, false},
+		{"another poll", `"8.3.abc"`, false},
+		{"weak ETag", `W/"7.3.abc"`, false},
+		{"malformed", `7.3.abc`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, "/polls/7", nil)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			rec := httptest.NewRecorder()
+
+			assert.Equal(t, tt.want, checkIfMatch(rec, req, &ent.Poll{ID: 7, Version: 3}))
+			if !tt.want {
+				assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+				assert.Contains(t, rec.Body.String(), ErrCodePreconditionFailed)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// PollEventsHeartbeat is how often an idle poll event stream sends a comment
+// to keep proxies from closing the connection
+const PollEventsHeartbeat = 15 * time.Second
+
+// HandlePollEvents handles streaming a poll's results as Server-Sent Events.
+// A snapshot of the poll is sent on connect and after every vote, with the
+// poll's latest vote event ID as the event ID. Snapshots carry vote counts
+// only while the poll's results are visible to the client. Clients
+// reconnecting with Last-Event-ID only receive a snapshot once the poll has
+// changed since. The stream ends when the client disconnects or ctx is
+// canceled.
+func HandlePollEvents(
+	ctx context.Context,
+	logger *slog.Logger,
+	client *ent.Client,
+	broadcaster *Broadcaster,
+	heartbeat time.Duration,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, _ := middleware.PrincipalFromContext(r.Context())
+
+		idStr := r.PathValue("id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			writeValidationError(w, r, "invalid poll id")
+			return
+		}
+
+		// Without Last-Event-ID the client has seen nothing, not even a poll
+		// without votes
+		lastEventID := -1
+		if header := r.Header.Get("Last-Event-ID"); header != "" {
+			lastEventID, err = strconv.Atoi(header)
+			if err != nil || lastEventID < 0 {
+				writeValidationError(w, r, "invalid Last-Event-ID")
+				return
+			}
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "poll events: starting", slog.Int("poll_id", id))
+
+		// Subscribe before loading the snapshot so no vote slips in between
+		notify, unsubscribe := broadcaster.Subscribe(id)
+		defer unsubscribe()
+
+		snapshot, eventID, err := loadPollSnapshot(r.Context(), client, id, principal)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeNotFoundError(w, r, "poll not found")
+				return
+			}
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query poll",
+				slog.String("error", err.Error()),
+				slog.Int("poll_id", id),
+			)
+			writeInternalError(w, r, "failed to stream poll events")
+			return
+		}
+
+		// The stream outlives the server's write timeout
+		rc := http.NewResponseController(w)
+		if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to clear write deadline", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to stream poll events")
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		ticker := time.NewTicker(heartbeat)
+		defer ticker.Stop()
+
+		sent := 0
+		for {
+			if eventID > lastEventID {
+				if err := writePollEvent(w, eventID, snapshot); err != nil {
+					logger.LogAttrs(r.Context(), slog.LevelError, "failed to write poll event", slog.String("error", err.Error()))
+					return
+				}
+				lastEventID = eventID
+				sent++
+			}
+			if err := rc.Flush(); err != nil {
+				logger.LogAttrs(r.Context(), slog.LevelError, "failed to flush poll events", slog.String("error", err.Error()))
+				return
+			}
+
+			select {
+			case <-r.Context().Done():
+				logger.LogAttrs(
+					r.Context(),
+					slog.LevelInfo,
+					"poll events: completed",
+					slog.Int("poll_id", id),
+					slog.Int("events", sent),
+				)
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+					return
+				}
+			case <-notify:
+				snapshot, eventID, err = loadPollSnapshot(r.Context(), client, id, principal)
+				if err != nil {
+					if !ent.IsNotFound(err) && r.Context().Err() == nil {
+						logger.LogAttrs(
+							r.Context(),
+							slog.LevelError,
+							"failed to reload poll",
+							slog.String("error", err.Error()),
+							slog.Int("poll_id", id),
+						)
+					}
+					return
+				}
+			}
+		}
+	})
+}
+
+// loadPollSnapshot loads a poll with its vote counts as seen by the
+// principal and the ID of the poll's latest vote event, or zero before the
+// first vote. The event ID is read first so the snapshot is never older than
+// it.
+func loadPollSnapshot(ctx context.Context, client *ent.Client, id int, principal middleware.Principal) (PollResponse, int, error) {
+	eventID, err := client.VoteEvent.Query().
+		Where(voteevent.PollID(id)).
+		Order(ent.Desc(voteevent.FieldID)).
+		FirstID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return PollResponse{}, 0, err
+	}
+
+	snapshot, err := loadPollResponse(ctx, client, id, principal)
+	if err != nil {
+		return PollResponse{}, 0, err
+	}
+
+	return snapshot, eventID, nil
+}
+
+// writePollEvent writes a poll snapshot as a Server-Sent Event
+func writePollEvent(w http.ResponseWriter, eventID int, snapshot PollResponse) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: poll\ndata: %s\n\n", eventID, data)
+	return err
+}
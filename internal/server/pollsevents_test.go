@@ -0,0 +1,286 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/config"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sseEvent is one event or comment read from a Server-Sent Events stream
+type sseEvent struct {
+	ID      string
+	Event   string
+	Data    string
+	Comment string
+}
+
+// openEventStream connects to a poll event stream, optionally resuming after
+// lastEventID, and returns a reader positioned at the first event
+func openEventStream(t *testing.T, url, lastEventID string) *bufio.Reader {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+
+	return bufio.NewReader(resp.Body)
+}
+
+// readEvent reads lines from the stream up to the next blank line
+func readEvent(t *testing.T, r *bufio.Reader) sseEvent {
+	t.Helper()
+
+	var e sseEvent
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return e
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "":
+			e.Comment = value
+		case "id":
+			e.ID = value
+		case "event":
+			e.Event = value
+		case "data":
+			e.Data = value
+		}
+	}
+}
+
+// readSnapshot reads the next event and decodes it as a poll snapshot
+func readSnapshot(t *testing.T, r *bufio.Reader) (sseEvent, server.PollResponse) {
+	t.Helper()
+
+	e := readEvent(t, r)
+	require.Equal(t, "poll", e.Event, "expected a poll event, got %+v", e)
+
+	var snapshot server.PollResponse
+	require.NoError(t, json.Unmarshal([]byte(e.Data), &snapshot))
+	return e, snapshot
+}
+
+func TestHandlePollEvents_StreamsVotes(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	// A timeout far shorter than the test proves streams are exempt from it
+	apiTimeout := 50 * time.Millisecond
+	srv := httptest.NewServer(server.AddRoutes(ctx, &config.Config{APITimeout: apiTimeout}, logger, testDB.DB, testDB.Client, events.NewMemory(logger)))
+	defer srv.Close()
+
+	post := func(path, token, body string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+path, bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	resp := post("/users", "", `{"username": "alice", "email": "alice@example.com"}`)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var registered server.RegisteredUserResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&registered))
+
+	resp = post("/polls", registered.Token, `{"title": "Lunch?", "options": ["Soup", "Salad"]}`)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var poll server.PollResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&poll))
+
+	stream := openEventStream(t, fmt.Sprintf("%s/polls/%d/events", srv.URL, poll.ID), "")
+
+	// The snapshot on connect carries the poll before any vote
+	e, snapshot := readSnapshot(t, stream)
+	assert.Equal(t, "0", e.ID)
+	assert.Equal(t, poll.ID, snapshot.ID)
+	assert.Equal(t, 0, *snapshot.Options[0].VoteCount)
+
+	time.Sleep(2 * apiTimeout)
+
+	resp = post(fmt.Sprintf("/polls/%d/vote", poll.ID), registered.Token, fmt.Sprintf(`{"option_id": %d}`, poll.Options[0].ID))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	e, snapshot = readSnapshot(t, stream)
+	eventID, err := strconv.Atoi(e.ID)
+	require.NoError(t, err)
+	assert.Positive(t, eventID)
+	assert.Equal(t, 1, *snapshot.Options[0].VoteCount)
+}
+
+func TestHandlePollEvents_Resume(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	user, err := testDB.Client.User.Create().
+		SetUsername("voter").
+		SetEmail("voter@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	poll, err := testDB.Client.Poll.Create().
+		SetOwnerID(user.ID).
+		SetTitle("Test Poll").
+		Save(ctx)
+	require.NoError(t, err)
+
+	options, err := testDB.Client.PollOption.CreateBulk(
+		testDB.Client.PollOption.Create().SetPollID(poll.ID).SetText("Option 1"),
+		testDB.Client.PollOption.Create().SetPollID(poll.ID).SetText("Option 2"),
+	).Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	bus := events.NewMemory(logger)
+	broadcaster := server.NewBroadcaster()
+	broadcaster.Relay(ctx, bus)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /polls/{id}/events", server.HandlePollEvents(ctx, logger, testDB.Client, broadcaster, 20*time.Millisecond))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	url := fmt.Sprintf("%s/polls/%d/events", srv.URL, poll.ID)
+
+	vote := func(method string, optionID int) {
+		body := fmt.Sprintf(`{"option_id": %d}`, optionID)
+		req := httptest.NewRequest(method, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(body))
+		req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+		req = asUser(req, user.ID)
+		rec := httptest.NewRecorder()
+		handler := server.HandleVote(logger, testDB.Client, bus)
+		if method == http.MethodPut {
+			handler = server.HandleChangeVote(logger, testDB.Client, bus)
+		}
+		handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	vote(http.MethodPost, options[0].ID)
+
+	first, _ := readSnapshot(t, openEventStream(t, url, ""))
+	require.NotEqual(t, "0", first.ID)
+
+	// A client that has seen the latest event only gets heartbeats until the
+	// poll changes
+	stream := openEventStream(t, url, first.ID)
+	assert.Equal(t, "heartbeat", readEvent(t, stream).Comment)
+
+	vote(http.MethodPut, options[1].ID)
+
+	e := readEvent(t, stream)
+	for e.Comment == "heartbeat" {
+		e = readEvent(t, stream)
+	}
+	require.Equal(t, "poll", e.Event)
+	assert.NotEqual(t, first.ID, e.ID)
+
+	var snapshot server.PollResponse
+	require.NoError(t, json.Unmarshal([]byte(e.Data), &snapshot))
+	assert.Equal(t, 0, *snapshot.Options[0].VoteCount)
+	assert.Equal(t, 1, *snapshot.Options[1].VoteCount)
+
+	// A client behind the latest event gets a snapshot straight away
+	resumed, _ := readSnapshot(t, openEventStream(t, url, first.ID))
+	assert.Equal(t, e.ID, resumed.ID)
+}
+
+func TestHandlePollEvents_Errors(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	tests := []struct {
+		name        string
+		pollID      string
+		lastEventID string
+		wantStatus  int
+		wantCode    string
+	}{
+		{
+			name:       "invalid poll id",
+			pollID:     "abc",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   server.ErrCodeValidation,
+		},
+		{
+			name:        "invalid Last-Event-ID",
+			pollID:      "1",
+			lastEventID: "latest",
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    server.ErrCodeValidation,
+		},
+		{
+			name:       "poll not found",
+			pollID:     "99999",
+			wantStatus: http.StatusNotFound,
+			wantCode:   server.ErrCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/polls/"+tt.pollID+"/events", nil)
+			req.SetPathValue("id", tt.pollID)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			rec := httptest.NewRecorder()
+
+			handler := server.HandlePollEvents(ctx, logger, testDB.Client, server.NewBroadcaster(), time.Second)
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var errResp server.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+			assert.Equal(t, tt.wantCode, errResp.Code)
+		})
+	}
+}
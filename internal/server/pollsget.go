@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"log/slog"
 	"net/http"
@@ -8,33 +9,102 @@ import (
 	"time"
 
 	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
 	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
 )
 
-// PollResponse represents the response for poll operations
+// PollResponse represents the response for poll operations. When the
+// poll's results_visibility hides its results from the requester,
+// results_hidden is set and the options carry no vote counts. The share slug
+// is only shown to those who manage the poll.
 type PollResponse struct {
-	ID        int              `json:"id"`
-	Title     string           `json:"title"`
-	CreatedAt time.Time        `json:"created_at"`
-	Options   []OptionResponse `json:"options"`
+	ID                int              `json:"id"`
+	OrganizationID    *int             `json:"organization_id,omitempty"`
+	Title             string           `json:"title"`
+	Status            string           `json:"status"`
+	VotingMethod      string           `json:"voting_method"`
+	MinSelections     int              `json:"min_selections"`
+	MaxSelections     int              `json:"max_selections"`
+	Anonymous         bool             `json:"anonymous"`
+	Visibility        string           `json:"visibility"`
+	ShareSlug         *string          `json:"share_slug,omitempty"`
+	ResultsVisibility string           `json:"results_visibility"`
+	ResultsHidden     bool             `json:"results_hidden"`
+	OpensAt           *time.Time       `json:"opens_at,omitempty"`
+	ClosesAt          *time.Time       `json:"closes_at,omitempty"`
+	ClosedAt          *time.Time       `json:"closed_at,omitempty"`
+	CreatedAt         time.Time        `json:"created_at"`
+	Options           []OptionResponse `json:"options"`
 }
 
-// OptionResponse represents a poll option in responses
+// OptionResponse represents a poll option in responses. On ranked polls the
+// vote count is the number of first preferences and on score polls the
+// number of ballots that scored the option; results holds the method's count.
 type OptionResponse struct {
 	ID        int    `json:"id"`
 	Text      string `json:"text"`
-	VoteCount int    `json:"vote_count"`
+	VoteCount *int   `json:"vote_count,omitempty"`
 }
 
-// HandleListPolls handles listing all polls
+// hideResults removes the vote counts from the response
+func (r *PollResponse) hideResults() {
+	r.ResultsHidden = true
+	for i := range r.Options {
+		r.Options[i].VoteCount = nil
+	}
+}
+
+// PollListResponse represents a page of polls. NextCursor is null on the
+// last page.
+type PollListResponse struct {
+	Data       []PollResponse `json:"data"`
+	NextCursor *string        `json:"next_cursor"`
+}
+
+// HandleListPolls handles listing polls a page at a time. Only the polls the
+// requester may see are listed: unlisted polls appear to those who manage
+// them alone, and organization polls to its members. Polls can be filtered by
+// organization, owner, status, visibility and creation time, and sorted by
+// newest, most votes or closing soonest. Vote counts are left out of polls
+// whose results are hidden from the requester. Each page carries an ETag, so
+// an unchanged page is answered with 304 Not Modified.
 func HandleListPolls(logger *slog.Logger, client *ent.Client) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, _ := middleware.PrincipalFromContext(r.Context())
+
 		logger.LogAttrs(r.Context(), slog.LevelInfo, "list polls: starting")
 
-		polls, err := client.Poll.Query().
-			WithOptions(func(q *ent.PollOptionQuery) {
-				q.WithVotes()
-			}).
+		query, errMsg := parseListPollsQuery(r.URL.Query())
+		if errMsg != "" {
+			writeValidationError(w, r, errMsg)
+			return
+		}
+
+		q := queryViewablePolls(r.Context(), client, principal).WithOptions()
+		if query.OrganizationID != 0 {
+			q.Where(entpoll.OrganizationID(query.OrganizationID))
+		}
+		if query.OwnerID != 0 {
+			q.Where(entpoll.OwnerID(query.OwnerID))
+		}
+		if query.Status != "" {
+			q.Where(statusPredicate(query.Status, time.Now()))
+		}
+		if query.Visibility != "" {
+			q.Where(entpoll.VisibilityEQ(entpoll.Visibility(query.Visibility)))
+		}
+		if query.CreatedAfter != nil {
+			q.Where(entpoll.CreatedAtGT(*query.CreatedAfter))
+		}
+		if query.CreatedBefore != nil {
+			q.Where(entpoll.CreatedAtLT(*query.CreatedBefore))
+		}
+
+		// Fetch one extra poll to learn whether another page follows
+		polls, err := orderPolls(q, query.Sort, query.Cursor).
+			Limit(query.Limit + 1).
 			All(r.Context())
 		if err != nil {
 			logger.LogAttrs(
@@ -43,13 +113,66 @@ func HandleListPolls(logger *slog.Logger, client *ent.Client) http.Handler {
 				"failed to query polls",
 				slog.String("error", err.Error()),
 			)
-			writeInternalError(w, "failed to retrieve polls")
+			writeInternalError(w, r, "failed to retrieve polls")
 			return
 		}
 
-		response := make([]PollResponse, len(polls))
+		var nextCursor *string
+		if len(polls) > query.Limit {
+			polls = polls[:query.Limit]
+			c, err := cursorAfter(r.Context(), client, query.Sort, polls[len(polls)-1])
+			if err != nil {
+				logger.LogAttrs(
+					r.Context(),
+					slog.LevelError,
+					"failed to build next cursor",
+					slog.String("error", err.Error()),
+				)
+				writeInternalError(w, r, "failed to retrieve polls")
+				return
+			}
+			encoded := c.encode()
+			nextCursor = &encoded
+		}
+
+		// Clients holding the current page are spared the vote counts. The
+		// page has no Last-Modified time, since a deleted poll leaves none.
+		pollIDs := make([]int, len(polls))
 		for i, p := range polls {
-			response[i] = mapPollToResponse(p)
+			pollIDs[i] = p.ID
+		}
+		marks, err := loadVoteMarks(r.Context(), client, pollIDs...)
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to load poll versions",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to retrieve polls")
+			return
+		}
+		etag := pollListETag(polls, marks, principal, nextCursor, time.Now())
+		if writeNotModified(w, r, pollValidators{ETag: etag}) {
+			logger.LogAttrs(r.Context(), slog.LevelInfo, "list polls: not modified", slog.Int("count", len(polls)))
+			return
+		}
+
+		data, err := mapPollsToViewerResponse(r.Context(), client, polls, principal)
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to load poll results",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to retrieve polls")
+			return
+		}
+
+		response := PollListResponse{
+			Data:       data,
+			NextCursor: nextCursor,
 		}
 
 		logger.LogAttrs(
@@ -57,6 +180,7 @@ func HandleListPolls(logger *slog.Logger, client *ent.Client) http.Handler {
 			slog.LevelInfo,
 			"list polls: completed",
 			slog.Int("count", len(polls)),
+			slog.Bool("has_more", nextCursor != nil),
 		)
 
 		w.Header().Set("Content-Type", "application/json")
@@ -71,27 +195,26 @@ func HandleListPolls(logger *slog.Logger, client *ent.Client) http.Handler {
 	})
 }
 
-// HandleGetPoll handles getting a single poll by ID
+// HandleGetPoll handles getting a single poll by ID, with vote counts when
+// its results are visible to the requester. The response carries an ETag and
+// Last-Modified time, and an unchanged poll is answered with 304 Not Modified.
 func HandleGetPoll(logger *slog.Logger, client *ent.Client) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, _ := middleware.PrincipalFromContext(r.Context())
+
 		idStr := r.PathValue("id")
 		id, err := strconv.Atoi(idStr)
 		if err != nil {
-			writeValidationError(w, "invalid poll id")
+			writeValidationError(w, r, "invalid poll id")
 			return
 		}
 
 		logger.LogAttrs(r.Context(), slog.LevelInfo, "get poll: starting", slog.Int("poll_id", id))
 
-		poll, err := client.Poll.Query().
-			WithOptions(func(q *ent.PollOptionQuery) {
-				q.WithVotes()
-			}).
-			Where(entpoll.ID(id)).
-			Only(r.Context())
+		p, validators, err := getPoll(r.Context(), client, id, principal)
 		if err != nil {
 			if ent.IsNotFound(err) {
-				writeNotFoundError(w, "poll not found")
+				writeNotFoundError(w, r, "poll not found")
 				return
 			}
 			logger.LogAttrs(
@@ -101,20 +224,40 @@ func HandleGetPoll(logger *slog.Logger, client *ent.Client) http.Handler {
 				slog.String("error", err.Error()),
 				slog.Int("poll_id", id),
 			)
-			writeInternalError(w, "failed to retrieve poll")
+			writeInternalError(w, r, "failed to retrieve poll")
 			return
 		}
 
+		// Clients holding the current response are spared the vote counts
+		if writeNotModified(w, r, validators) {
+			logger.LogAttrs(r.Context(), slog.LevelInfo, "get poll: not modified", slog.Int("poll_id", id))
+			return
+		}
+
+		view, err := viewPoll(r.Context(), client, p, principal)
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to load poll results",
+				slog.String("error", err.Error()),
+				slog.Int("poll_id", id),
+			)
+			writeInternalError(w, r, "failed to retrieve poll")
+			return
+		}
+		response := mapPollViewToResponse(view)
+
 		logger.LogAttrs(
 			r.Context(),
 			slog.LevelInfo,
 			"get poll: completed",
-			slog.Int("poll_id", poll.ID),
-			slog.String("title", poll.Title),
+			slog.Int("poll_id", response.ID),
+			slog.String("title", response.Title),
 		)
 
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(mapPollToResponse(poll)); err != nil {
+		if err := json.NewEncoder(w).Encode(response); err != nil {
 			logger.LogAttrs(
 				r.Context(),
 				slog.LevelError,
@@ -125,24 +268,148 @@ func HandleGetPoll(logger *slog.Logger, client *ent.Client) http.Handler {
 	})
 }
 
-func mapPollToResponse(p *ent.Poll) PollResponse {
+// loadPollResponse loads a poll with its options and their vote counts as
+// seen by the principal, which is the zero value for unauthenticated requests
+func loadPollResponse(ctx context.Context, client *ent.Client, id int, principal middleware.Principal) (PollResponse, error) {
+	p, err := loadViewablePoll(ctx, client, id, principal)
+	if err != nil {
+		return PollResponse{}, err
+	}
+	return pollResponseFor(ctx, client, p, principal)
+}
+
+// loadViewablePoll loads a poll with its options if the principal may see it
+func loadViewablePoll(ctx context.Context, client *ent.Client, id int, principal middleware.Principal) (*ent.Poll, error) {
+	return queryViewablePolls(ctx, client, principal).
+		Where(entpoll.ID(id)).
+		WithOptions().
+		Only(ctx)
+}
+
+// pollResponseFor maps the poll, loaded with its options, and its vote
+// counts as the principal sees them
+func pollResponseFor(ctx context.Context, client *ent.Client, p *ent.Poll, principal middleware.Principal) (PollResponse, error) {
+	view, err := viewPoll(ctx, client, p, principal)
+	if err != nil {
+		return PollResponse{}, err
+	}
+	return mapPollViewToResponse(view), nil
+}
+
+// mapPollViewToResponse maps a poll as a principal sees it to the v1 response
+func mapPollViewToResponse(v pollView) PollResponse {
+	response := mapPollToResponse(v.Poll, v.VoteCounts)
+	if v.Manages {
+		response.ShareSlug = v.Poll.ShareSlug
+	}
+	if !v.ResultsVisible {
+		response.hideResults()
+	}
+	return response
+}
+
+// mapPollsToViewerResponse maps the polls, loaded with their options, as the
+// principal sees them: the share slug only on polls they manage, and no vote
+// counts on polls whose results are hidden from them
+func mapPollsToViewerResponse(ctx context.Context, client *ent.Client, polls []*ent.Poll, principal middleware.Principal) ([]PollResponse, error) {
+	pollIDs := make([]int, len(polls))
+	for i, p := range polls {
+		pollIDs[i] = p.ID
+	}
+	counts, err := voteCounts(ctx, client, pollIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	visible, err := visibleResults(ctx, client, polls, []middleware.Principal{principal})
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]PollResponse, len(polls))
+	for i, p := range polls {
+		responses[i] = mapPollToResponse(p, counts)
+		if canManagePoll(principal, p) {
+			responses[i].ShareSlug = p.ShareSlug
+		}
+		if !visible[pollViewer{pollID: p.ID, userID: principal.UserID}] {
+			responses[i].hideResults()
+		}
+	}
+	return responses, nil
+}
+
+// voteCounts returns the number of votes per option on the given polls,
+// aggregated by the database. Ranked ballots count first preferences only.
+func voteCounts(ctx context.Context, client *ent.Client, pollIDs ...int) (map[int]int, error) {
+	type optionCount struct {
+		OptionID int `json:"option_id"`
+		Count    int `json:"count"`
+	}
+
+	var rows []optionCount
+	err := client.Vote.Query().
+		Where(
+			vote.PollIDIn(pollIDs...),
+			vote.Or(vote.RankIsNil(), vote.Rank(1)),
+		).
+		GroupBy(vote.FieldOptionID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Votes on anonymous polls are kept in their own table. Each option's
+	// votes are all in one table or the other.
+	var anonymousRows []optionCount
+	err = client.AnonymousVote.Query().
+		Where(
+			anonymousvote.PollIDIn(pollIDs...),
+			anonymousvote.Or(anonymousvote.RankIsNil(), anonymousvote.Rank(1)),
+		).
+		GroupBy(anonymousvote.FieldOptionID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &anonymousRows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int, len(rows)+len(anonymousRows))
+	for _, row := range append(rows, anonymousRows...) {
+		counts[row.OptionID] = row.Count
+	}
+	return counts, nil
+}
+
+func mapPollToResponse(p *ent.Poll, voteCounts map[int]int) PollResponse {
 	return PollResponse{
-		ID:        p.ID,
-		Title:     p.Title,
-		CreatedAt: p.CreatedAt,
-		Options:   mapOptionsToResponse(p.Edges.Options),
+		ID:                p.ID,
+		OrganizationID:    p.OrganizationID,
+		Title:             p.Title,
+		Status:            pollStatus(p, time.Now()),
+		VotingMethod:      string(p.VotingMethod),
+		MinSelections:     p.MinSelections,
+		MaxSelections:     p.MaxSelections,
+		Anonymous:         p.Anonymous,
+		Visibility:        string(p.Visibility),
+		ResultsVisibility: string(p.ResultsVisibility),
+		OpensAt:           p.OpensAt,
+		ClosesAt:          p.ClosesAt,
+		ClosedAt:          p.ClosedAt,
+		CreatedAt:         p.CreatedAt,
+		Options:           mapOptionsToResponse(p.Edges.Options, voteCounts),
 	}
 }
 
-func mapOptionsToResponse(options []*ent.PollOption) []OptionResponse {
+func mapOptionsToResponse(options []*ent.PollOption, voteCounts map[int]int) []OptionResponse {
 	result := make([]OptionResponse, len(options))
 	for i, o := range options {
-		// Calculate vote count dynamically from the votes edge
-		voteCount := len(o.Edges.Votes)
+		count := voteCounts[o.ID]
 		result[i] = OptionResponse{
 			ID:        o.ID,
 			Text:      o.Text,
-			VoteCount: voteCount,
+			VoteCount: &count,
 		}
 	}
 	return result
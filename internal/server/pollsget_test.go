@@ -6,10 +6,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/ivankorhner/polling-app/internal/server"
@@ -34,10 +36,11 @@ func TestHandleListPolls_EmptyDatabase(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rec.Code)
 	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
 
-	var polls []server.PollResponse
-	err := json.Unmarshal(rec.Body.Bytes(), &polls)
+	var page server.PollListResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &page)
 	require.NoError(t, err)
-	assert.Empty(t, polls)
+	assert.Empty(t, page.Data)
+	assert.Nil(t, page.NextCursor)
 }
 
 func TestHandleListPolls_WithPolls(t *testing.T) {
@@ -73,7 +76,14 @@ func TestHandleListPolls_WithPolls(t *testing.T) {
 	require.NoError(t, err)
 
 	// Create votes to test dynamic vote counting
+	ballot1, err := testDB.Client.Ballot.Create().
+		SetPollID(poll.ID).
+		SetUserID(user.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
 	_, err = testDB.Client.Vote.Create().
+		SetBallotID(ballot1.ID).
 		SetPollID(poll.ID).
 		SetOptionID(opt1.ID).
 		SetUserID(user.ID).
@@ -87,7 +97,14 @@ func TestHandleListPolls_WithPolls(t *testing.T) {
 		Save(ctx)
 	require.NoError(t, err)
 
+	ballot2, err := testDB.Client.Ballot.Create().
+		SetPollID(poll.ID).
+		SetUserID(user2.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
 	_, err = testDB.Client.Vote.Create().
+		SetBallotID(ballot2.ID).
 		SetPollID(poll.ID).
 		SetOptionID(opt2.ID).
 		SetUserID(user2.ID).
@@ -104,10 +121,13 @@ func TestHandleListPolls_WithPolls(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 
-	var polls []server.PollResponse
-	err = json.Unmarshal(rec.Body.Bytes(), &polls)
+	var page server.PollListResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &page)
 	require.NoError(t, err)
-	require.Len(t, polls, 1)
+	require.Len(t, page.Data, 1)
+	assert.Nil(t, page.NextCursor)
+
+	polls := page.Data
 
 	assert.Equal(t, poll.ID, polls[0].ID)
 	assert.Equal(t, "Test Poll", polls[0].Title)
@@ -116,9 +136,9 @@ func TestHandleListPolls_WithPolls(t *testing.T) {
 	// Check vote counts are calculated correctly
 	for _, opt := range polls[0].Options {
 		if opt.ID == opt1.ID {
-			assert.Equal(t, 1, opt.VoteCount)
+			assert.Equal(t, 1, *opt.VoteCount)
 		} else if opt.ID == opt2.ID {
-			assert.Equal(t, 1, opt.VoteCount)
+			assert.Equal(t, 1, *opt.VoteCount)
 		}
 	}
 }
@@ -169,7 +189,7 @@ func TestHandleGetPoll(t *testing.T) {
 				var errResp server.ErrorResponse
 				err := json.Unmarshal(rec.Body.Bytes(), &errResp)
 				require.NoError(t, err)
-				assert.Equal(t, "poll not found", errResp.Error)
+				assert.Equal(t, "poll not found", errResp.Detail)
 			},
 		},
 		{
@@ -183,7 +203,7 @@ func TestHandleGetPoll(t *testing.T) {
 				var errResp server.ErrorResponse
 				err := json.Unmarshal(rec.Body.Bytes(), &errResp)
 				require.NoError(t, err)
-				assert.Equal(t, "invalid poll id", errResp.Error)
+				assert.Equal(t, "invalid poll id", errResp.Detail)
 			},
 		},
 	}
@@ -214,3 +234,100 @@ func TestHandleGetPoll(t *testing.T) {
 		})
 	}
 }
+
+// BenchmarkHandleGetPoll measures GET /polls/{id} as the number of votes on
+// the poll and on every other poll grows. Vote counts are aggregated in the
+// database over the poll's own votes, so the time per request should follow
+// the poll's votes and not change when other polls fill the votes table.
+func BenchmarkHandleGetPoll(b *testing.B) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, b)
+	defer testDB.Teardown(ctx)
+
+	owner, err := testDB.Client.User.Create().
+		SetUsername("owner").
+		SetEmail("owner@example.com").
+		Save(ctx)
+	require.NoError(b, err)
+
+	createPoll := func(title string) (int, string) {
+		poll, err := testDB.Client.Poll.Create().
+			SetOwnerID(owner.ID).
+			SetTitle(title).
+			Save(ctx)
+		require.NoError(b, err)
+
+		options := make([]string, 4)
+		for i := range options {
+			option, err := testDB.Client.PollOption.Create().
+				SetPollID(poll.ID).
+				SetText(fmt.Sprintf("Option %d", i+1)).
+				Save(ctx)
+			require.NoError(b, err)
+			options[i] = fmt.Sprint(option.ID)
+		}
+		return poll.ID, "{" + strings.Join(options, ",") + "}"
+	}
+
+	// Bulk voters are inserted in SQL; going through ent would dominate setup
+	voters := 0
+	addVotes := func(pollID int, options string, n int) {
+		from, to := voters+1, voters+n
+		voters = to
+
+		_, err := testDB.DB.ExecContext(ctx, `
+			INSERT INTO users (username, email, created_at)
+			SELECT 'voter' || g, 'voter' || g || '@example.com', now()
+			FROM generate_series($1::int, $2::int) AS g`, from, to)
+		require.NoError(b, err)
+
+		_, err = testDB.DB.ExecContext(ctx, `
+			INSERT INTO ballots (poll_id, user_id, created_at)
+			SELECT $1, u.id, now()
+			FROM generate_series($2::int, $3::int) AS g
+			JOIN users u ON u.username = 'voter' || g`, pollID, from, to)
+		require.NoError(b, err)
+
+		_, err = testDB.DB.ExecContext(ctx, `
+			INSERT INTO votes (ballot_id, poll_id, option_id, user_id, created_at)
+			SELECT b.id, b.poll_id, ($2::bigint[])[1 + b.id % 4], b.user_id, now()
+			FROM ballots b
+			WHERE b.poll_id = $1 AND NOT EXISTS (SELECT 1 FROM votes v WHERE v.ballot_id = b.id)`, pollID, options)
+		require.NoError(b, err)
+	}
+
+	pollID, options := createPoll("Benchmark Poll")
+	otherPollID, otherOptions := createPoll("Other Poll")
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handler := server.HandleGetPoll(logger, testDB.Client)
+
+	// The first two runs differ only in the votes on the other poll
+	pollVotes := 0
+	for _, size := range []struct{ poll, others int }{
+		{100, 0},
+		{100, 100_000},
+		{1_000, 100_000},
+		{10_000, 100_000},
+	} {
+		addVotes(pollID, options, size.poll-pollVotes)
+		pollVotes = size.poll
+		if others := voters - pollVotes; others < size.others {
+			addVotes(otherPollID, otherOptions, size.others-others)
+		}
+
+		b.Run(fmt.Sprintf("votes=%d/others=%d", size.poll, size.others), func(b *testing.B) {
+			for b.Loop() {
+				req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/polls/%d", pollID), nil)
+				req.SetPathValue("id", fmt.Sprintf("%d", pollID))
+				rec := httptest.NewRecorder()
+
+				handler.ServeHTTP(rec, req)
+
+				if rec.Code != http.StatusOK {
+					b.Fatalf("unexpected status %d", rec.Code)
+				}
+			}
+		})
+	}
+}
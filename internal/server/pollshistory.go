@@ -0,0 +1,131 @@
+package server
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// VoteHistoryResponse represents the history of ballots on a poll
+type VoteHistoryResponse struct {
+	PollID      int                 `json:"poll_id"`
+	Casts       int                 `json:"casts"`
+	Changes     int                 `json:"changes"`
+	Retractions int                 `json:"retractions"`
+	Events      []VoteEventResponse `json:"events"`
+}
+
+// VoteEventResponse represents a single ballot being cast, changed or
+// retracted. Events on anonymous polls name neither the user nor the options.
+type VoteEventResponse struct {
+	ID        int       `json:"id"`
+	UserID    int       `json:"user_id,omitempty"`
+	Action    string    `json:"action"`
+	OptionIDs []int     `json:"option_ids"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// HandleGetVoteHistory handles listing how votes on a poll were cast, changed
+// and retracted, oldest first
+func HandleGetVoteHistory(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, _ := middleware.PrincipalFromContext(r.Context())
+
+		idStr := r.PathValue("id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			writeValidationError(w, r, "invalid poll id")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "get vote history: starting", slog.Int("poll_id", id))
+
+		exists, err := queryViewablePolls(r.Context(), client, principal).Where(entpoll.ID(id)).Exist(r.Context())
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to check poll", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to retrieve vote history")
+			return
+		}
+		if !exists {
+			writeNotFoundError(w, r, "poll not found")
+			return
+		}
+
+		events, err := client.VoteEvent.Query().
+			Where(voteevent.PollID(id)).
+			Order(ent.Asc(voteevent.FieldID)).
+			All(r.Context())
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query vote events",
+				slog.String("error", err.Error()),
+				slog.Int("poll_id", id),
+			)
+			writeInternalError(w, r, "failed to retrieve vote history")
+			return
+		}
+
+		response := mapVoteHistoryToResponse(id, events)
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"get vote history: completed",
+			slog.Int("poll_id", id),
+			slog.Int("events", len(events)),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode vote history response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+func mapVoteHistoryToResponse(pollID int, events []*ent.VoteEvent) VoteHistoryResponse {
+	response := VoteHistoryResponse{
+		PollID: pollID,
+		Events: make([]VoteEventResponse, len(events)),
+	}
+
+	for i, e := range events {
+		switch e.Action {
+		case voteevent.ActionCast:
+			response.Casts++
+		case voteevent.ActionChange:
+			response.Changes++
+		case voteevent.ActionRetract:
+			response.Retractions++
+		}
+
+		optionIDs := e.OptionIds
+		if optionIDs == nil {
+			optionIDs = []int{}
+		}
+		response.Events[i] = VoteEventResponse{
+			ID:        e.ID,
+			Action:    string(e.Action),
+			OptionIDs: optionIDs,
+			CreatedAt: e.CreatedAt,
+		}
+		if e.UserID != nil {
+			response.Events[i].UserID = *e.UserID
+		}
+	}
+
+	return response
+}
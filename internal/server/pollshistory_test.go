@@ -0,0 +1,116 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleGetVoteHistory(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	user, err := testDB.Client.User.Create().
+		SetUsername("voter").
+		SetEmail("voter@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	poll, err := testDB.Client.Poll.Create().
+		SetOwnerID(user.ID).
+		SetTitle("Test Poll").
+		Save(ctx)
+	require.NoError(t, err)
+
+	option1, err := testDB.Client.PollOption.Create().
+		SetPollID(poll.ID).
+		SetText("Option 1").
+		Save(ctx)
+	require.NoError(t, err)
+
+	option2, err := testDB.Client.PollOption.Create().
+		SetPollID(poll.ID).
+		SetText("Option 2").
+		Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	// Cast, change twice, then retract
+	steps := []struct {
+		handler http.Handler
+		body    string
+	}{
+		{server.HandleVote(logger, testDB.Client, events.NewMemory(logger)), fmt.Sprintf(`{"option_id": %d}`, option1.ID)},
+		{server.HandleChangeVote(logger, testDB.Client, events.NewMemory(logger)), fmt.Sprintf(`{"option_id": %d}`, option2.ID)},
+		{server.HandleChangeVote(logger, testDB.Client, events.NewMemory(logger)), fmt.Sprintf(`{"option_id": %d}`, option1.ID)},
+		{server.HandleRetractVote(logger, testDB.Client, events.NewMemory(logger)), ""},
+	}
+	for _, step := range steps {
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(step.body))
+		req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+		req = asUser(req, user.ID)
+		rec := httptest.NewRecorder()
+		step.handler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/polls/%d/history", poll.ID), nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleGetVoteHistory(logger, testDB.Client)
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var result server.VoteHistoryResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+
+	assert.Equal(t, poll.ID, result.PollID)
+	assert.Equal(t, 1, result.Casts)
+	assert.Equal(t, 2, result.Changes)
+	assert.Equal(t, 1, result.Retractions)
+	require.Len(t, result.Events, 4)
+
+	actions := make([]string, len(result.Events))
+	for i, e := range result.Events {
+		actions[i] = e.Action
+		assert.Equal(t, user.ID, e.UserID)
+	}
+	assert.Equal(t, []string{"cast", "change", "change", "retract"}, actions)
+	assert.Equal(t, []int{option2.ID}, result.Events[1].OptionIDs)
+	assert.Empty(t, result.Events[3].OptionIDs)
+}
+
+func TestHandleGetVoteHistory_NotFound(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/polls/99999/history", nil)
+	req.SetPathValue("id", "99999")
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleGetVoteHistory(logger, testDB.Client)
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
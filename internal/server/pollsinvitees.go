@@ -0,0 +1,206 @@
+package server
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/pollinvitee"
+)
+
+// InviteUserRequest represents the request body for inviting a user to a poll
+type InviteUserRequest struct {
+	UserID int `json:"user_id"`
+}
+
+// InviteeResponse represents a user invited to a poll
+type InviteeResponse struct {
+	UserID    int       `json:"user_id"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// InviteeListResponse represents the users invited to a poll
+type InviteeListResponse struct {
+	Data []InviteeResponse `json:"data"`
+}
+
+// HandleInviteUser handles inviting a user to a poll. Invitations only
+// matter while the poll is private, but they are kept when it changes
+// visibility so making it private again restores them.
+func HandleInviteUser(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		p, ok := loadManagedPoll(logger, client, w, r, "invite user")
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "invite user: starting", slog.Int("poll_id", p.ID))
+
+		var req InviteUserRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
+			return
+		}
+		if req.UserID < 1 {
+			writeValidationError(w, r, "user_id must be a positive integer")
+			return
+		}
+
+		u, err := client.User.Get(r.Context(), req.UserID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeNotFoundError(w, r, "user not found")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to query user", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to invite user")
+			return
+		}
+
+		invitee, err := client.PollInvitee.Create().
+			SetPollID(p.ID).
+			SetUserID(u.ID).
+			Save(r.Context())
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				writeConflictError(w, r, "user is already invited")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to invite user", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to invite user")
+			return
+		}
+		invitee.Edges.User = u
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"invite user: completed",
+			slog.Int("poll_id", p.ID),
+			slog.Int("user_id", u.ID),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		if err := json.NewEncoder(w).Encode(mapInviteeToResponse(invitee)); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode invitee response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleListInvitees handles listing the users invited to a poll, in the
+// order they were invited
+func HandleListInvitees(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, ok := loadManagedPoll(logger, client, w, r, "list invitees")
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list invitees: starting", slog.Int("poll_id", p.ID))
+
+		invitees, err := client.PollInvitee.Query().
+			Where(pollinvitee.PollID(p.ID)).
+			WithUser().
+			Order(ent.Asc(pollinvitee.FieldID)).
+			All(r.Context())
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query invitees",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to retrieve invitees")
+			return
+		}
+
+		response := InviteeListResponse{Data: make([]InviteeResponse, len(invitees))}
+		for i, invitee := range invitees {
+			response.Data[i] = mapInviteeToResponse(invitee)
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list invitees: completed", slog.Int("count", len(invitees)))
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode invitees response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleUninviteUser handles withdrawing a user's invitation to a poll. The
+// user's ballot, if any, is kept.
+func HandleUninviteUser(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, ok := loadManagedPoll(logger, client, w, r, "uninvite user")
+		if !ok {
+			return
+		}
+
+		userID, err := strconv.Atoi(r.PathValue("userID"))
+		if err != nil {
+			writeValidationError(w, r, "invalid user id")
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"uninvite user: starting",
+			slog.Int("poll_id", p.ID),
+			slog.Int("user_id", userID),
+		)
+
+		deleted, err := client.PollInvitee.Delete().
+			Where(pollinvitee.PollID(p.ID), pollinvitee.UserID(userID)).
+			Exec(r.Context())
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to uninvite user", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to uninvite user")
+			return
+		}
+		if deleted == 0 {
+			writeNotFoundError(w, r, "invitee not found")
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"uninvite user: completed",
+			slog.Int("poll_id", p.ID),
+			slog.Int("user_id", userID),
+		)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func mapInviteeToResponse(invitee *ent.PollInvitee) InviteeResponse {
+	response := InviteeResponse{
+		UserID:    invitee.UserID,
+		CreatedAt: invitee.CreatedAt,
+	}
+	if invitee.Edges.User != nil {
+		response.Username = invitee.Edges.User.Username
+	}
+	return response
+}
@@ -0,0 +1,194 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
+)
+
+// ReopenPollRequest represents the optional request body for reopening a poll
+type ReopenPollRequest struct {
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+}
+
+// pollTransition applies a lifecycle change to the poll update. It writes an
+// error response and returns false when the transition is not allowed.
+type pollTransition func(w http.ResponseWriter, r *http.Request, p *ent.Poll, upd *ent.PollUpdateOne, now time.Time) bool
+
+// HandlePublishPoll handles publishing a draft poll
+func HandlePublishPoll(logger *slog.Logger, client *ent.Client, bus events.Bus) http.Handler {
+	return handlePollTransition(logger, client, bus, "publish poll", events.TypePollPublished,
+		func(w http.ResponseWriter, r *http.Request, p *ent.Poll, upd *ent.PollUpdateOne, _ time.Time) bool {
+			if !p.Draft {
+				writeConflictError(w, r, "poll is already published")
+				return false
+			}
+			upd.SetDraft(false)
+			return true
+		},
+	)
+}
+
+// HandleClosePoll handles closing a poll to further votes
+func HandleClosePoll(logger *slog.Logger, client *ent.Client, bus events.Bus) http.Handler {
+	return handlePollTransition(logger, client, bus, "close poll", events.TypePollClosed,
+		func(w http.ResponseWriter, r *http.Request, p *ent.Poll, upd *ent.PollUpdateOne, now time.Time) bool {
+			switch pollStatus(p, now) {
+			case PollStatusDraft:
+				writeConflictError(w, r, "draft polls cannot be closed")
+				return false
+			case PollStatusClosed:
+				writeConflictError(w, r, "poll is already closed")
+				return false
+			}
+			upd.SetClosedAt(now)
+			return true
+		},
+	)
+}
+
+// HandleReopenPoll handles reopening a closed poll. If the poll closed because
+// its closing time passed, a new closes_at may be supplied; otherwise the
+// closing time is cleared.
+func HandleReopenPoll(logger *slog.Logger, client *ent.Client, bus events.Bus) http.Handler {
+	return handlePollTransition(logger, client, bus, "reopen poll", events.TypePollReopened,
+		func(w http.ResponseWriter, r *http.Request, p *ent.Poll, upd *ent.PollUpdateOne, now time.Time) bool {
+			var req ReopenPollRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+				writeValidationError(w, r, "invalid request body")
+				return false
+			}
+
+			if pollStatus(p, now) != PollStatusClosed {
+				writeConflictError(w, r, "poll is not closed")
+				return false
+			}
+
+			if errs := ValidatePollSchedule(p.OpensAt, req.ClosesAt, now); errs != nil {
+				writeFieldErrors(w, r, errs)
+				return false
+			}
+
+			upd.ClearClosedAt()
+			switch {
+			case req.ClosesAt != nil:
+				upd.SetClosesAt(*req.ClosesAt)
+			case p.ClosesAt != nil && !now.Before(*p.ClosesAt):
+				upd.ClearClosesAt()
+			}
+			return true
+		},
+	)
+}
+
+// handlePollTransition applies a lifecycle change on behalf of the poll's
+// owner or an admin, publishes the event for it and responds with the
+// updated poll
+func handlePollTransition(
+	logger *slog.Logger,
+	client *ent.Client,
+	bus events.Bus,
+	action string,
+	event events.Type,
+	apply pollTransition,
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		idStr := r.PathValue("id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			writeValidationError(w, r, "invalid poll id")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, action+": starting", slog.Int("poll_id", id))
+
+		p, err := queryViewablePolls(r.Context(), client, principal).Where(entpoll.ID(id)).Only(r.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeNotFoundError(w, r, "poll not found")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to query poll", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to "+action)
+			return
+		}
+		if !authorizePollManagement(w, r, principal, p) {
+			return
+		}
+
+		// The change and its webhook deliveries commit together
+		tx, err := client.Tx(r.Context())
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to start transaction", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to "+action)
+			return
+		}
+
+		upd := nextPollVersion(tx.Poll, p, false)
+		if !apply(w, r, p, upd, time.Now()) {
+			_ = tx.Rollback()
+			return
+		}
+
+		if err := savePollTransition(r.Context(), tx, upd, event); err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to update poll", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to "+action)
+			return
+		}
+		publishEvent(r.Context(), logger, bus, event, id)
+
+		// Return updated poll with vote counts
+		response, err := loadPollResponse(r.Context(), client, id, principal)
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to reload poll", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to "+action)
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			action+": completed",
+			slog.Int("poll_id", id),
+			slog.String("status", response.Status),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to encode response", slog.String("error", err.Error()))
+		}
+	})
+}
+
+// savePollTransition saves the lifecycle change, queues the event for the
+// poll's webhooks and commits tx
+func savePollTransition(ctx context.Context, tx *ent.Tx, upd *ent.PollUpdateOne, event events.Type) error {
+	p, err := upd.Save(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err := webhooks.Enqueue(ctx, tx, event, p, nil); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,297 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleClosePoll(t *testing.T) {
+	tests := []struct {
+		name       string
+		setup      func(c *ent.PollCreate) *ent.PollCreate
+		wantStatus int
+		wantState  string
+		wantError  string
+	}{
+		{
+			name:       "open poll",
+			setup:      func(c *ent.PollCreate) *ent.PollCreate { return c },
+			wantStatus: http.StatusOK,
+			wantState:  server.PollStatusClosed,
+		},
+		{
+			name: "scheduled poll",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetOpensAt(time.Now().Add(time.Hour))
+			},
+			wantStatus: http.StatusOK,
+			wantState:  server.PollStatusClosed,
+		},
+		{
+			name: "already closed",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetClosedAt(time.Now().Add(-time.Minute))
+			},
+			wantStatus: http.StatusConflict,
+			wantError:  "poll is already closed",
+		},
+		{
+			name: "draft",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetDraft(true)
+			},
+			wantStatus: http.StatusConflict,
+			wantError:  "draft polls cannot be closed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			user, err := testDB.Client.User.Create().
+				SetUsername("testuser").
+				SetEmail("test@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			poll, err := tt.setup(testDB.Client.Poll.Create().
+				SetOwnerID(user.ID).
+				SetTitle("Test Poll")).
+				Save(ctx)
+			require.NoError(t, err)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/close", poll.ID), nil)
+			req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+			req = asUser(req, user.ID)
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleClosePoll(logger, testDB.Client, events.NewMemory(logger))
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantError != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantError, errResp.Detail)
+				return
+			}
+
+			var result server.PollResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			assert.Equal(t, tt.wantState, result.Status)
+			assert.NotNil(t, result.ClosedAt)
+		})
+	}
+}
+
+func TestHandleReopenPoll(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(c *ent.PollCreate) *ent.PollCreate
+		body         string
+		wantStatus   int
+		wantClosesAt bool
+		wantError    string
+	}{
+		{
+			name: "manually closed",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetClosedAt(time.Now().Add(-time.Minute))
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "closing time passed clears closes_at",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetClosesAt(time.Now().Add(-time.Minute))
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "closing time passed with new closes_at",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetClosesAt(time.Now().Add(-time.Minute))
+			},
+			body:         fmt.Sprintf(`{"closes_at": %q}`, time.Now().Add(time.Hour).Format(time.RFC3339)),
+			wantStatus:   http.StatusOK,
+			wantClosesAt: true,
+		},
+		{
+			name: "new closes_at in the past",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetClosedAt(time.Now().Add(-time.Minute))
+			},
+			body:       fmt.Sprintf(`{"closes_at": %q}`, time.Now().Add(-time.Hour).Format(time.RFC3339)),
+			wantStatus: http.StatusBadRequest,
+			wantError:  "closes_at must be in the future",
+		},
+		{
+			name: "new closes_at before opens_at",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetOpensAt(time.Now().Add(2 * time.Hour)).SetClosedAt(time.Now().Add(-time.Minute))
+			},
+			body:       fmt.Sprintf(`{"closes_at": %q}`, time.Now().Add(time.Hour).Format(time.RFC3339)),
+			wantStatus: http.StatusBadRequest,
+			wantError:  "closes_at must be after opens_at",
+		},
+		{
+			name:       "not closed",
+			setup:      func(c *ent.PollCreate) *ent.PollCreate { return c },
+			wantStatus: http.StatusConflict,
+			wantError:  "poll is not closed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			user, err := testDB.Client.User.Create().
+				SetUsername("testuser").
+				SetEmail("test@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			poll, err := tt.setup(testDB.Client.Poll.Create().
+				SetOwnerID(user.ID).
+				SetTitle("Test Poll")).
+				Save(ctx)
+			require.NoError(t, err)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/reopen", poll.ID), bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+			req = asUser(req, user.ID)
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleReopenPoll(logger, testDB.Client, events.NewMemory(logger))
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantError != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantError, errResp.Detail)
+				return
+			}
+
+			var result server.PollResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			assert.Equal(t, server.PollStatusOpen, result.Status)
+			assert.Nil(t, result.ClosedAt)
+			assert.Equal(t, tt.wantClosesAt, result.ClosesAt != nil)
+		})
+	}
+}
+
+func TestHandlePublishPoll(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	user, err := testDB.Client.User.Create().
+		SetUsername("testuser").
+		SetEmail("test@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	poll, err := testDB.Client.Poll.Create().
+		SetOwnerID(user.ID).
+		SetTitle("Test Poll").
+		SetDraft(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	handler := server.HandlePublishPoll(logger, testDB.Client, events.NewMemory(logger))
+
+	// First publish succeeds
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/publish", poll.ID), nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	req = asUser(req, user.ID)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var result server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, server.PollStatusOpen, result.Status)
+
+	// Second publish conflicts
+	req = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/publish", poll.ID), nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	req = asUser(req, user.ID)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestHandlePollTransition_Forbidden(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	owner, err := testDB.Client.User.Create().
+		SetUsername("owner").
+		SetEmail("owner@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	other, err := testDB.Client.User.Create().
+		SetUsername("other").
+		SetEmail("other@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	poll, err := testDB.Client.Poll.Create().
+		SetOwnerID(owner.ID).
+		SetTitle("Test Poll").
+		Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/close", poll.ID), nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	req = asUser(req, other.ID)
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleClosePoll(logger, testDB.Client, events.NewMemory(logger))
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	var errResp server.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+	assert.Equal(t, server.ErrCodeForbidden, errResp.Code)
+
+	// The poll stays open
+	unchanged, err := testDB.Client.Poll.Get(ctx, poll.ID)
+	require.NoError(t, err)
+	assert.Nil(t, unchanged.ClosedAt)
+}
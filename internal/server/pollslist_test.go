@@ -0,0 +1,298 @@
+//go:build integration
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listPolls calls HandleListPolls with the query and decodes the page
+func listPolls(t *testing.T, client *ent.Client, query url.Values) server.PollListResponse {
+	t.Helper()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/polls?"+query.Encode(), nil)
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleListPolls(logger, client)
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var page server.PollListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	return page
+}
+
+// pollIDs returns the IDs of the polls on a page in order
+func pollIDs(page server.PollListResponse) []int {
+	ids := make([]int, len(page.Data))
+	for i, p := range page.Data {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func TestHandleListPolls_Pagination(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	user, err := testDB.Client.User.Create().
+		SetUsername("testuser").
+		SetEmail("test@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Two polls share a creation time to exercise the ID tie-breaker
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+	created := []time.Time{base, base.Add(time.Minute), base.Add(time.Minute), base.Add(2 * time.Minute), base.Add(3 * time.Minute)}
+
+	var want []int
+	for i, createdAt := range created {
+		poll, err := testDB.Client.Poll.Create().
+			SetOwnerID(user.ID).
+			SetTitle(fmt.Sprintf("Poll %d", i+1)).
+			SetCreatedAt(createdAt).
+			Save(ctx)
+		require.NoError(t, err)
+		want = append([]int{poll.ID}, want...)
+	}
+	// Newest first; the tied pair is ordered by descending ID
+	want[2], want[3] = max(want[2], want[3]), min(want[2], want[3])
+
+	var got []int
+	query := url.Values{"limit": {"2"}}
+	for pages := 1; ; pages++ {
+		require.LessOrEqual(t, pages, 3)
+
+		page := listPolls(t, testDB.Client, query)
+		got = append(got, pollIDs(page)...)
+		if page.NextCursor == nil {
+			assert.Equal(t, 3, pages)
+			break
+		}
+		query.Set("cursor", *page.NextCursor)
+	}
+
+	assert.Equal(t, want, got)
+}
+
+func TestHandleListPolls_Filters(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	alice, err := testDB.Client.User.Create().
+		SetUsername("alice").
+		SetEmail("alice@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	bob, err := testDB.Client.User.Create().
+		SetUsername("bob").
+		SetEmail("bob@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	now := time.Now()
+	lastWeek := now.Add(-7 * 24 * time.Hour)
+
+	open, err := testDB.Client.Poll.Create().
+		SetOwnerID(alice.ID).
+		SetTitle("Open").
+		Save(ctx)
+	require.NoError(t, err)
+
+	draft, err := testDB.Client.Poll.Create().
+		SetOwnerID(alice.ID).
+		SetTitle("Draft").
+		SetDraft(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	scheduled, err := testDB.Client.Poll.Create().
+		SetOwnerID(bob.ID).
+		SetTitle("Scheduled").
+		SetOpensAt(now.Add(time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	expired, err := testDB.Client.Poll.Create().
+		SetOwnerID(bob.ID).
+		SetTitle("Expired").
+		SetClosesAt(now.Add(-time.Hour)).
+		SetCreatedAt(lastWeek).
+		Save(ctx)
+	require.NoError(t, err)
+
+	closed, err := testDB.Client.Poll.Create().
+		SetOwnerID(bob.ID).
+		SetTitle("Closed").
+		SetClosedAt(now.Add(-time.Minute)).
+		SetCreatedAt(lastWeek.Add(time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		query url.Values
+		want  []int
+	}{
+		{
+			name:  "owner",
+			query: url.Values{"owner_id": {fmt.Sprint(alice.ID)}},
+			want:  []int{draft.ID, open.ID},
+		},
+		{
+			name:  "open",
+			query: url.Values{"status": {server.PollStatusOpen}},
+			want:  []int{open.ID},
+		},
+		{
+			name:  "draft",
+			query: url.Values{"status": {server.PollStatusDraft}},
+			want:  []int{draft.ID},
+		},
+		{
+			name:  "scheduled",
+			query: url.Values{"status": {server.PollStatusScheduled}},
+			want:  []int{scheduled.ID},
+		},
+		{
+			name:  "closed manually or by schedule",
+			query: url.Values{"status": {server.PollStatusClosed}},
+			want:  []int{closed.ID, expired.ID},
+		},
+		{
+			name:  "created before",
+			query: url.Values{"created_before": {now.Add(-24 * time.Hour).Format(time.RFC3339)}},
+			want:  []int{closed.ID, expired.ID},
+		},
+		{
+			name: "created after with owner",
+			query: url.Values{
+				"created_after": {now.Add(-24 * time.Hour).Format(time.RFC3339)},
+				"owner_id":      {fmt.Sprint(bob.ID)},
+			},
+			want: []int{scheduled.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := listPolls(t, testDB.Client, tt.query)
+			assert.Equal(t, tt.want, pollIDs(page))
+		})
+	}
+}
+
+func TestHandleListPolls_Sort(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	owner, err := testDB.Client.User.Create().
+		SetUsername("owner").
+		SetEmail("owner@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	now := time.Now()
+	closesAt := []*time.Time{nil, ptr(now.Add(3 * time.Hour)), ptr(now.Add(time.Hour)), nil, ptr(now.Add(2 * time.Hour))}
+	ballots := []int{2, 0, 3, 1, 2}
+
+	polls := make([]*ent.Poll, len(closesAt))
+	for i := range polls {
+		create := testDB.Client.Poll.Create().
+			SetOwnerID(owner.ID).
+			SetTitle(fmt.Sprintf("Poll %d", i+1))
+		if closesAt[i] != nil {
+			create.SetClosesAt(*closesAt[i])
+		}
+		polls[i], err = create.Save(ctx)
+		require.NoError(t, err)
+
+		for j := range ballots[i] {
+			voter, err := testDB.Client.User.Create().
+				SetUsername(fmt.Sprintf("voter%d_%d", i, j)).
+				SetEmail(fmt.Sprintf("voter%d_%d@example.com", i, j)).
+				Save(ctx)
+			require.NoError(t, err)
+
+			_, err = testDB.Client.Ballot.Create().
+				SetPollID(polls[i].ID).
+				SetUserID(voter.ID).
+				Save(ctx)
+			require.NoError(t, err)
+		}
+	}
+
+	tests := []struct {
+		sort string
+		want []int
+	}{
+		// Ties on ballot count are broken by the newer poll first
+		{sort: server.SortMostVotes, want: []int{polls[2].ID, polls[4].ID, polls[0].ID, polls[3].ID, polls[1].ID}},
+		// Polls without a closing time come last, oldest first
+		{sort: server.SortClosingSoon, want: []int{polls[2].ID, polls[4].ID, polls[1].ID, polls[0].ID, polls[3].ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sort, func(t *testing.T) {
+			// Walk one poll per page so every position goes through a cursor
+			var got []int
+			query := url.Values{"sort": {tt.sort}, "limit": {"1"}}
+			for range len(polls) + 1 {
+				page := listPolls(t, testDB.Client, query)
+				got = append(got, pollIDs(page)...)
+				if page.NextCursor == nil {
+					break
+				}
+				query.Set("cursor", *page.NextCursor)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHandleListPolls_InvalidQuery(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/polls?limit=0", nil)
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleListPolls(logger, testDB.Client)
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var errResp server.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+	assert.Equal(t, "limit must be between 1 and 100", errResp.Detail)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,385 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/anonymousvote"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/vote"
+)
+
+// AddOptionRequest represents the request body for adding a poll option
+type AddOptionRequest struct {
+	Text string `json:"text"`
+}
+
+// UpdateOptionRequest represents the request body for renaming a poll option.
+// Options that already have votes are only renamed when Force is set, since
+// the new text changes what those voters chose.
+type UpdateOptionRequest struct {
+	Text  string `json:"text"`
+	Force bool   `json:"force"`
+}
+
+// HandleAddOption handles adding an option to a poll. On polls that let
+// voters mark every option, the maximum selections grow with the options.
+func HandleAddOption(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		p, ok := loadManagedPoll(logger, client, w, r, "add option")
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "add option: starting", slog.Int("poll_id", p.ID))
+
+		var req AddOptionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
+			return
+		}
+
+		// Validate the options the poll would have
+		texts := append(optionTexts(p.Edges.Options), req.Text)
+		if errs := ValidatePollOptions(texts); errs != nil {
+			writeFieldErrors(w, r, errs.at("/text"))
+			return
+		}
+
+		// ValidatePollOptions trims the texts in place
+		if err := addOption(r.Context(), client, p, texts[len(texts)-1]); err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to add option", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to add option")
+			return
+		}
+
+		writeManagedPollResponse(logger, client, w, r, p.ID, http.StatusCreated, "add option")
+	})
+}
+
+// HandleUpdateOption handles renaming a poll option. Like HandleUpdatePoll,
+// it honors If-Match.
+func HandleUpdateOption(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		p, ok := loadManagedPoll(logger, client, w, r, "update option")
+		if !ok {
+			return
+		}
+
+		index, ok := pathOption(w, r, p)
+		if !ok {
+			return
+		}
+		option := p.Edges.Options[index]
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"update option: starting",
+			slog.Int("poll_id", p.ID),
+			slog.Int("option_id", option.ID),
+		)
+
+		if !checkIfMatch(w, r, p) {
+			return
+		}
+
+		var req UpdateOptionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
+			return
+		}
+
+		// Validate the options the poll would have
+		texts := optionTexts(p.Edges.Options)
+		texts[index] = req.Text
+		if errs := ValidatePollOptions(texts); errs != nil {
+			writeFieldErrors(w, r, errs.at("/text"))
+			return
+		}
+
+		votes, err := countOptionVotes(r.Context(), client, p, option.ID)
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to count votes", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to update option")
+			return
+		}
+		if votes > 0 && !req.Force {
+			writeConflictError(w, r, "option has votes; set force to rename it")
+			return
+		}
+
+		guarded := r.Header.Get("If-Match") != ""
+		if err := renameOption(r.Context(), client, p, option.ID, texts[index], guarded); err != nil {
+			if guarded && ent.IsNotFound(err) {
+				writePreconditionFailedError(w, r, "poll has changed since it was fetched")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to update option", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to update option")
+			return
+		}
+
+		writeManagedPollResponse(logger, client, w, r, p.ID, http.StatusOK, "update option")
+	})
+}
+
+// HandleDeleteOption handles removing a poll option. An option with votes is
+// only removed when move_to names another option of the poll; each ballot
+// then selects that option in its place.
+func HandleDeleteOption(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, ok := loadManagedPoll(logger, client, w, r, "delete option")
+		if !ok {
+			return
+		}
+
+		index, ok := pathOption(w, r, p)
+		if !ok {
+			return
+		}
+		option := p.Edges.Options[index]
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"delete option: starting",
+			slog.Int("poll_id", p.ID),
+			slog.Int("option_id", option.ID),
+		)
+
+		moveTo := 0
+		if s := r.URL.Query().Get("move_to"); s != "" {
+			id, err := strconv.Atoi(s)
+			if err != nil || id == option.ID || !slices.ContainsFunc(p.Edges.Options, func(o *ent.PollOption) bool { return o.ID == id }) {
+				writeValidationError(w, r, "move_to must be another option of this poll")
+				return
+			}
+			moveTo = id
+		}
+
+		// Validate the options the poll would keep. Nothing in the request is
+		// at fault, so the error names no field.
+		remaining := slices.Delete(optionTexts(p.Edges.Options), index, index+1)
+		maxSelections := min(p.MaxSelections, len(remaining))
+		errs := append(ValidatePollOptions(remaining), ValidateSelectionRule(p.MinSelections, maxSelections, len(remaining))...)
+		if errs != nil {
+			writeValidationError(w, r, errs.Detail())
+			return
+		}
+
+		votes, err := countOptionVotes(r.Context(), client, p, option.ID)
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to count votes", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to delete option")
+			return
+		}
+		if votes > 0 && moveTo == 0 {
+			writeConflictError(w, r, "option has votes; pass move_to to move them to another option")
+			return
+		}
+
+		if err := deleteOption(r.Context(), client, p, option.ID, moveTo, maxSelections); err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to delete option", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to delete option")
+			return
+		}
+
+		writeManagedPollResponse(logger, client, w, r, p.ID, http.StatusOK, "delete option")
+	})
+}
+
+// pathOption returns the index among the poll's options of the option named
+// in the request path. It writes an error response and returns false when
+// the poll has no such option.
+func pathOption(w http.ResponseWriter, r *http.Request, p *ent.Poll) (int, bool) {
+	optionID, err := strconv.Atoi(r.PathValue("optionID"))
+	if err != nil {
+		writeValidationError(w, r, "invalid option id")
+		return 0, false
+	}
+
+	index := slices.IndexFunc(p.Edges.Options, func(o *ent.PollOption) bool { return o.ID == optionID })
+	if index < 0 {
+		writeNotFoundError(w, r, "option not found")
+		return 0, false
+	}
+	return index, true
+}
+
+// countOptionVotes returns the number of ballots that select the option
+func countOptionVotes(ctx context.Context, client *ent.Client, p *ent.Poll, optionID int) (int, error) {
+	if p.Anonymous {
+		return client.AnonymousVote.Query().Where(anonymousvote.OptionID(optionID)).Count(ctx)
+	}
+	return client.Vote.Query().Where(vote.OptionID(optionID)).Count(ctx)
+}
+
+func optionTexts(options []*ent.PollOption) []string {
+	texts := make([]string, len(options))
+	for i, o := range options {
+		texts[i] = o.Text
+	}
+	return texts
+}
+
+// renameOption changes the option's text and moves the poll to its next
+// version, guarded as nextPollVersion describes
+func renameOption(ctx context.Context, client *ent.Client, p *ent.Poll, optionID int, text string, guarded bool) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.PollOption.UpdateOneID(optionID).SetText(text).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = nextPollVersion(tx.Poll, p, guarded).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
+
+func addOption(ctx context.Context, client *ent.Client, p *ent.Poll, text string) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.PollOption.Create().SetPollID(p.ID).SetText(text).Save(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	// Keep "mark every option" polls covering the new option
+	upd := nextPollVersion(tx.Poll, p, false)
+	if p.VotingMethod != entpoll.VotingMethodPlurality && p.MaxSelections == len(p.Edges.Options) {
+		upd.AddMaxSelections(1)
+	}
+	if err = upd.Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
+
+// deleteOption removes the option, first moving its votes to the moveTo
+// option when moveTo is set, and caps the poll's maximum selections
+func deleteOption(ctx context.Context, client *ent.Client, p *ent.Poll, optionID, moveTo, maxSelections int) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if moveTo != 0 {
+		if err = moveVotes(ctx, tx, p, optionID, moveTo); err != nil {
+			return errors.Join(err, tx.Rollback())
+		}
+	}
+
+	if err = tx.PollOption.DeleteOneID(optionID).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = nextPollVersion(tx.Poll, p, false).SetMaxSelections(maxSelections).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
+
+// moveVotes rewrites every ballot that selects the from option so that it
+// selects the to option in its place
+func moveVotes(ctx context.Context, tx *ent.Tx, p *ent.Poll, from, to int) error {
+	if p.Anonymous {
+		return moveAnonymousVotes(ctx, tx, p, from, to)
+	}
+
+	ballotIDs, err := tx.Vote.Query().
+		Where(vote.OptionID(from)).
+		Select(vote.FieldBallotID).
+		Ints(ctx)
+	if err != nil {
+		return err
+	}
+
+	votes, err := tx.Vote.Query().
+		Where(vote.BallotIDIn(ballotIDs...)).
+		Order(ent.Asc(vote.FieldBallotID), ent.Asc(vote.FieldRank), ent.Asc(vote.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for len(votes) > 0 {
+		n := 1
+		for n < len(votes) && votes[n].BallotID == votes[0].BallotID {
+			n++
+		}
+		ballot := make([]markedOption, n)
+		for i, v := range votes[:n] {
+			ballot[i] = markedOption{OptionID: v.OptionID, Score: v.Score}
+		}
+		ballotID, userID := votes[0].BallotID, votes[0].UserID
+		votes = votes[n:]
+
+		optionIDs, scores := moveSelection(ballot, from, to)
+		if _, err = tx.Vote.Delete().Where(vote.BallotID(ballotID)).Exec(ctx); err != nil {
+			return err
+		}
+		if err = addVotes(ctx, tx, p, ballotID, optionIDs, scores, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// markedOption is an option marked on a ballot, with its score on scored
+// ballots
+type markedOption struct {
+	OptionID int
+	Score    *int
+}
+
+// moveSelection returns the selections and scores of a ballot, in preference
+// order, once the from option is replaced by the to option. A ballot that
+// already selects the to option keeps that selection, its rank moving up if
+// the from option was preferred, and its own score.
+func moveSelection(ballot []markedOption, from, to int) ([]int, map[int]int) {
+	var optionIDs []int
+	scores := make(map[int]int)
+	for _, v := range ballot {
+		optionID := v.OptionID
+		if optionID == from {
+			optionID = to
+		}
+		if _, seen := scores[optionID]; seen {
+			continue
+		}
+		optionIDs = append(optionIDs, optionID)
+		scores[optionID] = 0
+		if v.Score != nil {
+			scores[optionID] = *v.Score
+		}
+	}
+	for _, v := range ballot {
+		if v.OptionID == to && v.Score != nil {
+			scores[to] = *v.Score
+		}
+	}
+	return optionIDs, scores
+}
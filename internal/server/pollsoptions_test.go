@@ -0,0 +1,328 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// optionsFixture is a poll with options A, B and C owned by owner
+type optionsFixture struct {
+	owner   *ent.User
+	poll    *ent.Poll
+	options []*ent.PollOption
+}
+
+func setupOptionsFixture(ctx context.Context, t *testing.T, testDB *testutil.TestDB, method entpoll.VotingMethod, maxSelections int) optionsFixture {
+	t.Helper()
+
+	owner := testutil.CreateUsers(ctx, t, testDB.Client, "owner")[0]
+	poll, options := testutil.CreatePoll(ctx, t, testDB.Client, owner.ID, "Test Poll", []string{"A", "B", "C"}, func(c *ent.PollCreate) {
+		c.SetVotingMethod(method).SetMaxSelections(maxSelections)
+	})
+	return optionsFixture{owner: owner, poll: poll, options: options}
+}
+
+// castVote votes on the fixture poll as a new user
+func (f optionsFixture) castVote(ctx context.Context, t *testing.T, testDB *testutil.TestDB, name string, optionIDs ...int) *ent.User {
+	t.Helper()
+
+	voter := testutil.CreateUsers(ctx, t, testDB.Client, name)[0]
+
+	body, err := json.Marshal(server.VoteRequest{OptionIDs: optionIDs})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", f.poll.ID), bytes.NewReader(body))
+	req.SetPathValue("id", fmt.Sprintf("%d", f.poll.ID))
+	req = asUser(req, voter.ID)
+	rec := httptest.NewRecorder()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	server.HandleVote(logger, testDB.Client, events.NewMemory(logger)).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	return voter
+}
+
+// serveOption sends an option request for the fixture poll as its owner
+func (f optionsFixture) serveOption(handler http.Handler, method, target, optionID, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+	req.SetPathValue("id", fmt.Sprintf("%d", f.poll.ID))
+	if optionID != "" {
+		req.SetPathValue("optionID", optionID)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req = asUser(req, f.owner.ID)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHandleAddOption(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     entpoll.VotingMethod
+		maxSel     int
+		body       string
+		wantStatus int
+		wantError  string
+		wantMax    int
+	}{
+		{
+			name:       "plurality keeps single choice",
+			method:     entpoll.VotingMethodPlurality,
+			maxSel:     1,
+			body:       `{"text": "  D  "}`,
+			wantStatus: http.StatusCreated,
+			wantMax:    1,
+		},
+		{
+			name:       "approval covering every option grows",
+			method:     entpoll.VotingMethodApproval,
+			maxSel:     3,
+			body:       `{"text": "D"}`,
+			wantStatus: http.StatusCreated,
+			wantMax:    4,
+		},
+		{
+			name:       "explicit cap is kept",
+			method:     entpoll.VotingMethodApproval,
+			maxSel:     2,
+			body:       `{"text": "D"}`,
+			wantStatus: http.StatusCreated,
+			wantMax:    2,
+		},
+		{
+			name:       "empty text",
+			method:     entpoll.VotingMethodPlurality,
+			maxSel:     1,
+			body:       `{"text": " "}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "option cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			f := setupOptionsFixture(ctx, t, testDB, tt.method, tt.maxSel)
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			rec := f.serveOption(server.HandleAddOption(logger, testDB.Client),
+				http.MethodPost, fmt.Sprintf("/polls/%d/options", f.poll.ID), "", tt.body)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantError != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantError, errResp.Detail)
+				return
+			}
+
+			var result server.PollResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			require.Len(t, result.Options, 4)
+			assert.Equal(t, "D", result.Options[3].Text)
+			assert.Equal(t, tt.wantMax, result.MaxSelections)
+		})
+	}
+}
+
+func TestHandleUpdateOption(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	f := setupOptionsFixture(ctx, t, testDB, entpoll.VotingMethodPlurality, 1)
+	f.castVote(ctx, t, testDB, "voter", f.options[0].ID)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	handler := server.HandleUpdateOption(logger, testDB.Client)
+	rename := func(option int, body string) *httptest.ResponseRecorder {
+		return f.serveOption(handler, http.MethodPatch,
+			fmt.Sprintf("/polls/%d/options/%d", f.poll.ID, option), fmt.Sprint(option), body)
+	}
+
+	// Options without votes are renamed freely
+	rec := rename(f.options[1].ID, `{"text": "Bee"}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Voted options need force
+	rec = rename(f.options[0].ID, `{"text": "Ay"}`)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	var errResp server.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+	assert.Equal(t, "option has votes; set force to rename it", errResp.Detail)
+
+	rec = rename(f.options[0].ID, `{"text": "Ay", "force": true}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var result server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, "Ay", result.Options[0].Text)
+	assert.Equal(t, 1, *result.Options[0].VoteCount)
+	assert.Equal(t, "Bee", result.Options[1].Text)
+
+	// Options of other polls are not found
+	rec = rename(99999, `{"text": "Nope"}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandleDeleteOption(t *testing.T) {
+	tests := []struct {
+		name       string
+		moveTo     func(f optionsFixture) string
+		voted      bool
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "unvoted option",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "voted option without move_to",
+			voted:      true,
+			wantStatus: http.StatusConflict,
+			wantError:  "option has votes; pass move_to to move them to another option",
+		},
+		{
+			name: "move_to the deleted option",
+			moveTo: func(f optionsFixture) string {
+				return fmt.Sprint(f.options[0].ID)
+			},
+			voted:      true,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "move_to must be another option of this poll",
+		},
+		{
+			name: "move_to an unknown option",
+			moveTo: func(_ optionsFixture) string {
+				return "99999"
+			},
+			voted:      true,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "move_to must be another option of this poll",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			f := setupOptionsFixture(ctx, t, testDB, entpoll.VotingMethodPlurality, 1)
+			if tt.voted {
+				f.castVote(ctx, t, testDB, "voter", f.options[0].ID)
+			}
+
+			target := fmt.Sprintf("/polls/%d/options/%d", f.poll.ID, f.options[0].ID)
+			if tt.moveTo != nil {
+				target += "?move_to=" + tt.moveTo(f)
+			}
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+			rec := f.serveOption(server.HandleDeleteOption(logger, testDB.Client),
+				http.MethodDelete, target, fmt.Sprint(f.options[0].ID), "")
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			options, err := testDB.Client.PollOption.Query().Count(ctx)
+			require.NoError(t, err)
+			if tt.wantError != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantError, errResp.Detail)
+				assert.Equal(t, 3, options)
+				return
+			}
+			assert.Equal(t, 2, options)
+		})
+	}
+}
+
+func TestHandleDeleteOption_KeepsTwoOptions(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	f := setupOptionsFixture(ctx, t, testDB, entpoll.VotingMethodPlurality, 1)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	handler := server.HandleDeleteOption(logger, testDB.Client)
+
+	for i, wantStatus := range []int{http.StatusOK, http.StatusBadRequest} {
+		option := f.options[i]
+		rec := f.serveOption(handler, http.MethodDelete,
+			fmt.Sprintf("/polls/%d/options/%d", f.poll.ID, option.ID), fmt.Sprint(option.ID), "")
+		assert.Equal(t, wantStatus, rec.Code)
+	}
+}
+
+func TestHandleDeleteOption_MovesRankedVotes(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	f := setupOptionsFixture(ctx, t, testDB, entpoll.VotingMethodInstantRunoff, 3)
+	a, b, c := f.options[0].ID, f.options[1].ID, f.options[2].ID
+
+	first := f.castVote(ctx, t, testDB, "first", a, b, c)
+	second := f.castVote(ctx, t, testDB, "second", b, a)
+	third := f.castVote(ctx, t, testDB, "third", c, a)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	rec := f.serveOption(server.HandleDeleteOption(logger, testDB.Client), http.MethodDelete,
+		fmt.Sprintf("/polls/%d/options/%d?move_to=%d", f.poll.ID, a, c), fmt.Sprint(a), "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var result server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, 2, result.MaxSelections)
+	require.Len(t, result.Options, 2)
+
+	rankings := func(userID int) []int {
+		votes, err := testDB.Client.Vote.Query().
+			Where(vote.UserID(userID)).
+			Order(ent.Asc(vote.FieldRank)).
+			All(ctx)
+		require.NoError(t, err)
+		var optionIDs []int
+		for i, v := range votes {
+			require.NotNil(t, v.Rank)
+			assert.Equal(t, i+1, *v.Rank)
+			optionIDs = append(optionIDs, v.OptionID)
+		}
+		return optionIDs
+	}
+
+	// C takes A's place, keeping its own higher preference where it had one
+	assert.Equal(t, []int{c, b}, rankings(first.ID))
+	assert.Equal(t, []int{b, c}, rankings(second.ID))
+	assert.Equal(t, []int{c}, rankings(third.ID))
+
+	// First preferences: C twice, B once
+	counts := map[int]int{}
+	for _, o := range result.Options {
+		counts[o.ID] = *o.VoteCount
+	}
+	assert.Equal(t, map[int]int{b: 1, c: 2}, counts)
+}
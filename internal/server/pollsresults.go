@@ -0,0 +1,212 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/polloption"
+	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+	"github.com/ivankorhner/polling-app/internal/tally"
+)
+
+// ResultsResponse represents the outcome of counting a poll's ballots
+type ResultsResponse struct {
+	PollID       int             `json:"poll_id"`
+	VotingMethod string          `json:"voting_method"`
+	Status       string          `json:"status"`
+	BallotCount  int             `json:"ballot_count"`
+	Winners      []int           `json:"winners"`
+	Rounds       []RoundResponse `json:"rounds"`
+}
+
+// RoundResponse represents one counting round in poll results
+type RoundResponse struct {
+	Round      int             `json:"round"`
+	Tallies    []TallyResponse `json:"tallies"`
+	Exhausted  int             `json:"exhausted"`
+	Eliminated *int            `json:"eliminated,omitempty"`
+}
+
+// TallyResponse represents the votes an option holds in a counting round
+type TallyResponse struct {
+	OptionID int    `json:"option_id"`
+	Text     string `json:"text"`
+	Votes    int    `json:"votes"`
+}
+
+// HandleGetPollResults handles counting a poll's ballots with its voting
+// method. Requesters the poll's results are hidden from get a 403.
+func HandleGetPollResults(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, _ := middleware.PrincipalFromContext(r.Context())
+
+		idStr := r.PathValue("id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			writeValidationError(w, r, "invalid poll id")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "get poll results: starting", slog.Int("poll_id", id))
+
+		poll, err := queryViewablePolls(r.Context(), client, principal).
+			Where(entpoll.ID(id)).
+			WithOptions(func(q *ent.PollOptionQuery) {
+				q.Order(ent.Asc(polloption.FieldID))
+			}).
+			Only(r.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeNotFoundError(w, r, "poll not found")
+				return
+			}
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query poll",
+				slog.String("error", err.Error()),
+				slog.Int("poll_id", id),
+			)
+			writeInternalError(w, r, "failed to retrieve poll results")
+			return
+		}
+
+		visible, err := resultsVisibleTo(r.Context(), client, principal, poll)
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to check results visibility",
+				slog.String("error", err.Error()),
+				slog.Int("poll_id", id),
+			)
+			writeInternalError(w, r, "failed to retrieve poll results")
+			return
+		}
+		if !visible {
+			writeForbiddenError(w, r, hiddenResultsMessage(poll))
+			return
+		}
+
+		ballots, err := loadBallots(r.Context(), client, poll)
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query ballots",
+				slog.String("error", err.Error()),
+				slog.Int("poll_id", id),
+			)
+			writeInternalError(w, r, "failed to retrieve poll results")
+			return
+		}
+
+		options := make([]int, len(poll.Edges.Options))
+		for i, o := range poll.Edges.Options {
+			options[i] = o.ID
+		}
+
+		result := votingMethod(poll.VotingMethod).Count(options, ballots)
+
+		response := mapResultsToResponse(poll, len(ballots), result)
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"get poll results: completed",
+			slog.Int("poll_id", id),
+			slog.Int("ballots", len(ballots)),
+			slog.Int("rounds", len(result.Rounds)),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode results response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// loadBallots returns the poll's ballots with options in preference order and
+// their scores on scored ballots
+func loadBallots(ctx context.Context, client *ent.Client, p *ent.Poll) ([]tally.Ballot, error) {
+	if p.Anonymous {
+		return loadAnonymousBallots(ctx, client, p.ID)
+	}
+
+	votes, err := client.Vote.Query().
+		Where(vote.PollID(p.ID)).
+		Order(ent.Asc(vote.FieldBallotID), ent.Asc(vote.FieldRank), ent.Asc(vote.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ballots []tally.Ballot
+	for i, v := range votes {
+		if i == 0 || v.BallotID != votes[i-1].BallotID {
+			ballots = append(ballots, tally.Ballot{})
+		}
+		b := &ballots[len(ballots)-1]
+		b.Options = append(b.Options, v.OptionID)
+		if v.Score != nil {
+			b.Scores = append(b.Scores, *v.Score)
+		}
+	}
+
+	return ballots, nil
+}
+
+func mapResultsToResponse(p *ent.Poll, ballotCount int, result tally.Result) ResultsResponse {
+	texts := make(map[int]string, len(p.Edges.Options))
+	for _, o := range p.Edges.Options {
+		texts[o.ID] = o.Text
+	}
+
+	rounds := make([]RoundResponse, len(result.Rounds))
+	for i, round := range result.Rounds {
+		tallies := make([]TallyResponse, len(round.Tallies))
+		for j, t := range round.Tallies {
+			tallies[j] = TallyResponse{
+				OptionID: t.OptionID,
+				Text:     texts[t.OptionID],
+				Votes:    t.Votes,
+			}
+		}
+
+		rounds[i] = RoundResponse{
+			Round:     round.Number,
+			Tallies:   tallies,
+			Exhausted: round.Exhausted,
+		}
+		if round.Eliminated != 0 {
+			eliminated := round.Eliminated
+			rounds[i].Eliminated = &eliminated
+		}
+	}
+
+	winners := result.Winners
+	if winners == nil {
+		winners = []int{}
+	}
+
+	return ResultsResponse{
+		PollID:       p.ID,
+		VotingMethod: string(p.VotingMethod),
+		Status:       pollStatus(p, time.Now()),
+		BallotCount:  ballotCount,
+		Winners:      winners,
+		Rounds:       rounds,
+	}
+}
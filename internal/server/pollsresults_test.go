@@ -0,0 +1,321 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleGetPollResults_InstantRunoff(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	owner, err := testDB.Client.User.Create().
+		SetUsername("owner").
+		SetEmail("owner@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	poll, err := testDB.Client.Poll.Create().
+		SetOwnerID(owner.ID).
+		SetTitle("Team lead").
+		SetVotingMethod(entpoll.VotingMethodInstantRunoff).
+		SetMaxSelections(3).
+		Save(ctx)
+	require.NoError(t, err)
+
+	options := make([]*ent.PollOption, 3)
+	for i, text := range []string{"Ann", "Ben", "Cat"} {
+		options[i], err = testDB.Client.PollOption.Create().
+			SetPollID(poll.ID).
+			SetText(text).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	ann, ben, cat := options[0].ID, options[1].ID, options[2].ID
+
+	// Ann leads on first preferences, but Cat's voters prefer Ben
+	rankings := [][]int{
+		{ann, ben},
+		{ann, cat},
+		{ben, ann},
+		{ben, cat},
+		{cat, ben},
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	voteHandler := server.HandleVote(logger, testDB.Client, events.NewMemory(logger))
+
+	for i, ranking := range rankings {
+		voter, err := testDB.Client.User.Create().
+			SetUsername(fmt.Sprintf("voter%d", i)).
+			SetEmail(fmt.Sprintf("voter%d@example.com", i)).
+			Save(ctx)
+		require.NoError(t, err)
+
+		body := fmt.Sprintf(`{"option_ids": [%d, %d]}`, ranking[0], ranking[1])
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(body))
+		req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+		req.Header.Set("Content-Type", "application/json")
+		req = asUser(req, voter.ID)
+		rec := httptest.NewRecorder()
+		voteHandler.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	// Preferences are stored with their rank
+	ranked, err := testDB.Client.Vote.Query().Where(vote.RankNotNil()).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 10, ranked)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/polls/%d/results", poll.ID), nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleGetPollResults(logger, testDB.Client)
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var result server.ResultsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+
+	assert.Equal(t, "instant_runoff", result.VotingMethod)
+	assert.Equal(t, 5, result.BallotCount)
+	assert.Equal(t, []int{ben}, result.Winners)
+	require.Len(t, result.Rounds, 2)
+
+	first := result.Rounds[0]
+	require.NotNil(t, first.Eliminated)
+	assert.Equal(t, cat, *first.Eliminated)
+	assert.Equal(t, []server.TallyResponse{
+		{OptionID: ann, Text: "Ann", Votes: 2},
+		{OptionID: ben, Text: "Ben", Votes: 2},
+		{OptionID: cat, Text: "Cat", Votes: 1},
+	}, first.Tallies)
+
+	final := result.Rounds[1]
+	assert.Nil(t, final.Eliminated)
+	assert.Equal(t, []server.TallyResponse{
+		{OptionID: ann, Text: "Ann", Votes: 2},
+		{OptionID: ben, Text: "Ben", Votes: 3},
+	}, final.Tallies)
+}
+
+func TestHandleGetPollResults_Plurality(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	user, err := testDB.Client.User.Create().
+		SetUsername("voter").
+		SetEmail("voter@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	poll, err := testDB.Client.Poll.Create().
+		SetOwnerID(user.ID).
+		SetTitle("Test Poll").
+		Save(ctx)
+	require.NoError(t, err)
+
+	option1, err := testDB.Client.PollOption.Create().
+		SetPollID(poll.ID).
+		SetText("Option 1").
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = testDB.Client.PollOption.Create().
+		SetPollID(poll.ID).
+		SetText("Option 2").
+		Save(ctx)
+	require.NoError(t, err)
+
+	ballot, err := testDB.Client.Ballot.Create().
+		SetPollID(poll.ID).
+		SetUserID(user.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = testDB.Client.Vote.Create().
+		SetBallotID(ballot.ID).
+		SetPollID(poll.ID).
+		SetOptionID(option1.ID).
+		SetUserID(user.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/polls/%d/results", poll.ID), nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleGetPollResults(logger, testDB.Client)
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var result server.ResultsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+
+	assert.Equal(t, "plurality", result.VotingMethod)
+	assert.Equal(t, 1, result.BallotCount)
+	assert.Equal(t, []int{option1.ID}, result.Winners)
+	require.Len(t, result.Rounds, 1)
+}
+
+func TestHandleGetPollResults_NotFound(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/polls/99999/results", nil)
+	req.SetPathValue("id", "99999")
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleGetPollResults(logger, testDB.Client)
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	var errResp server.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+	assert.Equal(t, "poll not found", errResp.Detail)
+}
+
+func TestHandleGetPollResults_Methods(t *testing.T) {
+	// Three voters rank or score Ann, Ben and Cat. Ann has the most first
+	// preferences, but Ben is everyone's first or second choice.
+	rankings := [][]int{{0, 1, 2}, {0, 1, 2}, {2, 1, 0}, {1, 2, 0}, {2, 1, 0}}
+	scores := [][]int{{10, 8, 0}, {10, 8, 0}, {0, 8, 10}, {0, 10, 5}, {0, 8, 10}}
+
+	tests := []struct {
+		method      entpoll.VotingMethod
+		wantWinners []int
+		wantVotes   []int
+	}{
+		{method: entpoll.VotingMethodApproval, wantWinners: []int{1}, wantVotes: []int{2, 5, 4}},
+		{method: entpoll.VotingMethodBorda, wantWinners: []int{1}, wantVotes: []int{4, 6, 5}},
+		{method: entpoll.VotingMethodSchulze, wantWinners: []int{1}, wantVotes: []int{0, 2, 1}},
+		{method: entpoll.VotingMethodScore, wantWinners: []int{1}, wantVotes: []int{20, 42, 25}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method.String(), func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			owner, err := testDB.Client.User.Create().
+				SetUsername("owner").
+				SetEmail("owner@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			poll, err := testDB.Client.Poll.Create().
+				SetOwnerID(owner.ID).
+				SetTitle("Team lead").
+				SetVotingMethod(tt.method).
+				SetMaxSelections(3).
+				Save(ctx)
+			require.NoError(t, err)
+
+			optionIDs := make([]int, 3)
+			for i, text := range []string{"Ann", "Ben", "Cat"} {
+				option, err := testDB.Client.PollOption.Create().
+					SetPollID(poll.ID).
+					SetText(text).
+					Save(ctx)
+				require.NoError(t, err)
+				optionIDs[i] = option.ID
+			}
+
+			for i := range rankings {
+				voter, err := testDB.Client.User.Create().
+					SetUsername(fmt.Sprintf("voter%d", i)).
+					SetEmail(fmt.Sprintf("voter%d@example.com", i)).
+					Save(ctx)
+				require.NoError(t, err)
+
+				ballot, err := testDB.Client.Ballot.Create().
+					SetPollID(poll.ID).
+					SetUserID(voter.ID).
+					Save(ctx)
+				require.NoError(t, err)
+
+				// Approval voters approve their top two choices
+				marked := rankings[i]
+				if tt.method == entpoll.VotingMethodApproval {
+					marked = marked[:2]
+				}
+				for rank, option := range marked {
+					create := testDB.Client.Vote.Create().
+						SetBallotID(ballot.ID).
+						SetPollID(poll.ID).
+						SetOptionID(optionIDs[option]).
+						SetUserID(voter.ID)
+					switch tt.method {
+					case entpoll.VotingMethodScore:
+						create.SetScore(scores[i][option])
+					case entpoll.VotingMethodBorda, entpoll.VotingMethodSchulze:
+						create.SetRank(rank + 1)
+					}
+					_, err = create.Save(ctx)
+					require.NoError(t, err)
+				}
+			}
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/polls/%d/results", poll.ID), nil)
+			req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleGetPollResults(logger, testDB.Client)
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var result server.ResultsResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+
+			assert.Equal(t, tt.method.String(), result.VotingMethod)
+			assert.Equal(t, len(rankings), result.BallotCount)
+
+			wantWinners := make([]int, len(tt.wantWinners))
+			for i, option := range tt.wantWinners {
+				wantWinners[i] = optionIDs[option]
+			}
+			assert.Equal(t, wantWinners, result.Winners)
+
+			require.Len(t, result.Rounds, 1)
+			votes := make([]int, len(result.Rounds[0].Tallies))
+			for i, tally := range result.Rounds[0].Tallies {
+				votes[i] = tally.Votes
+			}
+			assert.Equal(t, tt.wantVotes, votes)
+		})
+	}
+}
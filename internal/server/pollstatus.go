@@ -0,0 +1,58 @@
+package server
+
+import (
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/predicate"
+)
+
+// Poll lifecycle statuses reported in poll responses
+const (
+	PollStatusDraft     = "draft"
+	PollStatusScheduled = "scheduled"
+	PollStatusOpen      = "open"
+	PollStatusClosed    = "closed"
+)
+
+// pollStatus derives the lifecycle status of a poll at the given moment.
+// A manual close or a passed closing time wins over the opening schedule.
+func pollStatus(p *ent.Poll, now time.Time) string {
+	switch {
+	case p.Draft:
+		return PollStatusDraft
+	case p.ClosedAt != nil:
+		return PollStatusClosed
+	case p.ClosesAt != nil && !now.Before(*p.ClosesAt):
+		return PollStatusClosed
+	case p.OpensAt != nil && now.Before(*p.OpensAt):
+		return PollStatusScheduled
+	default:
+		return PollStatusOpen
+	}
+}
+
+// statusPredicate matches polls whose status at the given moment is status,
+// mirroring pollStatus in SQL
+func statusPredicate(status string, now time.Time) predicate.Poll {
+	notClosed := entpoll.And(
+		entpoll.Draft(false),
+		entpoll.ClosedAtIsNil(),
+		entpoll.Or(entpoll.ClosesAtIsNil(), entpoll.ClosesAtGT(now)),
+	)
+
+	switch status {
+	case PollStatusDraft:
+		return entpoll.Draft(true)
+	case PollStatusClosed:
+		return entpoll.And(
+			entpoll.Draft(false),
+			entpoll.Or(entpoll.ClosedAtNotNil(), entpoll.ClosesAtLTE(now)),
+		)
+	case PollStatusScheduled:
+		return entpoll.And(notClosed, entpoll.OpensAtGT(now))
+	default:
+		return entpoll.And(notClosed, entpoll.Or(entpoll.OpensAtIsNil(), entpoll.OpensAtLTE(now)))
+	}
+}
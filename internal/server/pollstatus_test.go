@@ -0,0 +1,69 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+)
+
+func TestPollStatus(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		poll *ent.Poll
+		want string
+	}{
+		{
+			name: "open without schedule",
+			poll: &ent.Poll{},
+			want: PollStatusOpen,
+		},
+		{
+			name: "draft",
+			poll: &ent.Poll{Draft: true, ClosedAt: &past},
+			want: PollStatusDraft,
+		},
+		{
+			name: "scheduled",
+			poll: &ent.Poll{OpensAt: &future},
+			want: PollStatusScheduled,
+		},
+		{
+			name: "opened on schedule",
+			poll: &ent.Poll{OpensAt: &past, ClosesAt: &future},
+			want: PollStatusOpen,
+		},
+		{
+			name: "closing time passed",
+			poll: &ent.Poll{ClosesAt: &past},
+			want: PollStatusClosed,
+		},
+		{
+			name: "closing time reached",
+			poll: &ent.Poll{ClosesAt: &now},
+			want: PollStatusClosed,
+		},
+		{
+			name: "closed manually",
+			poll: &ent.Poll{ClosedAt: &past, ClosesAt: &future},
+			want: PollStatusClosed,
+		},
+		{
+			name: "closed manually before opening",
+			poll: &ent.Poll{ClosedAt: &past, OpensAt: &future},
+			want: PollStatusClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pollStatus(tt.poll, now))
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// UpdatePollRequest represents the request body for editing a poll. Omitted
+// fields are left unchanged.
+type UpdatePollRequest struct {
+	Title             *string `json:"title,omitempty"`
+	Visibility        *string `json:"visibility,omitempty"`
+	ResultsVisibility *string `json:"results_visibility,omitempty"`
+}
+
+// HandleUpdatePoll handles editing a poll's details by its owner or an
+// admin. With an If-Match header the edit only applies to the version of the
+// poll it names.
+func HandleUpdatePoll(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		p, ok := loadManagedPoll(logger, client, w, r, "update poll")
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "update poll: starting", slog.Int("poll_id", p.ID))
+
+		if !checkIfMatch(w, r, p) {
+			return
+		}
+
+		var req UpdatePollRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
+			return
+		}
+
+		if req.Title == nil && req.Visibility == nil && req.ResultsVisibility == nil {
+			writeValidationError(w, r, "no changes requested")
+			return
+		}
+
+		guarded := r.Header.Get("If-Match") != ""
+		upd := nextPollVersion(client.Poll, p, guarded)
+		var errs FieldErrors
+
+		// Validate title
+		if req.Title != nil {
+			errs = append(errs, ValidatePollTitle(*req.Title)...)
+			upd.SetTitle(strings.TrimSpace(*req.Title))
+		}
+
+		// Validate visibility. A poll that stops being public keeps the share
+		// slug it was given before, so links already handed out still work.
+		if req.Visibility != nil {
+			errs = append(errs, ValidatePollVisibility(*req.Visibility)...)
+			upd.SetVisibility(entpoll.Visibility(*req.Visibility))
+			if *req.Visibility != string(entpoll.VisibilityPublic) && p.ShareSlug == nil {
+				upd.SetShareSlug(newShareSlug())
+			}
+		}
+
+		// Validate results visibility
+		if req.ResultsVisibility != nil {
+			errs = append(errs, ValidateResultsVisibility(*req.ResultsVisibility)...)
+			upd.SetResultsVisibility(entpoll.ResultsVisibility(*req.ResultsVisibility))
+		}
+
+		if errs != nil {
+			writeFieldErrors(w, r, errs)
+			return
+		}
+
+		if _, err := upd.Save(r.Context()); err != nil {
+			if guarded && ent.IsNotFound(err) {
+				writePreconditionFailedError(w, r, "poll has changed since it was fetched")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to update poll", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to update poll")
+			return
+		}
+
+		writeManagedPollResponse(logger, client, w, r, p.ID, http.StatusOK, "update poll")
+	})
+}
+
+// writeManagedPollResponse reloads the poll after a change and writes it
+// with the given status and its new validators
+func writeManagedPollResponse(logger *slog.Logger, client *ent.Client, w http.ResponseWriter, r *http.Request, pollID, status int, action string) {
+	principal, _ := middleware.PrincipalFromContext(r.Context())
+	p, err := loadViewablePoll(r.Context(), client, pollID, principal)
+	if err != nil {
+		logger.LogAttrs(r.Context(), slog.LevelError, "failed to reload poll", slog.String("error", err.Error()))
+		writeInternalError(w, r, "failed to "+action)
+		return
+	}
+	response, err := pollResponseFor(r.Context(), client, p, principal)
+	if err != nil {
+		logger.LogAttrs(r.Context(), slog.LevelError, "failed to reload poll", slog.String("error", err.Error()))
+		writeInternalError(w, r, "failed to "+action)
+		return
+	}
+
+	// Let the client make its next change conditional on this version
+	validators, err := loadPollValidators(r.Context(), client, p, principal)
+	if err != nil {
+		logger.LogAttrs(r.Context(), slog.LevelError, "failed to load poll version", slog.String("error", err.Error()))
+		writeInternalError(w, r, "failed to "+action)
+		return
+	}
+	validators.setHeaders(w.Header())
+
+	logger.LogAttrs(
+		r.Context(),
+		slog.LevelInfo,
+		action+": completed",
+		slog.Int("poll_id", pollID),
+		slog.Int("options_count", len(response.Options)),
+	)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.LogAttrs(r.Context(), slog.LevelError, "failed to encode response", slog.String("error", err.Error()))
+	}
+}
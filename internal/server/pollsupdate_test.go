@@ -0,0 +1,118 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleUpdatePoll(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		asOwner    bool
+		wantStatus int
+		wantError  string
+		wantTitle  string
+	}{
+		{
+			name:       "rename",
+			body:       `{"title": "  Favorite colors?  "}`,
+			asOwner:    true,
+			wantStatus: http.StatusOK,
+			wantTitle:  "Favorite colors?",
+		},
+		{
+			name:       "empty title",
+			body:       `{"title": " "}`,
+			asOwner:    true,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "title is required",
+			wantTitle:  "Favorite color?",
+		},
+		{
+			name:       "no changes",
+			body:       `{}`,
+			asOwner:    true,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "no changes requested",
+			wantTitle:  "Favorite color?",
+		},
+		{
+			name:       "not the owner",
+			body:       `{"title": "Hijacked"}`,
+			wantStatus: http.StatusForbidden,
+			wantError:  "only the poll owner or an admin can modify this poll",
+			wantTitle:  "Favorite color?",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			owner, err := testDB.Client.User.Create().
+				SetUsername("owner").
+				SetEmail("owner@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			other, err := testDB.Client.User.Create().
+				SetUsername("other").
+				SetEmail("other@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			poll, err := testDB.Client.Poll.Create().
+				SetOwnerID(owner.ID).
+				SetTitle("Favorite color?").
+				Save(ctx)
+			require.NoError(t, err)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			req := httptest.NewRequest(http.MethodPatch, fmt.Sprintf("/polls/%d", poll.ID), bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+			req.Header.Set("Content-Type", "application/json")
+			if tt.asOwner {
+				req = asUser(req, owner.ID)
+			} else {
+				req = asUser(req, other.ID)
+			}
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleUpdatePoll(logger, testDB.Client)
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantError != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantError, errResp.Detail)
+			} else {
+				var result server.PollResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+				assert.Equal(t, tt.wantTitle, result.Title)
+			}
+
+			stored, err := testDB.Client.Poll.Get(ctx, poll.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTitle, stored.Title)
+		})
+	}
+}
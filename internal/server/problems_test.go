@@ -0,0 +1,89 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/config"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProblemDetails(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	handler := server.AddRoutes(ctx, &config.Config{APITimeout: time.Minute}, logger, testDB.DB, testDB.Client, events.NewMemory(logger))
+
+	serve := func(method, path, token, body string) (*httptest.ResponseRecorder, server.ErrorResponse) {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		var problem server.ErrorResponse
+		if rec.Code >= http.StatusBadRequest {
+			assert.Equal(t, server.ProblemContentType, rec.Header().Get("Content-Type"))
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			assert.Equal(t, rec.Code, problem.Status)
+			assert.Equal(t, "urn:uuid:"+rec.Header().Get(middleware.RequestIDHeader), problem.Instance)
+		}
+		return rec, problem
+	}
+
+	// Every invalid field of a registration is reported
+	rec, problem := serve(http.MethodPost, "/users", "", `{"username": "1x", "email": "nope"}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	assert.Equal(t, "/problems/validation-error", problem.Type)
+	assert.Equal(t, server.ErrCodeValidation, problem.Code)
+	assert.Equal(t, server.FieldErrors{
+		{Pointer: "/username", Code: server.FieldCodeTooShort, Detail: "username must be at least 3 characters"},
+		{Pointer: "/email", Code: server.FieldCodeInvalidFormat, Detail: "invalid email format"},
+	}, problem.Errors)
+
+	rec, _ = serve(http.MethodPost, "/users", "", `{"username": "alice", "email": "alice@example.com"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var alice server.RegisteredUserResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &alice))
+
+	// And every invalid field of a poll
+	rec, problem = serve(http.MethodPost, "/polls", alice.Token,
+		`{"title": " ", "options": ["Soup", ""], "visibility": "secret", "closes_at": "2020-01-01T00:00:00Z"}`)
+	require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	pointers := make([]string, len(problem.Errors))
+	for i, e := range problem.Errors {
+		pointers[i] = e.Pointer
+	}
+	assert.Equal(t, []string{"/title", "/options/1", "/visibility", "/closes_at"}, pointers)
+	assert.Equal(t, `title is required; option cannot be empty; unsupported visibility "secret"; closes_at must be in the future`, problem.Detail)
+
+	// Other errors are problems without fields
+	rec, problem = serve(http.MethodGet, "/polls/999999", "", "")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "/problems/not-found", problem.Type)
+	assert.Equal(t, "Resource not found", problem.Title)
+	assert.Equal(t, "poll not found", problem.Detail)
+	assert.Empty(t, problem.Errors)
+
+	rec, problem = serve(http.MethodPost, "/polls", "", `{"title": "Lunch?", "options": ["Soup", "Salad"]}`)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, server.ErrCodeUnauthorized, problem.Code)
+}
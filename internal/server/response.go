@@ -2,55 +2,148 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strings"
+
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
 )
 
 // MaxRequestBodySize is the maximum allowed size for request bodies (1MB)
 const MaxRequestBodySize = 1 << 20
 
-// ErrorResponse represents a unified JSON error response
+// ProblemContentType is the media type of error responses (RFC 9457)
+const ProblemContentType = "application/problem+json"
+
+// ErrorResponse represents a unified error response, an RFC 9457 problem
+// details document. Type identifies the kind of problem and Code names it for
+// programs; Instance identifies the request by its ID. Validation errors list
+// every invalid field in Errors.
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Code    string `json:"code,omitempty"`
-	Details string `json:"details,omitempty"`
+	Type     string      `json:"type"`
+	Title    string      `json:"title"`
+	Status   int         `json:"status,omitempty"`
+	Detail   string      `json:"detail"`
+	Instance string      `json:"instance,omitempty"`
+	Code     string      `json:"code"`
+	Errors   FieldErrors `json:"errors,omitempty"`
 }
 
 // Error codes for common error scenarios
 const (
-	ErrCodeValidation   = "VALIDATION_ERROR"
-	ErrCodeNotFound     = "NOT_FOUND"
-	ErrCodeConflict     = "CONFLICT"
-	ErrCodeInternal     = "INTERNAL_ERROR"
-	ErrCodeBadRequest   = "BAD_REQUEST"
-	ErrCodeUnauthorized = "UNAUTHORIZED"
+	ErrCodeValidation         = "VALIDATION_ERROR"
+	ErrCodeNotFound           = "NOT_FOUND"
+	ErrCodeConflict           = "CONFLICT"
+	ErrCodeInternal           = "INTERNAL_ERROR"
+	ErrCodeBadRequest         = "BAD_REQUEST"
+	ErrCodeUnauthorized       = "UNAUTHORIZED"
+	ErrCodeForbidden          = "FORBIDDEN"
+	ErrCodePollNotOpen        = "POLL_NOT_OPEN"
+	ErrCodePreconditionFailed = "PRECONDITION_FAILED"
+	ErrCodeTimeout            = "TIMEOUT"
 )
 
-// writeError writes a JSON error response
-func writeError(w http.ResponseWriter, message, code string, status int) {
-	w.Header().Set("Content-Type", "application/json")
+// problemTitles are the short summaries of the problem types, by error code
+var problemTitles = map[string]string{
+	ErrCodeValidation:           "Request validation failed",
+	ErrCodeNotFound:             "Resource not found",
+	ErrCodeConflict:             "Request conflicts with the current state",
+	ErrCodeInternal:             "Internal server error",
+	ErrCodeBadRequest:           "Bad request",
+	ErrCodeUnauthorized:         "Authentication required",
+	ErrCodeForbidden:            "Permission denied",
+	ErrCodePollNotOpen:          "Poll is not open for voting",
+	ErrCodePreconditionFailed:   "Precondition failed",
+	ErrCodeTimeout:              "Request timed out",
+	ErrCodeIdempotencyKeyReused: "Idempotency key reused",
+}
+
+// problemType returns the URI reference identifying the problem type of an
+// error code, e.g. /problems/validation-error
+func problemType(code string) string {
+	return "/problems/" + strings.ReplaceAll(strings.ToLower(code), "_", "-")
+}
+
+// newErrorResponse returns the problem details of an error with the given
+// code. The status and instance are left for HTTP responses to fill in.
+func newErrorResponse(message, code string) ErrorResponse {
+	return ErrorResponse{
+		Type:   problemType(code),
+		Title:  problemTitles[code],
+		Detail: message,
+		Code:   code,
+	}
+}
+
+// writeProblem writes a problem details response, identifying the request
+// by its ID
+func writeProblem(w http.ResponseWriter, r *http.Request, status int, problem ErrorResponse) {
+	problem.Status = status
+	if reqID := middleware.RequestIDFromContext(r.Context()); reqID != "" {
+		problem.Instance = "urn:uuid:" + reqID
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
 	w.WriteHeader(status)
-	_ = json.NewEncoder(w).Encode(ErrorResponse{
-		Error: message,
-		Code:  code,
-	})
+	_ = json.NewEncoder(w).Encode(problem)
+}
+
+// writeError writes a problem details error response
+func writeError(w http.ResponseWriter, r *http.Request, message, code string, status int) {
+	writeProblem(w, r, status, newErrorResponse(message, code))
 }
 
 // writeValidationError writes a validation error response
-func writeValidationError(w http.ResponseWriter, message string) {
-	writeError(w, message, ErrCodeValidation, http.StatusBadRequest)
+func writeValidationError(w http.ResponseWriter, r *http.Request, message string) {
+	writeError(w, r, message, ErrCodeValidation, http.StatusBadRequest)
+}
+
+// writeFieldErrors writes a validation error response listing the invalid
+// fields of the request
+func writeFieldErrors(w http.ResponseWriter, r *http.Request, errs FieldErrors) {
+	problem := newErrorResponse(errs.Detail(), ErrCodeValidation)
+	problem.Errors = errs
+	writeProblem(w, r, http.StatusBadRequest, problem)
 }
 
 // writeNotFoundError writes a not found error response
-func writeNotFoundError(w http.ResponseWriter, message string) {
-	writeError(w, message, ErrCodeNotFound, http.StatusNotFound)
+func writeNotFoundError(w http.ResponseWriter, r *http.Request, message string) {
+	writeError(w, r, message, ErrCodeNotFound, http.StatusNotFound)
 }
 
 // writeConflictError writes a conflict error response
-func writeConflictError(w http.ResponseWriter, message string) {
-	writeError(w, message, ErrCodeConflict, http.StatusConflict)
+func writeConflictError(w http.ResponseWriter, r *http.Request, message string) {
+	writeError(w, r, message, ErrCodeConflict, http.StatusConflict)
+}
+
+// writeUnauthorizedError writes an unauthorized error response, challenging
+// the client for a bearer token
+func writeUnauthorizedError(w http.ResponseWriter, r *http.Request, message string) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	writeError(w, r, message, ErrCodeUnauthorized, http.StatusUnauthorized)
+}
+
+// writeForbiddenError writes a forbidden error response
+func writeForbiddenError(w http.ResponseWriter, r *http.Request, message string) {
+	writeError(w, r, message, ErrCodeForbidden, http.StatusForbidden)
+}
+
+// writePreconditionFailedError writes a precondition failed error response
+func writePreconditionFailedError(w http.ResponseWriter, r *http.Request, message string) {
+	writeError(w, r, message, ErrCodePreconditionFailed, http.StatusPreconditionFailed)
 }
 
 // writeInternalError writes an internal server error response
-func writeInternalError(w http.ResponseWriter, message string) {
-	writeError(w, message, ErrCodeInternal, http.StatusInternalServerError)
+func writeInternalError(w http.ResponseWriter, r *http.Request, message string) {
+	writeError(w, r, message, ErrCodeInternal, http.StatusInternalServerError)
+}
+
+// writeAbortedError writes the response to a request the middleware cut
+// short, because its handler panicked or ran past the API timeout
+func writeAbortedError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, middleware.ErrTimeout) {
+		writeError(w, r, err.Error(), ErrCodeTimeout, http.StatusServiceUnavailable)
+		return
+	}
+	writeInternalError(w, r, err.Error())
 }
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ivankorhner/polling-app/internal/config"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+func TestWriteProblem(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	errs := append(ValidatePollTitle(""), ValidatePollOptions([]string{"A"})...)
+
+	tests := []struct {
+		name   string
+		write  func(w http.ResponseWriter, r *http.Request)
+		status int
+		want   ErrorResponse
+	}{
+		{
+			name:   "not found",
+			write:  func(w http.ResponseWriter, r *http.Request) { writeNotFoundError(w, r, "poll not found") },
+			status: http.StatusNotFound,
+			want: ErrorResponse{
+				Type:   "/problems/not-found",
+				Title:  "Resource not found",
+				Status: http.StatusNotFound,
+				Detail: "poll not found",
+				Code:   ErrCodeNotFound,
+			},
+		},
+		{
+			name:   "invalid fields",
+			write:  func(w http.ResponseWriter, r *http.Request) { writeFieldErrors(w, r, errs) },
+			status: http.StatusBadRequest,
+			want: ErrorResponse{
+				Type:   "/problems/validation-error",
+				Title:  "Request validation failed",
+				Status: http.StatusBadRequest,
+				Detail: "title is required; at least 2 options are required",
+				Code:   ErrCodeValidation,
+				Errors: FieldErrors{
+					{Pointer: "/title", Code: FieldCodeRequired, Detail: "title is required"},
+					{Pointer: "/options", Code: FieldCodeTooFew, Detail: "at least 2 options are required"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := middleware.NewStreaming(logger, writeAbortedError)(http.HandlerFunc(tt.write))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/polls", nil))
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, ProblemContentType, rec.Header().Get("Content-Type"))
+
+			var got ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			reqID := rec.Header().Get(middleware.RequestIDHeader)
+			require.NotEmpty(t, reqID)
+			assert.Equal(t, "urn:uuid:"+reqID, got.Instance)
+
+			got.Instance = ""
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWriteAbortedError(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	middlewares := middleware.NewDefaults(t.Context(), &config.Config{APITimeout: 20 * time.Millisecond}, logger, writeAbortedError)
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		status  int
+		code    string
+		detail  string
+	}{
+		{
+			name:    "panic",
+			handler: func(http.ResponseWriter, *http.Request) { panic("boom") },
+			status:  http.StatusInternalServerError,
+			code:    ErrCodeInternal,
+			detail:  "internal server error",
+		},
+		{
+			name:    "timeout",
+			handler: func(_ http.ResponseWriter, r *http.Request) { <-r.Context().Done() },
+			status:  http.StatusServiceUnavailable,
+			code:    ErrCodeTimeout,
+			detail:  "request took too long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			middlewares(tt.handler).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/polls", nil))
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, ProblemContentType, rec.Header().Get("Content-Type"))
+
+			var got ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.Equal(t, tt.code, got.Code)
+			assert.Equal(t, tt.detail, got.Detail)
+			assert.Equal(t, "urn:uuid:"+rec.Header().Get(middleware.RequestIDHeader), got.Instance)
+		})
+	}
+}
+
+func TestProblemTitles(t *testing.T) {
+	for _, code := range []string{
+		ErrCodeValidation, ErrCodeNotFound, ErrCodeConflict, ErrCodeInternal, ErrCodeBadRequest,
+		ErrCodeUnauthorized, ErrCodeForbidden, ErrCodePollNotOpen, ErrCodePreconditionFailed,
+		ErrCodeIdempotencyKeyReused, ErrCodeTimeout,
+	} {
+		assert.NotEmpty(t, problemTitles[code], code)
+	}
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/participation"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// pollViewer identifies a user looking at a poll. Unauthenticated requests
+// have user ID zero.
+type pollViewer struct {
+	pollID int
+	userID int
+}
+
+// resultsVisibleTo reports whether the principal may see the poll's results
+func resultsVisibleTo(ctx context.Context, client *ent.Client, principal middleware.Principal, p *ent.Poll) (bool, error) {
+	visible, err := visibleResults(ctx, client, []*ent.Poll{p}, []middleware.Principal{principal})
+	if err != nil {
+		return false, err
+	}
+	return visible[pollViewer{pollID: p.ID, userID: principal.UserID}], nil
+}
+
+// visibleResults decides for every poll and principal whether the principal
+// may see the poll's results. Ballots are only looked up for open polls that
+// show results after voting, in a single query per ballot table.
+func visibleResults(ctx context.Context, client *ent.Client, polls []*ent.Poll, principals []middleware.Principal) (map[pollViewer]bool, error) {
+	now := time.Now()
+	visible := make(map[pollViewer]bool)
+	var pollIDs, userIDs []int
+	for _, p := range polls {
+		for _, principal := range principals {
+			switch {
+			case resultsVisible(p, principal, false, now):
+				visible[pollViewer{pollID: p.ID, userID: principal.UserID}] = true
+			case principal.UserID != 0 && p.ResultsVisibility == entpoll.ResultsVisibilityAfterVote:
+				pollIDs = append(pollIDs, p.ID)
+				userIDs = append(userIDs, principal.UserID)
+			}
+		}
+	}
+	if len(pollIDs) == 0 {
+		return visible, nil
+	}
+
+	// Every pair of these polls and users is either waiting on a ballot or
+	// already visible, so matching any pair is safe
+	ballots, err := client.Ballot.Query().
+		Where(ballot.PollIDIn(pollIDs...), ballot.UserIDIn(userIDs...)).
+		Select(ballot.FieldPollID, ballot.FieldUserID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range ballots {
+		visible[pollViewer{pollID: b.PollID, userID: b.UserID}] = true
+	}
+
+	participations, err := client.Participation.Query().
+		Where(participation.PollIDIn(pollIDs...), participation.UserIDIn(userIDs...)).
+		Select(participation.FieldPollID, participation.FieldUserID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range participations {
+		visible[pollViewer{pollID: p.PollID, userID: p.UserID}] = true
+	}
+
+	return visible, nil
+}
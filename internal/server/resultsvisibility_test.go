@@ -0,0 +1,235 @@
+//go:build integration
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// visibilityFixture is an owner, a user who has voted and one who has not,
+// and a poll with one vote per results visibility
+type visibilityFixture struct {
+	owner, voter, other *ent.User
+	polls               map[entpoll.ResultsVisibility]*ent.Poll
+}
+
+func setupVisibilityFixture(ctx context.Context, t *testing.T, testDB *testutil.TestDB, closed bool) visibilityFixture {
+	t.Helper()
+
+	f := visibilityFixture{polls: make(map[entpoll.ResultsVisibility]*ent.Poll)}
+	users := testutil.CreateUsers(ctx, t, testDB.Client, "owner", "voter", "other")
+	f.owner, f.voter, f.other = users[0], users[1], users[2]
+
+	for _, visibility := range []entpoll.ResultsVisibility{
+		entpoll.ResultsVisibilityAlways,
+		entpoll.ResultsVisibilityAfterVote,
+		entpoll.ResultsVisibilityAfterClose,
+		entpoll.ResultsVisibilityOwnerOnly,
+	} {
+		p, options := testutil.CreatePoll(ctx, t, testDB.Client, f.owner.ID, string(visibility), []string{"Yes"}, func(c *ent.PollCreate) {
+			c.SetResultsVisibility(visibility)
+			if closed {
+				c.SetClosedAt(time.Now())
+			}
+		})
+		f.polls[visibility] = p
+		testutil.CastVote(ctx, t, testDB.Client, p.ID, f.voter.ID, options[0].ID)
+	}
+	return f
+}
+
+// request sends a request for the poll as the user, unauthenticated when
+// userID is zero
+func (f visibilityFixture) request(handler http.Handler, method string, p *ent.Poll, userID int, body any) *httptest.ResponseRecorder {
+	req := testutil.NewRequest(method, fmt.Sprintf("/polls/%d", p.ID), userID, body)
+	req.SetPathValue("id", fmt.Sprintf("%d", p.ID))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestResultsVisibility_GetPoll(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	open := setupVisibilityFixture(ctx, t, testDB, false)
+
+	tests := []struct {
+		name       string
+		visibility entpoll.ResultsVisibility
+		userID     int
+		wantHidden bool
+	}{
+		{name: "always unauthenticated", visibility: entpoll.ResultsVisibilityAlways, wantHidden: false},
+		{name: "after vote unauthenticated", visibility: entpoll.ResultsVisibilityAfterVote, wantHidden: true},
+		{name: "after vote without voting", visibility: entpoll.ResultsVisibilityAfterVote, userID: open.other.ID, wantHidden: true},
+		{name: "after vote once voted", visibility: entpoll.ResultsVisibilityAfterVote, userID: open.voter.ID, wantHidden: false},
+		{name: "after close while open", visibility: entpoll.ResultsVisibilityAfterClose, userID: open.voter.ID, wantHidden: true},
+		{name: "after close to the owner", visibility: entpoll.ResultsVisibilityAfterClose, userID: open.owner.ID, wantHidden: false},
+		{name: "owner only to a voter", visibility: entpoll.ResultsVisibilityOwnerOnly, userID: open.voter.ID, wantHidden: true},
+		{name: "owner only to the owner", visibility: entpoll.ResultsVisibilityOwnerOnly, userID: open.owner.ID, wantHidden: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := open.polls[tt.visibility]
+
+			rec := open.request(server.HandleGetPoll(logger, testDB.Client), http.MethodGet, p, tt.userID, nil)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+			var poll server.PollResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+			assert.Equal(t, string(tt.visibility), poll.ResultsVisibility)
+			assert.Equal(t, tt.wantHidden, poll.ResultsHidden)
+			require.Len(t, poll.Options, 1)
+			if tt.wantHidden {
+				assert.Nil(t, poll.Options[0].VoteCount)
+				assert.NotContains(t, rec.Body.String(), "vote_count")
+			} else {
+				require.NotNil(t, poll.Options[0].VoteCount)
+				assert.Equal(t, 1, *poll.Options[0].VoteCount)
+			}
+
+			rec = open.request(server.HandleGetPollResults(logger, testDB.Client), http.MethodGet, p, tt.userID, nil)
+			if tt.wantHidden {
+				require.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, server.ErrCodeForbidden, errResp.Code)
+			} else {
+				require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestResultsVisibility_ClosedPolls(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	closed := setupVisibilityFixture(ctx, t, testDB, true)
+
+	req := httptest.NewRequest(http.MethodGet, "/polls?limit=10", nil)
+	rec := httptest.NewRecorder()
+	server.HandleListPolls(logger, testDB.Client).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var page server.PollListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+	require.Len(t, page.Data, len(closed.polls))
+
+	// Closing reveals every poll's results except owner-only ones
+	for _, poll := range page.Data {
+		hidden := poll.ResultsVisibility == string(entpoll.ResultsVisibilityOwnerOnly)
+		assert.Equal(t, hidden, poll.ResultsHidden, poll.ResultsVisibility)
+		require.Len(t, poll.Options, 1)
+		assert.Equal(t, hidden, poll.Options[0].VoteCount == nil, poll.ResultsVisibility)
+	}
+}
+
+func TestResultsVisibility_Vote(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupVisibilityFixture(ctx, t, testDB, false)
+	bus := events.NewMemory(logger)
+
+	tests := []struct {
+		name       string
+		visibility entpoll.ResultsVisibility
+		wantHidden bool
+	}{
+		{name: "after vote", visibility: entpoll.ResultsVisibilityAfterVote, wantHidden: false},
+		{name: "after close", visibility: entpoll.ResultsVisibilityAfterClose, wantHidden: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := f.polls[tt.visibility]
+			options, err := p.QueryOptions().All(ctx)
+			require.NoError(t, err)
+
+			ballot := server.VoteRequest{OptionID: options[0].ID}
+			rec := f.request(server.HandleVote(logger, testDB.Client, bus), http.MethodPost, p, f.other.ID, ballot)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+			var poll server.PollResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+			assert.Equal(t, tt.wantHidden, poll.ResultsHidden)
+			if !tt.wantHidden {
+				require.NotNil(t, poll.Options[0].VoteCount)
+				assert.Equal(t, 2, *poll.Options[0].VoteCount)
+			}
+
+			// Retracting the ballot hides the results again
+			rec = f.request(server.HandleRetractVote(logger, testDB.Client, bus), http.MethodDelete, p, f.other.ID, nil)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+			assert.True(t, poll.ResultsHidden)
+		})
+	}
+}
+
+func TestResultsVisibility_Update(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	f := setupVisibilityFixture(ctx, t, testDB, false)
+	p := f.polls[entpoll.ResultsVisibilityAlways]
+
+	tests := []struct {
+		name       string
+		visibility string
+		wantStatus int
+	}{
+		{name: "owner only", visibility: "owner_only", wantStatus: http.StatusOK},
+		{name: "unknown", visibility: "never", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := server.UpdatePollRequest{ResultsVisibility: &tt.visibility}
+			rec := f.request(server.HandleUpdatePoll(logger, testDB.Client), http.MethodPatch, p, f.owner.ID, body)
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			// The owner still sees the counts
+			var poll server.PollResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+			assert.Equal(t, tt.visibility, poll.ResultsVisibility)
+			assert.False(t, poll.ResultsHidden)
+		})
+	}
+
+	rec := f.request(server.HandleGetPoll(logger, testDB.Client), http.MethodGet, p, f.voter.ID, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var poll server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+	assert.True(t, poll.ResultsHidden)
+}
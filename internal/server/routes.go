@@ -8,30 +8,127 @@ import (
 
 	"github.com/ivankorhner/polling-app/internal/config"
 	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/events"
 	"github.com/ivankorhner/polling-app/internal/server/middleware"
 )
 
-// AddRoutes configures all HTTP routes for the server
+// AddRoutes configures all HTTP routes for the server. The API is served
+// under /v1, and at the bare paths it was first served at as deprecated
+// aliases until their sunset.
 func AddRoutes(
 	ctx context.Context,
 	config *config.Config,
 	logger *slog.Logger,
 	db *sql.DB,
 	client *ent.Client,
+	bus events.Bus,
 ) http.Handler {
 	mux := http.NewServeMux()
+	broadcaster := NewBroadcaster()
+	broadcaster.Relay(ctx, bus)
+	hub := NewHub(ctx, logger, client, broadcaster)
 
-	middlewares := middleware.NewDefaults(ctx, config, logger)
+	middlewares := middleware.NewDefaults(ctx, config, logger, writeAbortedError)
+	streaming := middleware.NewStreaming(logger, writeAbortedError)
+	authenticate := middleware.Authenticate(
+		resolveAPIToken(logger, client),
+		writeAuthenticationError(logger),
+	)
+	// Retries of these requests with the same Idempotency-Key replay the
+	// first response instead of creating another poll or ballot. Registration
+	// is left out: its response carries the new user's token, which must
+	// neither be stored nor handed to whoever repeats the key.
+	idempotent := middleware.Idempotency(
+		newIdempotencyStore(client, config.IdempotencyTTL),
+		MaxRequestBodySize,
+		writeIdempotencyError(logger),
+	)
 
+	// Health checks probe the server rather than call the API, so they stay
+	// unversioned
 	mux.Handle(http.MethodGet+" /health", HandleHealth(logger, db))
-	mux.Handle(http.MethodGet+" /polls", HandleListPolls(logger, client))
-	mux.Handle(http.MethodGet+" /polls/{id}", HandleGetPoll(logger, client))
-	mux.Handle(http.MethodPost+" /polls", HandleCreatePoll(logger, client))
-	mux.Handle(http.MethodDelete+" /polls/{id}", HandleDeletePoll(logger, client))
-	mux.Handle(http.MethodPost+" /polls/{id}/vote", HandleVote(logger, client))
-	mux.Handle(http.MethodPost+" /users", HandleRegisterUser(logger, client))
+
+	// The API is served under /v1. A /v2 with its own request and response
+	// types would register its handlers the same way, under its own prefix,
+	// while the bare paths keep aliasing /v1 until their sunset.
+	v1 := apiVersion{mux: mux, prefix: "/v1", unversioned: &unversionedDeprecation}
+	v1.Handle(http.MethodGet+" /openapi.json", HandleOpenAPI(logger))
+	v1.Handle(http.MethodGet+" /docs", HandleDocs(logger))
+	v1.Handle(http.MethodGet+" /polls", HandleListPolls(logger, client))
+	v1.Handle(http.MethodGet+" /polls/{id}", HandleGetPoll(logger, client))
+	v1.Handle(http.MethodGet+" /polls/{id}/results", HandleGetPollResults(logger, client))
+	v1.Handle(http.MethodGet+" /polls/{id}/history", HandleGetVoteHistory(logger, client))
+	v1.Handle(http.MethodPost+" /polls", idempotent(HandleCreatePoll(logger, client, bus)))
+	v1.Handle(http.MethodPatch+" /polls/{id}", HandleUpdatePoll(logger, client))
+	v1.Handle(http.MethodDelete+" /polls/{id}", HandleDeletePoll(logger, client, bus))
+	v1.Handle(http.MethodPost+" /polls/{id}/options", HandleAddOption(logger, client))
+	v1.Handle(http.MethodPatch+" /polls/{id}/options/{optionID}", HandleUpdateOption(logger, client))
+	v1.Handle(http.MethodDelete+" /polls/{id}/options/{optionID}", HandleDeleteOption(logger, client))
+	v1.Handle(http.MethodPost+" /polls/{id}/publish", HandlePublishPoll(logger, client, bus))
+	v1.Handle(http.MethodPost+" /polls/{id}/close", HandleClosePoll(logger, client, bus))
+	v1.Handle(http.MethodPost+" /polls/{id}/reopen", HandleReopenPoll(logger, client, bus))
+	v1.Handle(http.MethodPost+" /polls/{id}/vote", idempotent(HandleVote(logger, client, bus)))
+	v1.Handle(http.MethodPut+" /polls/{id}/vote", HandleChangeVote(logger, client, bus))
+	v1.Handle(http.MethodDelete+" /polls/{id}/vote", HandleRetractVote(logger, client, bus))
+	v1.Handle(http.MethodPost+" /polls/{id}/invitees", HandleInviteUser(logger, client))
+	v1.Handle(http.MethodGet+" /polls/{id}/invitees", HandleListInvitees(logger, client))
+	v1.Handle(http.MethodDelete+" /polls/{id}/invitees/{userID}", HandleUninviteUser(logger, client))
+
+	// Unlisted and private polls are also reachable by share slug
+	v1.Handle(http.MethodGet+" /p/{slug}", HandleSharedPoll(logger, client, HandleGetPoll(logger, client)))
+	v1.Handle(http.MethodGet+" /p/{slug}/results", HandleSharedPoll(logger, client, HandleGetPollResults(logger, client)))
+	v1.Handle(http.MethodGet+" /p/{slug}/history", HandleSharedPoll(logger, client, HandleGetVoteHistory(logger, client)))
+	v1.Handle(http.MethodPost+" /p/{slug}/vote", idempotent(HandleSharedPoll(logger, client, HandleVote(logger, client, bus))))
+	v1.Handle(http.MethodPut+" /p/{slug}/vote", HandleSharedPoll(logger, client, HandleChangeVote(logger, client, bus)))
+	v1.Handle(http.MethodDelete+" /p/{slug}/vote", HandleSharedPoll(logger, client, HandleRetractVote(logger, client, bus)))
+
+	v1.Handle(http.MethodPost+" /users", HandleRegisterUser(logger, client))
+	v1.Handle(http.MethodGet+" /users", HandleListUsers(logger, client))
+	v1.Handle(http.MethodGet+" /users/{id}", HandleGetUser(logger, client))
+	v1.Handle(http.MethodPatch+" /users/{id}", HandleUpdateUser(logger, client))
+	v1.Handle(http.MethodDelete+" /users/{id}", HandleDeleteUser(logger, client))
+	v1.Handle(http.MethodGet+" /users/{id}/polls", HandleListUserPolls(logger, client))
+	v1.Handle(http.MethodGet+" /users/{id}/votes", HandleListUserVotes(logger, client))
+	v1.Handle(http.MethodGet+" /me", HandleMe(HandleGetUser(logger, client)))
+	v1.Handle(http.MethodGet+" /me/polls", HandleMe(HandleListUserPolls(logger, client)))
+	v1.Handle(http.MethodGet+" /me/votes", HandleMe(HandleListUserVotes(logger, client)))
+	v1.Handle(http.MethodPost+" /tokens", HandleCreateToken(logger, client))
+	v1.Handle(http.MethodGet+" /tokens", HandleListTokens(logger, client))
+	v1.Handle(http.MethodDelete+" /tokens/{id}", HandleRevokeToken(logger, client))
+	v1.Handle(http.MethodPost+" /webhooks", HandleCreateWebhook(logger, client))
+	v1.Handle(http.MethodGet+" /webhooks", HandleListWebhooks(logger, client))
+	v1.Handle(http.MethodGet+" /webhooks/{id}", HandleGetWebhook(logger, client))
+	v1.Handle(http.MethodDelete+" /webhooks/{id}", HandleDeleteWebhook(logger, client))
+	v1.Handle(http.MethodGet+" /webhooks/{id}/deliveries", HandleListWebhookDeliveries(logger, client))
+	v1.Handle(http.MethodPost+" /orgs", HandleCreateOrganization(logger, client))
+	v1.Handle(http.MethodGet+" /orgs", HandleListOrganizations(logger, client))
+	v1.Handle(http.MethodGet+" /orgs/{id}", HandleGetOrganization(logger, client))
+	v1.Handle(http.MethodDelete+" /orgs/{id}", HandleDeleteOrganization(logger, client))
+	v1.Handle(http.MethodGet+" /orgs/{id}/members", HandleListMembers(logger, client))
+	v1.Handle(http.MethodPatch+" /orgs/{id}/members/{userID}", HandleUpdateMember(logger, client))
+	v1.Handle(http.MethodDelete+" /orgs/{id}/members/{userID}", HandleRemoveMember(logger, client))
+	v1.Handle(http.MethodPost+" /orgs/{id}/invitations", HandleCreateInvitation(logger, client))
+	v1.Handle(http.MethodGet+" /orgs/{id}/invitations", HandleListInvitations(logger, client))
+	v1.Handle(http.MethodDelete+" /orgs/{id}/invitations/{invitationID}", HandleRevokeInvitation(logger, client))
+	v1.Handle(http.MethodGet+" /invitations", HandleListMyInvitations(logger, client))
+	v1.Handle(http.MethodPost+" /invitations/{id}/accept", HandleAcceptInvitation(logger, client))
 
 	mux.Handle("/", http.NotFoundHandler())
 
-	return middlewares(mux)
+	// Event streams and WebSockets run for as long as the client listens, so
+	// they bypass the API timeout that applies to every other route
+	root := http.NewServeMux()
+	v1Streams := apiVersion{mux: root, prefix: v1.prefix, unversioned: v1.unversioned}
+	v1Streams.Handle(
+		http.MethodGet+" /polls/{id}/events",
+		streaming(authenticate(HandlePollEvents(ctx, logger, client, broadcaster, PollEventsHeartbeat))),
+	)
+	v1Streams.Handle(
+		http.MethodGet+" /p/{slug}/events",
+		streaming(authenticate(HandleSharedPoll(logger, client, HandlePollEvents(ctx, logger, client, broadcaster, PollEventsHeartbeat)))),
+	)
+	v1Streams.Handle(http.MethodGet+" /ws", streaming(authenticate(HandleWebSocket(logger, client, bus, hub))))
+	root.Handle("/", middlewares(authenticate(mux)))
+
+	return root
 }
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/apitoken"
+)
+
+// CreateTokenRequest represents the request body for creating an API token
+type CreateTokenRequest struct {
+	Name string `json:"name"`
+}
+
+// TokenResponse represents an API token in responses. The token itself is
+// only returned when it is created.
+type TokenResponse struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// CreatedTokenResponse represents a newly created API token
+type CreatedTokenResponse struct {
+	TokenResponse
+	Token string `json:"token"`
+}
+
+// TokenListResponse represents the API tokens of the authenticated user
+type TokenListResponse struct {
+	Data []TokenResponse `json:"data"`
+}
+
+// HandleCreateToken handles issuing an API token to the authenticated user
+func HandleCreateToken(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "create token: starting")
+
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		var req CreateTokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
+			return
+		}
+
+		if errs := ValidateTokenName(req.Name); errs != nil {
+			writeFieldErrors(w, r, errs)
+			return
+		}
+
+		t, token, err := issueAPIToken(r.Context(), client.APIToken, principal.UserID, strings.TrimSpace(req.Name))
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to create token",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to create token")
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"create token: completed",
+			slog.Int("token_id", t.ID),
+			slog.Int("user_id", principal.UserID),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		response := CreatedTokenResponse{TokenResponse: mapTokenToResponse(t), Token: token}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode token response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleListTokens handles listing the authenticated user's API tokens,
+// including revoked ones
+func HandleListTokens(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list tokens: starting")
+
+		tokens, err := client.APIToken.Query().
+			Where(apitoken.UserID(principal.UserID)).
+			Order(ent.Asc(apitoken.FieldID)).
+			All(r.Context())
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query tokens",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to retrieve tokens")
+			return
+		}
+
+		response := TokenListResponse{Data: make([]TokenResponse, len(tokens))}
+		for i, t := range tokens {
+			response.Data[i] = mapTokenToResponse(t)
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list tokens: completed", slog.Int("count", len(tokens)))
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode tokens response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleRevokeToken handles revoking one of the authenticated user's API
+// tokens. Revoking a token twice is not an error.
+func HandleRevokeToken(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		idStr := r.PathValue("id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			writeValidationError(w, r, "invalid token id")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "revoke token: starting", slog.Int("token_id", id))
+
+		// Tokens of other users are reported as missing rather than forbidden
+		exists, err := client.APIToken.Query().
+			Where(apitoken.ID(id), apitoken.UserID(principal.UserID)).
+			Exist(r.Context())
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to check token", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to revoke token")
+			return
+		}
+		if !exists {
+			writeNotFoundError(w, r, "token not found")
+			return
+		}
+
+		err = client.APIToken.Update().
+			Where(apitoken.ID(id), apitoken.RevokedAtIsNil()).
+			SetRevokedAt(time.Now()).
+			Exec(r.Context())
+		if err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to revoke token", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to revoke token")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "revoke token: completed", slog.Int("token_id", id))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func mapTokenToResponse(t *ent.APIToken) TokenResponse {
+	return TokenResponse{
+		ID:         t.ID,
+		Name:       t.Name,
+		Prefix:     t.Prefix,
+		CreatedAt:  t.CreatedAt,
+		LastUsedAt: t.LastUsedAt,
+		RevokedAt:  t.RevokedAt,
+	}
+}
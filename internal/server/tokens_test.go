@@ -0,0 +1,287 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/config"
+	"github.com/ivankorhner/polling-app/internal/ent/apitoken"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// asUser returns a copy of req authenticated as the given user, as the
+// authentication middleware would leave it
+func asUser(req *http.Request, userID int) *http.Request {
+	return req.WithContext(middleware.WithPrincipal(req.Context(), middleware.Principal{UserID: userID}))
+}
+
+func TestHandleCreateToken(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	user, err := testDB.Client.User.Create().
+		SetUsername("alice").
+		SetEmail("alice@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	req := httptest.NewRequest(http.MethodPost, "/tokens", bytes.NewBufferString(`{"name": "ci"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req = asUser(req, user.ID)
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleCreateToken(logger, testDB.Client)
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var result server.CreatedTokenResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, "ci", result.Name)
+	assert.True(t, strings.HasPrefix(result.Token, result.Prefix))
+	assert.Nil(t, result.LastUsedAt)
+
+	// Only a hash of the token is stored
+	stored, err := testDB.Client.APIToken.Get(ctx, result.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, stored.UserID)
+	assert.NotEqual(t, result.Token, stored.TokenHash)
+	assert.NotContains(t, stored.TokenHash, result.Token)
+}
+
+func TestHandleCreateToken_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		anonymous  bool
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "unauthenticated",
+			body:       `{"name": "ci"}`,
+			anonymous:  true,
+			wantStatus: http.StatusUnauthorized,
+			wantError:  "authentication required",
+		},
+		{
+			name:       "missing name",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "name is required",
+		},
+		{
+			name:       "invalid JSON",
+			body:       `{invalid}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid request body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			req := httptest.NewRequest(http.MethodPost, "/tokens", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if !tt.anonymous {
+				req = asUser(req, 1)
+			}
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleCreateToken(logger, testDB.Client)
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var errResp server.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+			assert.Equal(t, tt.wantError, errResp.Detail)
+		})
+	}
+}
+
+func TestHandleListTokens(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	alice, err := testDB.Client.User.Create().
+		SetUsername("alice").
+		SetEmail("alice@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	bob, err := testDB.Client.User.Create().
+		SetUsername("bob").
+		SetEmail("bob@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	for i, userID := range []int{alice.ID, alice.ID, bob.ID} {
+		_, err := testDB.Client.APIToken.Create().
+			SetUserID(userID).
+			SetName(fmt.Sprintf("token %d", i)).
+			SetTokenHash(fmt.Sprintf("hash%d", i)).
+			SetPrefix("pat_test").
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/tokens", nil)
+	req = asUser(req, alice.ID)
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleListTokens(logger, testDB.Client)
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "hash")
+
+	var result server.TokenListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	require.Len(t, result.Data, 2)
+	assert.Equal(t, "token 0", result.Data[0].Name)
+	assert.Equal(t, "token 1", result.Data[1].Name)
+}
+
+func TestHandleRevokeToken(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	alice, err := testDB.Client.User.Create().
+		SetUsername("alice").
+		SetEmail("alice@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	bob, err := testDB.Client.User.Create().
+		SetUsername("bob").
+		SetEmail("bob@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	token, err := testDB.Client.APIToken.Create().
+		SetUserID(alice.ID).
+		SetName("laptop").
+		SetTokenHash("hash").
+		SetPrefix("pat_test").
+		Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	revoke := func(userID int) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodDelete, fmt.Sprintf("/tokens/%d", token.ID), nil)
+		req.SetPathValue("id", fmt.Sprintf("%d", token.ID))
+		req = asUser(req, userID)
+		rec := httptest.NewRecorder()
+		server.HandleRevokeToken(logger, testDB.Client).ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Other users cannot see the token
+	rec := revoke(bob.ID)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = revoke(alice.ID)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	revoked, err := testDB.Client.APIToken.Get(ctx, token.ID)
+	require.NoError(t, err)
+	require.NotNil(t, revoked.RevokedAt)
+
+	// Revoking again keeps the original revocation time
+	rec = revoke(alice.ID)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	again, err := testDB.Client.APIToken.Get(ctx, token.ID)
+	require.NoError(t, err)
+	assert.True(t, revoked.RevokedAt.Equal(*again.RevokedAt))
+}
+
+func TestBearerAuthentication(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	handler := server.AddRoutes(ctx, &config.Config{APITimeout: time.Minute}, logger, testDB.DB, testDB.Client, events.NewMemory(logger))
+
+	serve := func(method, path, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Registration issues the first token
+	rec := serve(http.MethodPost, "/users", "", `{"username": "alice", "email": "alice@example.com"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var registered server.RegisteredUserResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registered))
+	require.NotEmpty(t, registered.Token)
+
+	// The token creates a poll owned by its user
+	rec = serve(http.MethodPost, "/polls", registered.Token, `{"title": "Lunch?", "options": ["Soup", "Salad"]}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var poll server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &poll))
+
+	stored, err := testDB.Client.Poll.Get(ctx, poll.ID)
+	require.NoError(t, err)
+	assert.Equal(t, registered.ID, stored.OwnerID)
+
+	used, err := testDB.Client.APIToken.Query().Where(apitoken.UserID(registered.ID)).Only(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, used.LastUsedAt)
+
+	// Requests without a token are rejected by handlers that need one
+	rec = serve(http.MethodPost, "/polls", "", `{"title": "Lunch?", "options": ["Soup", "Salad"]}`)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+
+	// Unknown tokens are rejected even on public routes
+	rec = serve(http.MethodGet, "/polls", "pat_unknown", "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	var errResp server.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+	assert.Equal(t, server.ErrCodeUnauthorized, errResp.Code)
+
+	// Revoked tokens stop working
+	rec = serve(http.MethodDelete, fmt.Sprintf("/tokens/%d", used.ID), registered.Token, "")
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = serve(http.MethodGet, "/tokens", registered.Token, "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
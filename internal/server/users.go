@@ -1,7 +1,9 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -16,15 +18,27 @@ type RegisterUserRequest struct {
 	Email    string `json:"email"`
 }
 
-// UserResponse represents the response for user operations
+// UserResponse represents the response for user operations. The email is
+// only shown to the user themselves and to admins.
 type UserResponse struct {
 	ID        int       `json:"id"`
 	Username  string    `json:"username"`
-	Email     string    `json:"email"`
+	Email     string    `json:"email,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
-// HandleRegisterUser handles user registration
+// RegisteredUserResponse represents a newly registered user along with the
+// API token issued to authenticate as them
+type RegisteredUserResponse struct {
+	UserResponse
+	Token string `json:"token"`
+}
+
+// initialTokenName names the API token issued at registration
+const initialTokenName = "default"
+
+// HandleRegisterUser handles user registration. The response carries the
+// user's first API token, which is not shown again.
 func HandleRegisterUser(logger *slog.Logger, client *ent.Client) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.LogAttrs(r.Context(), slog.LevelInfo, "register user: starting")
@@ -34,19 +48,14 @@ func HandleRegisterUser(logger *slog.Logger, client *ent.Client) http.Handler {
 
 		var req RegisterUserRequest
 		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-			writeValidationError(w, "invalid request body")
-			return
-		}
-
-		// Validate username
-		if errMsg := ValidateUsername(req.Username); errMsg != "" {
-			writeValidationError(w, errMsg)
+			writeValidationError(w, r, "invalid request body")
 			return
 		}
 
-		// Validate email
-		if errMsg := ValidateEmail(req.Email); errMsg != "" {
-			writeValidationError(w, errMsg)
+		// Validate username and email
+		errs := append(ValidateUsername(req.Username), ValidateEmail(req.Email)...)
+		if errs != nil {
+			writeFieldErrors(w, r, errs)
 			return
 		}
 
@@ -54,13 +63,10 @@ func HandleRegisterUser(logger *slog.Logger, client *ent.Client) http.Handler {
 		req.Username = strings.TrimSpace(req.Username)
 		req.Email = strings.TrimSpace(strings.ToLower(req.Email))
 
-		user, err := client.User.Create().
-			SetUsername(req.Username).
-			SetEmail(req.Email).
-			Save(r.Context())
+		user, token, err := createUserWithToken(r.Context(), client, req)
 		if err != nil {
 			if ent.IsConstraintError(err) {
-				writeConflictError(w, "username or email already exists")
+				writeConflictError(w, r, "username or email already exists")
 				return
 			}
 			logger.LogAttrs(
@@ -69,7 +75,7 @@ func HandleRegisterUser(logger *slog.Logger, client *ent.Client) http.Handler {
 				"failed to create user",
 				slog.String("error", err.Error()),
 			)
-			writeInternalError(w, "failed to create user")
+			writeInternalError(w, r, "failed to create user")
 			return
 		}
 
@@ -83,7 +89,8 @@ func HandleRegisterUser(logger *slog.Logger, client *ent.Client) http.Handler {
 
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusCreated)
-		if err := json.NewEncoder(w).Encode(mapUserToResponse(user)); err != nil {
+		response := RegisteredUserResponse{UserResponse: mapUserToResponse(user), Token: token}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
 			logger.LogAttrs(
 				r.Context(),
 				slog.LevelError,
@@ -94,6 +101,32 @@ func HandleRegisterUser(logger *slog.Logger, client *ent.Client) http.Handler {
 	})
 }
 
+func createUserWithToken(ctx context.Context, client *ent.Client, req RegisterUserRequest) (*ent.User, string, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	user, err := tx.User.Create().
+		SetUsername(req.Username).
+		SetEmail(req.Email).
+		Save(ctx)
+	if err != nil {
+		return nil, "", errors.Join(err, tx.Rollback())
+	}
+
+	_, token, err := issueAPIToken(ctx, tx.APIToken, user.ID, initialTokenName)
+	if err != nil {
+		return nil, "", errors.Join(err, tx.Rollback())
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", err
+	}
+
+	return user, token, nil
+}
+
 func mapUserToResponse(u *ent.User) UserResponse {
 	return UserResponse{
 		ID:        u.ID,
@@ -36,7 +36,7 @@ func TestHandleRegisterUser_Success(t *testing.T) {
 	assert.Equal(t, http.StatusCreated, rec.Code)
 	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
 
-	var result server.UserResponse
+	var result server.RegisteredUserResponse
 	err := json.Unmarshal(rec.Body.Bytes(), &result)
 	require.NoError(t, err)
 
@@ -44,6 +44,14 @@ func TestHandleRegisterUser_Success(t *testing.T) {
 	assert.Equal(t, "test@example.com", result.Email)
 	assert.NotZero(t, result.ID)
 	assert.NotZero(t, result.CreatedAt)
+	assert.NotEmpty(t, result.Token)
+
+	// The initial token is stored hashed
+	tokens, err := testDB.Client.APIToken.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, result.ID, tokens[0].UserID)
+	assert.NotEqual(t, result.Token, tokens[0].TokenHash)
 }
 
 func TestHandleRegisterUser_Validation(t *testing.T) {
@@ -117,7 +125,7 @@ func TestHandleRegisterUser_Validation(t *testing.T) {
 			var errResp server.ErrorResponse
 			err := json.Unmarshal(rec.Body.Bytes(), &errResp)
 			require.NoError(t, err)
-			assert.Equal(t, tt.wantError, errResp.Error)
+			assert.Equal(t, tt.wantError, errResp.Detail)
 		})
 	}
 }
@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/participation"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/predicate"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// UserPollResponse represents a poll in a user's activity together with the
+// choice the user made on it. Choice is null when the user has not voted,
+// when the poll is anonymous, and for others when the poll's results are
+// hidden from them.
+type UserPollResponse struct {
+	PollResponse
+	Choice *ChoiceResponse `json:"choice"`
+}
+
+// ChoiceResponse represents the options a user chose on a poll. Ranked
+// ballots list the options in order of preference and score ballots also
+// carry the scores given.
+type ChoiceResponse struct {
+	OptionIDs []int       `json:"option_ids"`
+	Scores    map[int]int `json:"scores,omitempty"`
+	VotedAt   time.Time   `json:"voted_at"`
+}
+
+// UserPollListResponse represents a page of a user's polls. NextCursor is
+// null on the last page.
+type UserPollListResponse struct {
+	Data       []UserPollResponse `json:"data"`
+	NextCursor *string            `json:"next_cursor"`
+}
+
+// HandleMe serves next for the authenticated user, as if their ID had been
+// given in the path
+func HandleMe(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		r.SetPathValue("id", strconv.Itoa(principal.UserID))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// HandleListUserPolls handles listing the polls a user owns, newest first.
+// Only the polls the requester may see are listed.
+func HandleListUserPolls(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		u, ok := loadPathUser(logger, client, w, r, "retrieve polls")
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list user polls: starting", slog.Int("user_id", u.ID))
+
+		writeUserPolls(logger, client, w, r, principal, u, entpoll.OwnerID(u.ID))
+	})
+}
+
+// HandleListUserVotes handles listing the polls a user has voted in, newest
+// first. Only the polls the requester may see are listed, and anonymous polls
+// only to the user themselves, since no one else may learn who voted in them.
+func HandleListUserVotes(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		u, ok := loadPathUser(logger, client, w, r, "retrieve votes")
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list user votes: starting", slog.Int("user_id", u.ID))
+
+		voted := entpoll.HasBallotsWith(ballot.UserID(u.ID))
+		if principal.UserID == u.ID {
+			voted = entpoll.Or(voted, entpoll.HasParticipationsWith(participation.UserID(u.ID)))
+		}
+
+		writeUserPolls(logger, client, w, r, principal, u, voted)
+	})
+}
+
+// writeUserPolls writes a page of the polls matching where that the principal
+// may see, newest first, with the user's choice on each
+func writeUserPolls(
+	logger *slog.Logger,
+	client *ent.Client,
+	w http.ResponseWriter,
+	r *http.Request,
+	principal middleware.Principal,
+	u *ent.User,
+	where predicate.Poll,
+) {
+	page, errMsg := parsePageQuery(r.URL.Query())
+	if errMsg != "" {
+		writeValidationError(w, r, errMsg)
+		return
+	}
+
+	q := queryViewablePolls(r.Context(), client, principal).Where(where).WithOptions()
+	if page.Cursor != 0 {
+		q.Where(entpoll.IDLT(page.Cursor))
+	}
+
+	// Fetch one extra poll to learn whether another page follows
+	polls, err := q.Order(ent.Desc(entpoll.FieldID)).Limit(page.Limit + 1).All(r.Context())
+	if err != nil {
+		logger.LogAttrs(r.Context(), slog.LevelError, "failed to query polls", slog.String("error", err.Error()))
+		writeInternalError(w, r, "failed to retrieve polls")
+		return
+	}
+
+	var nextCursor *string
+	if len(polls) > page.Limit {
+		polls = polls[:page.Limit]
+		encoded := encodeIDCursor(polls[len(polls)-1].ID)
+		nextCursor = &encoded
+	}
+
+	data, err := mapPollsToViewerResponse(r.Context(), client, polls, principal)
+	if err != nil {
+		logger.LogAttrs(r.Context(), slog.LevelError, "failed to load poll results", slog.String("error", err.Error()))
+		writeInternalError(w, r, "failed to retrieve polls")
+		return
+	}
+
+	choices, err := userChoices(r.Context(), client, polls, u.ID)
+	if err != nil {
+		logger.LogAttrs(r.Context(), slog.LevelError, "failed to query ballots", slog.String("error", err.Error()))
+		writeInternalError(w, r, "failed to retrieve polls")
+		return
+	}
+
+	response := UserPollListResponse{
+		Data:       make([]UserPollResponse, len(polls)),
+		NextCursor: nextCursor,
+	}
+	for i, p := range polls {
+		response.Data[i] = UserPollResponse{PollResponse: data[i]}
+		// Others may only see the choice where they may see the results
+		if principal.UserID == u.ID || !data[i].ResultsHidden {
+			response.Data[i].Choice = choices[p.ID]
+		}
+	}
+
+	logger.LogAttrs(
+		r.Context(),
+		slog.LevelInfo,
+		"list user polls: completed",
+		slog.Int("user_id", u.ID),
+		slog.Int("count", len(polls)),
+		slog.Bool("has_more", nextCursor != nil),
+	)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelError,
+			"failed to encode polls response",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// userChoices returns the user's choice on each of the polls they voted in,
+// keyed by poll ID. Ballots on anonymous polls cannot be traced back to the
+// user, so those polls have none.
+func userChoices(ctx context.Context, client *ent.Client, polls []*ent.Poll, userID int) (map[int]*ChoiceResponse, error) {
+	pollIDs := make([]int, len(polls))
+	for i, p := range polls {
+		pollIDs[i] = p.ID
+	}
+
+	ballots, err := client.Ballot.Query().
+		Where(ballot.PollIDIn(pollIDs...), ballot.UserID(userID)).
+		WithVotes().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	choices := make(map[int]*ChoiceResponse, len(ballots))
+	for _, b := range ballots {
+		votes := b.Edges.Votes
+		// Ranked votes in order of preference, others in option order
+		slices.SortFunc(votes, func(a, b *ent.Vote) int {
+			if a.Rank != nil && b.Rank != nil {
+				return *a.Rank - *b.Rank
+			}
+			return a.OptionID - b.OptionID
+		})
+
+		choice := &ChoiceResponse{
+			OptionIDs: make([]int, len(votes)),
+			VotedAt:   b.CreatedAt,
+		}
+		for i, v := range votes {
+			choice.OptionIDs[i] = v.OptionID
+			if v.Score != nil {
+				if choice.Scores == nil {
+					choice.Scores = make(map[int]int, len(votes))
+				}
+				choice.Scores[v.OptionID] = *v.Score
+			}
+		}
+		choices[b.PollID] = choice
+	}
+	return choices, nil
+}
@@ -0,0 +1,248 @@
+//go:build integration
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// activityFixture holds an owner's polls and a voter's ballots on them
+type activityFixture struct {
+	owner, voter, other int
+	public              *ent.Poll
+	publicChoice        int
+	anonymous           *ent.Poll
+	private             *ent.Poll
+	ownerOnly           *ent.Poll
+}
+
+func setupActivity(ctx context.Context, t *testing.T, testDB *testutil.TestDB) activityFixture {
+	t.Helper()
+	client := testDB.Client
+
+	users := testutil.CreateUsers(ctx, t, client, "owner", "voter", "other")
+	f := activityFixture{
+		owner: users[0].ID,
+		voter: users[1].ID,
+		other: users[2].ID,
+	}
+
+	newPoll := func(title string, set func(*ent.PollCreate)) (*ent.Poll, []*ent.PollOption) {
+		return testutil.CreatePoll(ctx, t, client, f.owner, title, []string{"A", "B"}, set)
+	}
+	vote := func(p *ent.Poll, optionID int) {
+		testutil.CastVote(ctx, t, client, p.ID, f.voter, optionID)
+	}
+
+	var options []*ent.PollOption
+	f.public, options = newPoll("Public", nil)
+	f.publicChoice = options[1].ID
+	vote(f.public, f.publicChoice)
+
+	f.anonymous, _ = newPoll("Anonymous", func(c *ent.PollCreate) { c.SetAnonymous(true) })
+	_, err := client.Participation.Create().SetPollID(f.anonymous.ID).SetUserID(f.voter).Save(ctx)
+	require.NoError(t, err)
+
+	f.private, _ = newPoll("Private", func(c *ent.PollCreate) { c.SetVisibility(entpoll.VisibilityPrivate) })
+
+	f.ownerOnly, options = newPoll("Owner only", func(c *ent.PollCreate) {
+		c.SetResultsVisibility(entpoll.ResultsVisibilityOwnerOnly)
+	})
+	vote(f.ownerOnly, options[0].ID)
+
+	return f
+}
+
+// listActivity calls handler for the user ID as viewer and decodes the page
+func listActivity(t *testing.T, handler http.Handler, path string, userID, viewerID int) server.UserPollListResponse {
+	t.Helper()
+	pathID := fmt.Sprint(userID)
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf(path, pathID), nil)
+	req.SetPathValue("id", pathID)
+	req = asUser(req, viewerID)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var result server.UserPollListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	return result
+}
+
+// activityPollIDs returns the IDs of the listed polls in order
+func activityPollIDs(polls []server.UserPollResponse) []int {
+	ids := make([]int, len(polls))
+	for i, p := range polls {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func TestHandleListUserVotes(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	f := setupActivity(ctx, t, testDB)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	handler := server.HandleListUserVotes(logger, testDB.Client)
+
+	t.Run("own votes include anonymous polls without the choice", func(t *testing.T) {
+		result := listActivity(t, handler, "/users/%s/votes", f.voter, f.voter)
+
+		require.Equal(t, []int{f.ownerOnly.ID, f.anonymous.ID, f.public.ID}, activityPollIDs(result.Data))
+		assert.Nil(t, result.NextCursor)
+
+		require.NotNil(t, result.Data[0].Choice)
+		assert.Nil(t, result.Data[1].Choice)
+		require.NotNil(t, result.Data[2].Choice)
+		assert.Equal(t, []int{f.publicChoice}, result.Data[2].Choice.OptionIDs)
+	})
+
+	t.Run("others do not see anonymous polls or hidden choices", func(t *testing.T) {
+		result := listActivity(t, handler, "/users/%s/votes", f.voter, f.other)
+
+		require.Equal(t, []int{f.ownerOnly.ID, f.public.ID}, activityPollIDs(result.Data))
+		assert.Nil(t, result.Data[0].Choice)
+		assert.True(t, result.Data[0].ResultsHidden)
+		require.NotNil(t, result.Data[1].Choice)
+		assert.Equal(t, []int{f.publicChoice}, result.Data[1].Choice.OptionIDs)
+	})
+
+	t.Run("poll owner sees the choice on their owner only poll", func(t *testing.T) {
+		result := listActivity(t, handler, "/users/%s/votes", f.voter, f.owner)
+
+		require.Equal(t, []int{f.ownerOnly.ID, f.public.ID}, activityPollIDs(result.Data))
+		assert.NotNil(t, result.Data[0].Choice)
+	})
+
+	t.Run("me", func(t *testing.T) {
+		result := listActivity(t, server.HandleMe(handler), "/me/votes", 0, f.voter)
+
+		assert.Equal(t, []int{f.ownerOnly.ID, f.anonymous.ID, f.public.ID}, activityPollIDs(result.Data))
+	})
+}
+
+func TestHandleListUserPolls(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	f := setupActivity(ctx, t, testDB)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	handler := server.HandleListUserPolls(logger, testDB.Client)
+
+	t.Run("owner sees every poll", func(t *testing.T) {
+		result := listActivity(t, handler, "/users/%s/polls", f.owner, f.owner)
+
+		assert.Equal(t, []int{f.ownerOnly.ID, f.private.ID, f.anonymous.ID, f.public.ID}, activityPollIDs(result.Data))
+		for _, p := range result.Data {
+			assert.Nil(t, p.Choice)
+		}
+	})
+
+	t.Run("others do not see private polls", func(t *testing.T) {
+		result := listActivity(t, handler, "/users/%s/polls", f.owner, f.other)
+
+		assert.Equal(t, []int{f.ownerOnly.ID, f.anonymous.ID, f.public.ID}, activityPollIDs(result.Data))
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		var seen []int
+		path := "/users/%s/polls?limit=3"
+		for {
+			result := listActivity(t, handler, path, f.owner, f.owner)
+			seen = append(seen, activityPollIDs(result.Data)...)
+			if result.NextCursor == nil {
+				break
+			}
+			path = "/users/%s/polls?limit=3&cursor=" + *result.NextCursor
+		}
+		assert.Equal(t, []int{f.ownerOnly.ID, f.private.ID, f.anonymous.ID, f.public.ID}, seen)
+	})
+}
+
+func TestHandleListUserPolls_Validation(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	u, err := testDB.Client.User.Create().SetUsername("alice").SetEmail("alice@example.com").Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	tests := []struct {
+		name       string
+		handler    http.Handler
+		pathID     string
+		query      string
+		anonymous  bool
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "unauthenticated",
+			handler:    server.HandleListUserPolls(logger, testDB.Client),
+			pathID:     fmt.Sprint(u.ID),
+			anonymous:  true,
+			wantStatus: http.StatusUnauthorized,
+			wantError:  "authentication required",
+		},
+		{
+			name:       "unauthenticated me",
+			handler:    server.HandleMe(server.HandleListUserVotes(logger, testDB.Client)),
+			anonymous:  true,
+			wantStatus: http.StatusUnauthorized,
+			wantError:  "authentication required",
+		},
+		{
+			name:       "missing user",
+			handler:    server.HandleListUserVotes(logger, testDB.Client),
+			pathID:     "99999",
+			wantStatus: http.StatusNotFound,
+			wantError:  "user not found",
+		},
+		{
+			name:       "invalid cursor",
+			handler:    server.HandleListUserPolls(logger, testDB.Client),
+			pathID:     fmt.Sprint(u.ID),
+			query:      "?cursor=not-a-cursor",
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid cursor",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tt.pathID+"/polls"+tt.query, nil)
+			req.SetPathValue("id", tt.pathID)
+			if !tt.anonymous {
+				req = asUser(req, u.ID)
+			}
+			rec := httptest.NewRecorder()
+
+			tt.handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			var errResp server.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+			assert.Equal(t, tt.wantError, errResp.Detail)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/apitoken"
+	"github.com/ivankorhner/polling-app/internal/ent/idempotencykey"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	"github.com/ivankorhner/polling-app/internal/ent/organization"
+	"github.com/ivankorhner/polling-app/internal/ent/pollinvitee"
+	"github.com/ivankorhner/polling-app/internal/ent/user"
+	"github.com/ivankorhner/polling-app/internal/ent/webhook"
+)
+
+// HandleDeleteUser handles deleting a user's account by the user or an
+// admin. The account is anonymized rather than removed, so the user's
+// ballots keep counting and their polls stay up: their name and email are
+// replaced, their API tokens, webhooks, memberships, poll invitations and
+// recorded idempotent responses are removed, and they no longer appear in
+// user listings. The last owner of an organization must hand it over first.
+func HandleDeleteUser(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		u, ok := loadPathUser(logger, client, w, r, "delete user")
+		if !ok {
+			return
+		}
+		if !canManageUser(principal, u) {
+			writeForbiddenError(w, r, "only the user or an admin can delete this user")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "delete user: starting", slog.Int("user_id", u.ID))
+
+		if err := anonymizeUser(r.Context(), client, u.ID); err != nil {
+			if errors.Is(err, errLastOwner) {
+				writeConflictError(w, r, errLastOwner.Error())
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to delete user", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to delete user")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "delete user: completed", slog.Int("user_id", u.ID))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// errLastOwner is returned when deleting a user would leave an organization
+// without an owner
+var errLastOwner = errors.New("user is the last owner of an organization; transfer ownership first")
+
+// anonymizeUser strips a user's account down to the row their ballots,
+// votes and polls refer to. The placeholder name and email fail validation,
+// so no one can register or rename themselves into them. It returns
+// errLastOwner, changing nothing, when the user is an organization's only
+// owner.
+func anonymizeUser(ctx context.Context, client *ent.Client, userID int) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Lock the owner memberships of the user's organizations, so co-owners
+	// deleting their accounts at once cannot both see the other as the
+	// remaining owner
+	ownedOrgs := organization.HasMembershipsWith(membership.UserID(userID), membership.RoleEQ(membership.RoleOwner))
+	owners, err := tx.Membership.Query().
+		Where(membership.RoleEQ(membership.RoleOwner), membership.HasOrganizationWith(ownedOrgs)).
+		ForUpdate().
+		All(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	others := make(map[int]bool)
+	for _, m := range owners {
+		if m.UserID != userID {
+			others[m.OrganizationID] = true
+		}
+	}
+	for _, m := range owners {
+		if m.UserID == userID && !others[m.OrganizationID] {
+			return errors.Join(errLastOwner, tx.Rollback())
+		}
+	}
+
+	err = tx.User.UpdateOneID(userID).
+		SetUsername(fmt.Sprintf("deleted-user#%d", userID)).
+		SetEmail(fmt.Sprintf("deleted-user-%d@invalid", userID)).
+		SetRole(user.RoleUser).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if _, err := tx.APIToken.Delete().Where(apitoken.UserID(userID)).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if _, err := tx.Webhook.Delete().Where(webhook.UserID(userID)).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if _, err := tx.Membership.Delete().Where(membership.UserID(userID)).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if _, err := tx.IdempotencyKey.Delete().Where(idempotencykey.UserID(userID)).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	if _, err := tx.PollInvitee.Delete().Where(pollinvitee.UserID(userID)).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,236 @@
+//go:build integration
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/ent/apitoken"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleDeleteUser_KeepsVotes(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	owner, err := testDB.Client.User.Create().SetUsername("owner").SetEmail("owner@example.com").Save(ctx)
+	require.NoError(t, err)
+	voter, err := testDB.Client.User.Create().SetUsername("voter").SetEmail("voter@example.com").Save(ctx)
+	require.NoError(t, err)
+
+	poll, err := testDB.Client.Poll.Create().SetOwnerID(owner.ID).SetTitle("Lunch").Save(ctx)
+	require.NoError(t, err)
+	option, err := testDB.Client.PollOption.Create().SetPollID(poll.ID).SetText("Pizza").Save(ctx)
+	require.NoError(t, err)
+	b, err := testDB.Client.Ballot.Create().SetPollID(poll.ID).SetUserID(voter.ID).Save(ctx)
+	require.NoError(t, err)
+	_, err = testDB.Client.Vote.Create().
+		SetBallotID(b.ID).
+		SetPollID(poll.ID).
+		SetOptionID(option.ID).
+		SetUserID(voter.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = testDB.Client.APIToken.Create().
+		SetUserID(voter.ID).
+		SetName("cli").
+		SetPrefix("pk_test").
+		SetTokenHash("hash").
+		Save(ctx)
+	require.NoError(t, err)
+	org, err := testDB.Client.Organization.Create().SetName("Acme").Save(ctx)
+	require.NoError(t, err)
+	_, err = testDB.Client.Membership.Create().SetOrganizationID(org.ID).SetUserID(owner.ID).SetRole(membership.RoleOwner).Save(ctx)
+	require.NoError(t, err)
+	_, err = testDB.Client.Membership.Create().SetOrganizationID(org.ID).SetUserID(voter.ID).Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	pathID := fmt.Sprint(voter.ID)
+	req := httptest.NewRequest(http.MethodDelete, "/users/"+pathID, nil)
+	req.SetPathValue("id", pathID)
+	req = asUser(req, voter.ID)
+	rec := httptest.NewRecorder()
+
+	server.HandleDeleteUser(logger, testDB.Client).ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+	// The account is anonymized, not removed
+	stored, err := testDB.Client.User.Get(ctx, voter.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored.DeletedAt)
+	assert.NotContains(t, stored.Username, "voter")
+	assert.NotContains(t, stored.Email, "voter")
+
+	// The ballot still counts
+	ballots, err := testDB.Client.Ballot.Query().Where(ballot.PollID(poll.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ballots)
+	votes, err := testDB.Client.Vote.Query().Where(vote.OptionID(option.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, votes)
+
+	// Credentials and memberships are gone
+	tokens, err := testDB.Client.APIToken.Query().Where(apitoken.UserID(voter.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, tokens)
+	memberships, err := testDB.Client.Membership.Query().Where(membership.UserID(voter.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, memberships)
+
+	// The user can no longer be looked up
+	req = httptest.NewRequest(http.MethodGet, "/users/"+pathID, nil)
+	req.SetPathValue("id", pathID)
+	req = asUser(req, owner.ID)
+	rec = httptest.NewRecorder()
+
+	server.HandleGetUser(logger, testDB.Client).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// The username is free to register again
+	_, err = testDB.Client.User.Create().SetUsername("voter").SetEmail("voter@example.com").Save(ctx)
+	assert.NoError(t, err)
+}
+
+func TestHandleDeleteUser_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  func(userID, otherID int) *middleware.Principal
+		soleOwner  bool
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "admin deletes another user",
+			principal:  func(_, otherID int) *middleware.Principal { return &middleware.Principal{UserID: otherID, Admin: true} },
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "other user",
+			principal:  func(_, otherID int) *middleware.Principal { return &middleware.Principal{UserID: otherID} },
+			wantStatus: http.StatusForbidden,
+			wantError:  "only the user or an admin can delete this user",
+		},
+		{
+			name:       "last owner of an organization",
+			principal:  func(userID, _ int) *middleware.Principal { return &middleware.Principal{UserID: userID} },
+			soleOwner:  true,
+			wantStatus: http.StatusConflict,
+			wantError:  "user is the last owner of an organization; transfer ownership first",
+		},
+		{
+			name:       "unauthenticated",
+			principal:  func(_, _ int) *middleware.Principal { return nil },
+			wantStatus: http.StatusUnauthorized,
+			wantError:  "authentication required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			u, err := testDB.Client.User.Create().SetUsername("alice").SetEmail("alice@example.com").Save(ctx)
+			require.NoError(t, err)
+			other, err := testDB.Client.User.Create().SetUsername("bob").SetEmail("bob@example.com").Save(ctx)
+			require.NoError(t, err)
+
+			// Another owner lets the user leave
+			org, err := testDB.Client.Organization.Create().SetName("Acme").Save(ctx)
+			require.NoError(t, err)
+			_, err = testDB.Client.Membership.Create().SetOrganizationID(org.ID).SetUserID(u.ID).SetRole(membership.RoleOwner).Save(ctx)
+			require.NoError(t, err)
+			otherRole := membership.RoleOwner
+			if tt.soleOwner {
+				otherRole = membership.RoleAdmin
+			}
+			_, err = testDB.Client.Membership.Create().SetOrganizationID(org.ID).SetUserID(other.ID).SetRole(otherRole).Save(ctx)
+			require.NoError(t, err)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			pathID := fmt.Sprint(u.ID)
+			req := httptest.NewRequest(http.MethodDelete, "/users/"+pathID, nil)
+			req.SetPathValue("id", pathID)
+			if p := tt.principal(u.ID, other.ID); p != nil {
+				req = req.WithContext(middleware.WithPrincipal(req.Context(), *p))
+			}
+			rec := httptest.NewRecorder()
+
+			server.HandleDeleteUser(logger, testDB.Client).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantError != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantError, errResp.Detail)
+
+				stored, err := testDB.Client.User.Get(ctx, u.ID)
+				require.NoError(t, err)
+				assert.Nil(t, stored.DeletedAt)
+			}
+		})
+	}
+}
+
+func TestHandleDeleteUser_ConcurrentOwners(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	// Two owners deleting their accounts at once cannot both leave
+	owners := testutil.CreateUsers(ctx, t, testDB.Client, "alice", "bob")
+	org, err := testDB.Client.Organization.Create().SetName("Acme").Save(ctx)
+	require.NoError(t, err)
+	for _, u := range owners {
+		_, err = testDB.Client.Membership.Create().SetOrganizationID(org.ID).SetUserID(u.ID).SetRole(membership.RoleOwner).Save(ctx)
+		require.NoError(t, err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	handler := server.HandleDeleteUser(logger, testDB.Client)
+
+	statuses := make([]int, len(owners))
+	var wg sync.WaitGroup
+	for i, u := range owners {
+		wg.Go(func() {
+			pathID := fmt.Sprint(u.ID)
+			req := httptest.NewRequest(http.MethodDelete, "/users/"+pathID, nil)
+			req.SetPathValue("id", pathID)
+			req = asUser(req, u.ID)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			statuses[i] = rec.Code
+		})
+	}
+	wg.Wait()
+
+	assert.ElementsMatch(t, []int{http.StatusNoContent, http.StatusConflict}, statuses)
+	remaining, err := testDB.Client.Membership.Query().
+		Where(membership.OrganizationID(org.ID), membership.RoleEQ(membership.RoleOwner)).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, remaining)
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/user"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// UserListResponse represents a page of users. NextCursor is null on the
+// last page.
+type UserListResponse struct {
+	Data       []UserResponse `json:"data"`
+	NextCursor *string        `json:"next_cursor"`
+}
+
+// HandleGetUser handles retrieving a user's profile
+func HandleGetUser(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		u, ok := loadPathUser(logger, client, w, r, "retrieve user")
+		if !ok {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(mapUserToViewerResponse(u, principal)); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode user response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleListUsers handles listing users a page at a time, in the order they
+// registered. Deleted users are left out.
+func HandleListUsers(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list users: starting")
+
+		page, errMsg := parsePageQuery(r.URL.Query())
+		if errMsg != "" {
+			writeValidationError(w, r, errMsg)
+			return
+		}
+
+		// Fetch one extra user to learn whether another page follows
+		users, err := client.User.Query().
+			Where(user.DeletedAtIsNil(), user.IDGT(page.Cursor)).
+			Order(ent.Asc(user.FieldID)).
+			Limit(page.Limit + 1).
+			All(r.Context())
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query users",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to retrieve users")
+			return
+		}
+
+		var nextCursor *string
+		if len(users) > page.Limit {
+			users = users[:page.Limit]
+			encoded := encodeIDCursor(users[len(users)-1].ID)
+			nextCursor = &encoded
+		}
+
+		response := UserListResponse{
+			Data:       make([]UserResponse, len(users)),
+			NextCursor: nextCursor,
+		}
+		for i, u := range users {
+			response.Data[i] = mapUserToViewerResponse(u, principal)
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"list users: completed",
+			slog.Int("count", len(users)),
+			slog.Bool("has_more", nextCursor != nil),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode users response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// loadPathUser loads the user named in the request path. Deleted users are
+// reported as missing. It writes an error response and returns false when
+// there is no such user.
+func loadPathUser(logger *slog.Logger, client *ent.Client, w http.ResponseWriter, r *http.Request, action string) (*ent.User, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeValidationError(w, r, "invalid user id")
+		return nil, false
+	}
+
+	u, err := client.User.Query().
+		Where(user.ID(id), user.DeletedAtIsNil()).
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeNotFoundError(w, r, "user not found")
+			return nil, false
+		}
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelError,
+			"failed to query user",
+			slog.String("error", err.Error()),
+			slog.Int("user_id", id),
+		)
+		writeInternalError(w, r, "failed to "+action)
+		return nil, false
+	}
+	return u, true
+}
+
+// mapUserToViewerResponse maps the user as the principal may see them,
+// leaving the email out for anyone but the user and admins
+func mapUserToViewerResponse(u *ent.User, principal middleware.Principal) UserResponse {
+	response := mapUserToResponse(u)
+	if !canManageUser(principal, u) {
+		response.Email = ""
+	}
+	return response
+}
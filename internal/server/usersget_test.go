@@ -0,0 +1,209 @@
+//go:build integration
+
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleGetUser(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	alice, err := testDB.Client.User.Create().SetUsername("alice").SetEmail("alice@example.com").Save(ctx)
+	require.NoError(t, err)
+	bob, err := testDB.Client.User.Create().SetUsername("bob").SetEmail("bob@example.com").Save(ctx)
+	require.NoError(t, err)
+	gone, err := testDB.Client.User.Create().
+		SetUsername("gone").
+		SetEmail("gone@example.com").
+		SetDeletedAt(time.Now()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	tests := []struct {
+		name       string
+		principal  *middleware.Principal
+		pathID     string
+		wantStatus int
+		wantError  string
+		wantEmail  string
+	}{
+		{
+			name:       "self sees email",
+			principal:  &middleware.Principal{UserID: alice.ID},
+			pathID:     fmt.Sprint(alice.ID),
+			wantStatus: http.StatusOK,
+			wantEmail:  "alice@example.com",
+		},
+		{
+			name:       "other user does not see email",
+			principal:  &middleware.Principal{UserID: bob.ID},
+			pathID:     fmt.Sprint(alice.ID),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "admin sees email",
+			principal:  &middleware.Principal{UserID: bob.ID, Admin: true},
+			pathID:     fmt.Sprint(alice.ID),
+			wantStatus: http.StatusOK,
+			wantEmail:  "alice@example.com",
+		},
+		{
+			name:       "deleted user",
+			principal:  &middleware.Principal{UserID: alice.ID, Admin: true},
+			pathID:     fmt.Sprint(gone.ID),
+			wantStatus: http.StatusNotFound,
+			wantError:  "user not found",
+		},
+		{
+			name:       "missing user",
+			principal:  &middleware.Principal{UserID: alice.ID},
+			pathID:     "99999",
+			wantStatus: http.StatusNotFound,
+			wantError:  "user not found",
+		},
+		{
+			name:       "invalid ID",
+			principal:  &middleware.Principal{UserID: alice.ID},
+			pathID:     "invalid",
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid user id",
+		},
+		{
+			name:       "unauthenticated",
+			pathID:     fmt.Sprint(alice.ID),
+			wantStatus: http.StatusUnauthorized,
+			wantError:  "authentication required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/users/"+tt.pathID, nil)
+			req.SetPathValue("id", tt.pathID)
+			if tt.principal != nil {
+				req = req.WithContext(middleware.WithPrincipal(req.Context(), *tt.principal))
+			}
+			rec := httptest.NewRecorder()
+
+			server.HandleGetUser(logger, testDB.Client).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantError != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantError, errResp.Detail)
+				return
+			}
+
+			var result server.UserResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			assert.Equal(t, alice.ID, result.ID)
+			assert.Equal(t, "alice", result.Username)
+			assert.Equal(t, tt.wantEmail, result.Email)
+		})
+	}
+}
+
+func TestHandleListUsers(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	var ids []int
+	for i := range 5 {
+		u, err := testDB.Client.User.Create().
+			SetUsername(fmt.Sprintf("user%d", i)).
+			SetEmail(fmt.Sprintf("user%d@example.com", i)).
+			Save(ctx)
+		require.NoError(t, err)
+		ids = append(ids, u.ID)
+	}
+	// Deleted users are left out of the listing
+	require.NoError(t, testDB.Client.User.UpdateOneID(ids[2]).SetDeletedAt(time.Now()).Exec(ctx))
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	list := func(t *testing.T, query string) server.UserListResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/users"+query, nil)
+		req = asUser(req, ids[0])
+		rec := httptest.NewRecorder()
+
+		server.HandleListUsers(logger, testDB.Client).ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var result server.UserListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+		return result
+	}
+
+	first := list(t, "?limit=2")
+	require.Len(t, first.Data, 2)
+	assert.Equal(t, ids[0], first.Data[0].ID)
+	assert.Equal(t, "user0@example.com", first.Data[0].Email)
+	assert.Equal(t, ids[1], first.Data[1].ID)
+	assert.Empty(t, first.Data[1].Email)
+	require.NotNil(t, first.NextCursor)
+
+	second := list(t, "?limit=2&cursor="+*first.NextCursor)
+	require.Len(t, second.Data, 2)
+	assert.Equal(t, ids[3], second.Data[0].ID)
+	assert.Equal(t, ids[4], second.Data[1].ID)
+	assert.Nil(t, second.NextCursor)
+}
+
+func TestHandleListUsers_Validation(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	tests := []struct {
+		name       string
+		query      string
+		anonymous  bool
+		wantStatus int
+		wantError  string
+	}{
+		{name: "unauthenticated", anonymous: true, wantStatus: http.StatusUnauthorized, wantError: "authentication required"},
+		{name: "limit too large", query: "?limit=101", wantStatus: http.StatusBadRequest, wantError: "limit must be between 1 and 100"},
+		{name: "invalid cursor", query: "?cursor=not-a-cursor", wantStatus: http.StatusBadRequest, wantError: "invalid cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/users"+tt.query, nil)
+			if !tt.anonymous {
+				req = asUser(req, 1)
+			}
+			rec := httptest.NewRecorder()
+
+			server.HandleListUsers(logger, testDB.Client).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			var errResp server.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+			assert.Equal(t, tt.wantError, errResp.Detail)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+)
+
+// UpdateUserRequest represents the request body for editing a user's
+// profile. Omitted fields are left unchanged.
+type UpdateUserRequest struct {
+	Username *string `json:"username,omitempty"`
+	Email    *string `json:"email,omitempty"`
+}
+
+// HandleUpdateUser handles editing a user's profile by the user or an admin
+func HandleUpdateUser(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		u, ok := loadPathUser(logger, client, w, r, "update user")
+		if !ok {
+			return
+		}
+		if !canManageUser(principal, u) {
+			writeForbiddenError(w, r, "only the user or an admin can modify this user")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "update user: starting", slog.Int("user_id", u.ID))
+
+		var req UpdateUserRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
+			return
+		}
+
+		if req.Username == nil && req.Email == nil {
+			writeValidationError(w, r, "no changes requested")
+			return
+		}
+
+		upd := client.User.UpdateOne(u)
+		var errs FieldErrors
+
+		// Validate username
+		if req.Username != nil {
+			errs = append(errs, ValidateUsername(*req.Username)...)
+			upd.SetUsername(strings.TrimSpace(*req.Username))
+		}
+
+		// Validate email
+		if req.Email != nil {
+			errs = append(errs, ValidateEmail(*req.Email)...)
+			upd.SetEmail(strings.TrimSpace(strings.ToLower(*req.Email)))
+		}
+
+		if errs != nil {
+			writeFieldErrors(w, r, errs)
+			return
+		}
+
+		updated, err := upd.Save(r.Context())
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				writeConflictError(w, r, "username or email already exists")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to update user", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to update user")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "update user: completed", slog.Int("user_id", u.ID))
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(mapUserToViewerResponse(updated, principal)); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode user response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
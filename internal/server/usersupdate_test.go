@@ -0,0 +1,135 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleUpdateUser(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		asOther      bool
+		admin        bool
+		wantStatus   int
+		wantError    string
+		wantUsername string
+		wantEmail    string
+	}{
+		{
+			name:         "rename self",
+			body:         `{"username": "alice2"}`,
+			wantStatus:   http.StatusOK,
+			wantUsername: "alice2",
+			wantEmail:    "alice@example.com",
+		},
+		{
+			name:         "email is normalized",
+			body:         `{"email": "  Alice@New.Example.com "}`,
+			wantStatus:   http.StatusOK,
+			wantUsername: "alice",
+			wantEmail:    "alice@new.example.com",
+		},
+		{
+			name:         "admin edits another user",
+			body:         `{"username": "renamed"}`,
+			asOther:      true,
+			admin:        true,
+			wantStatus:   http.StatusOK,
+			wantUsername: "renamed",
+			wantEmail:    "alice@example.com",
+		},
+		{
+			name:       "other user",
+			body:       `{"username": "hijack"}`,
+			asOther:    true,
+			wantStatus: http.StatusForbidden,
+			wantError:  "only the user or an admin can modify this user",
+		},
+		{
+			name:       "username taken",
+			body:       `{"username": "bob"}`,
+			wantStatus: http.StatusConflict,
+			wantError:  "username or email already exists",
+		},
+		{
+			name:       "invalid username",
+			body:       `{"username": "deleted-user#1"}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "username can only contain letters, numbers, underscores, and hyphens",
+		},
+		{
+			name:       "no changes",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "no changes requested",
+		},
+		{
+			name:       "invalid body",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid request body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			alice, err := testDB.Client.User.Create().SetUsername("alice").SetEmail("alice@example.com").Save(ctx)
+			require.NoError(t, err)
+			bob, err := testDB.Client.User.Create().SetUsername("bob").SetEmail("bob@example.com").Save(ctx)
+			require.NoError(t, err)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			pathID := fmt.Sprint(alice.ID)
+			req := httptest.NewRequest(http.MethodPatch, "/users/"+pathID, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("id", pathID)
+			principal := middleware.Principal{UserID: alice.ID}
+			if tt.asOther {
+				principal = middleware.Principal{UserID: bob.ID, Admin: tt.admin}
+			}
+			req = req.WithContext(middleware.WithPrincipal(req.Context(), principal))
+			rec := httptest.NewRecorder()
+
+			server.HandleUpdateUser(logger, testDB.Client).ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantError != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantError, errResp.Detail)
+				return
+			}
+
+			var result server.UserResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			assert.Equal(t, tt.wantUsername, result.Username)
+			assert.Equal(t, tt.wantEmail, result.Email)
+
+			stored, err := testDB.Client.User.Get(ctx, alice.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUsername, stored.Username)
+			assert.Equal(t, tt.wantEmail, stored.Email)
+		})
+	}
+}
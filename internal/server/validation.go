@@ -1,9 +1,21 @@
 package server
 
 import (
+	"fmt"
+	"maps"
+	"net/netip"
+	"net/url"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 	"unicode"
+
+	"github.com/ivankorhner/polling-app/internal/ent/membership"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/tally"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
 )
 
 const (
@@ -13,94 +25,345 @@ const (
 	UsernameMaxLength = 32
 )
 
+// Field error codes name the way a field is invalid
+const (
+	FieldCodeRequired      = "required"
+	FieldCodeTooShort      = "too_short"
+	FieldCodeTooLong       = "too_long"
+	FieldCodeTooFew        = "too_few"
+	FieldCodeTooMany       = "too_many"
+	FieldCodeInvalidFormat = "invalid_format"
+	FieldCodeUnsupported   = "unsupported"
+	FieldCodeOutOfRange    = "out_of_range"
+	FieldCodeDuplicate     = "duplicate"
+	FieldCodeNotAllowed    = "not_allowed"
+)
+
+// FieldError describes an invalid field of a request body. Pointer is a JSON
+// pointer (RFC 6901) to the field, such as /options/2.
+type FieldError struct {
+	Pointer string `json:"pointer"`
+	Code    string `json:"code"`
+	Detail  string `json:"detail"`
+}
+
+// FieldErrors lists the invalid fields of a request. The validators return
+// every problem they find rather than stopping at the first, and a nil list
+// means the input is valid.
+type FieldErrors []FieldError
+
+// fieldError returns a list holding a single field error
+func fieldError(pointer, code, detail string) FieldErrors {
+	return FieldErrors{{Pointer: pointer, Code: code, Detail: detail}}
+}
+
+// Detail returns the distinct messages of the errors, separated by
+// semicolons, or an empty string when there are none
+func (errs FieldErrors) Detail() string {
+	var details []string
+	for _, e := range errs {
+		if !slices.Contains(details, e.Detail) {
+			details = append(details, e.Detail)
+		}
+	}
+	return strings.Join(details, "; ")
+}
+
+// at returns the errors pointing at the given field instead, for requests
+// that carry a single value of what the validator checks as a whole
+func (errs FieldErrors) at(pointer string) FieldErrors {
+	moved := make(FieldErrors, len(errs))
+	for i, e := range errs {
+		e.Pointer = pointer
+		moved[i] = e
+	}
+	return moved
+}
+
 // emailRegex is a simple but effective email validation pattern
 var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
 
-// ValidateUsername validates a username and returns an error message if invalid
-func ValidateUsername(username string) string {
+// ValidateUsername validates a username and returns the invalid fields
+func ValidateUsername(username string) FieldErrors {
 	username = strings.TrimSpace(username)
 
 	if username == "" {
-		return "username is required"
+		return fieldError("/username", FieldCodeRequired, "username is required")
 	}
 
 	if len(username) < UsernameMinLength {
-		return "username must be at least 3 characters"
+		return fieldError("/username", FieldCodeTooShort, "username must be at least 3 characters")
 	}
 
 	if len(username) > UsernameMaxLength {
-		return "username must be at most 32 characters"
+		return fieldError("/username", FieldCodeTooLong, "username must be at most 32 characters")
 	}
 
 	// Check that username contains only allowed characters (alphanumeric, underscore, hyphen)
 	for _, r := range username {
 		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' {
-			return "username can only contain letters, numbers, underscores, and hyphens"
+			return fieldError("/username", FieldCodeInvalidFormat, "username can only contain letters, numbers, underscores, and hyphens")
 		}
 	}
 
 	// Username must start with a letter
 	if !unicode.IsLetter(rune(username[0])) {
-		return "username must start with a letter"
+		return fieldError("/username", FieldCodeInvalidFormat, "username must start with a letter")
 	}
 
-	return ""
+	return nil
 }
 
-// ValidateEmail validates an email address and returns an error message if invalid
-func ValidateEmail(email string) string {
+// ValidateEmail validates an email address and returns the invalid fields
+func ValidateEmail(email string) FieldErrors {
 	email = strings.TrimSpace(email)
 
 	if email == "" {
-		return "email is required"
+		return fieldError("/email", FieldCodeRequired, "email is required")
 	}
 
 	if len(email) > 254 { // RFC 5321
-		return "email address is too long"
+		return fieldError("/email", FieldCodeTooLong, "email address is too long")
 	}
 
 	if !emailRegex.MatchString(email) {
-		return "invalid email format"
+		return fieldError("/email", FieldCodeInvalidFormat, "invalid email format")
 	}
 
-	return ""
+	return nil
 }
 
-// ValidatePollTitle validates a poll title and returns an error message if invalid
-func ValidatePollTitle(title string) string {
+// ValidatePollTitle validates a poll title and returns the invalid fields
+func ValidatePollTitle(title string) FieldErrors {
 	title = strings.TrimSpace(title)
 
 	if title == "" {
-		return "title is required"
+		return fieldError("/title", FieldCodeRequired, "title is required")
 	}
 
 	if len(title) > 256 {
-		return "title must be at most 256 characters"
+		return fieldError("/title", FieldCodeTooLong, "title must be at most 256 characters")
+	}
+
+	return nil
+}
+
+// ValidateTokenName validates an API token name and returns the invalid fields
+func ValidateTokenName(name string) FieldErrors {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return fieldError("/name", FieldCodeRequired, "name is required")
+	}
+
+	if len(name) > 100 {
+		return fieldError("/name", FieldCodeTooLong, "name must be at most 100 characters")
+	}
+
+	return nil
+}
+
+// ValidateOrganizationName validates an organization name and returns the invalid fields
+func ValidateOrganizationName(name string) FieldErrors {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return fieldError("/name", FieldCodeRequired, "name is required")
+	}
+
+	if len(name) > 100 {
+		return fieldError("/name", FieldCodeTooLong, "name must be at most 100 characters")
+	}
+
+	return nil
+}
+
+// ValidateMemberRole validates an organization membership role and returns the invalid fields
+func ValidateMemberRole(role string) FieldErrors {
+	if err := membership.RoleValidator(membership.Role(role)); err != nil {
+		return fieldError("/role", FieldCodeUnsupported, fmt.Sprintf("unsupported role %q", role))
+	}
+
+	return nil
+}
+
+// ValidateWebhookURL validates a webhook URL and returns the invalid fields
+func ValidateWebhookURL(rawURL string) FieldErrors {
+	if strings.TrimSpace(rawURL) == "" {
+		return fieldError("/url", FieldCodeRequired, "url is required")
+	}
+
+	if len(rawURL) > 2048 {
+		return fieldError("/url", FieldCodeTooLong, "url must be at most 2048 characters")
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fieldError("/url", FieldCodeInvalidFormat, "url must be an absolute http or https URL")
+	}
+
+	// Names are checked again once resolved, when the delivery is sent
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	addr, err := netip.ParseAddr(host)
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || (err == nil && !webhooks.PublicAddr(addr)) {
+		return fieldError("/url", FieldCodeNotAllowed, "url must not point to a loopback, private or link-local address")
+	}
+
+	return nil
+}
+
+// ValidateWebhookEvents validates the event types a webhook subscribes to and
+// returns the invalid fields
+func ValidateWebhookEvents(types []string) FieldErrors {
+	if len(types) == 0 {
+		return fieldError("/events", FieldCodeRequired, "at least 1 event is required")
+	}
+
+	var errs FieldErrors
+	for i, t := range types {
+		if !slices.Contains(webhooks.Events, events.Type(t)) {
+			errs = append(errs, fieldError(fmt.Sprintf("/events/%d", i), FieldCodeUnsupported, fmt.Sprintf("unknown event %q", t))...)
+		}
 	}
 
-	return ""
+	return errs
 }
 
-// ValidatePollOptions validates poll options and returns an error message if invalid
-func ValidatePollOptions(options []string) string {
+// ValidatePollOptions validates poll options and returns the invalid fields
+func ValidatePollOptions(options []string) FieldErrors {
+	var errs FieldErrors
 	if len(options) < 2 {
-		return "at least 2 options are required"
+		errs = append(errs, fieldError("/options", FieldCodeTooFew, "at least 2 options are required")...)
 	}
 
 	if len(options) > 20 {
-		return "at most 20 options are allowed"
+		errs = append(errs, fieldError("/options", FieldCodeTooMany, "at most 20 options are allowed")...)
 	}
 
 	for i, opt := range options {
 		opt = strings.TrimSpace(opt)
+		pointer := fmt.Sprintf("/options/%d", i)
 		if opt == "" {
-			return "option cannot be empty"
+			errs = append(errs, fieldError(pointer, FieldCodeRequired, "option cannot be empty")...)
 		}
 		if len(opt) > 256 {
-			return "option text must be at most 256 characters"
+			errs = append(errs, fieldError(pointer, FieldCodeTooLong, "option text must be at most 256 characters")...)
 		}
 		options[i] = opt // Normalize trimmed value
 	}
 
-	return ""
+	return errs
+}
+
+// ValidatePollSchedule validates the optional opening and closing times of a poll
+// and returns the invalid fields
+func ValidatePollSchedule(opensAt, closesAt *time.Time, now time.Time) FieldErrors {
+	if closesAt == nil {
+		return nil
+	}
+
+	if !closesAt.After(now) {
+		return fieldError("/closes_at", FieldCodeOutOfRange, "closes_at must be in the future")
+	}
+
+	if opensAt != nil && !closesAt.After(*opensAt) {
+		return fieldError("/closes_at", FieldCodeOutOfRange, "closes_at must be after opens_at")
+	}
+
+	return nil
+}
+
+// ValidateVotingMethod validates a poll's voting method and returns the invalid fields
+func ValidateVotingMethod(method string) FieldErrors {
+	if err := entpoll.VotingMethodValidator(entpoll.VotingMethod(method)); err != nil {
+		return fieldError("/voting_method", FieldCodeUnsupported, fmt.Sprintf("unsupported voting_method %q", method))
+	}
+
+	return nil
+}
+
+// ValidatePollVisibility validates a poll's visibility and returns the invalid fields
+func ValidatePollVisibility(visibility string) FieldErrors {
+	if err := entpoll.VisibilityValidator(entpoll.Visibility(visibility)); err != nil {
+		return fieldError("/visibility", FieldCodeUnsupported, fmt.Sprintf("unsupported visibility %q", visibility))
+	}
+
+	return nil
+}
+
+// ValidateResultsVisibility validates a poll's results visibility and returns the invalid fields
+func ValidateResultsVisibility(visibility string) FieldErrors {
+	if err := entpoll.ResultsVisibilityValidator(entpoll.ResultsVisibility(visibility)); err != nil {
+		return fieldError("/results_visibility", FieldCodeUnsupported, fmt.Sprintf("unsupported results_visibility %q", visibility))
+	}
+
+	return nil
+}
+
+// ValidateBallotKind validates that a ballot carries scores exactly when the
+// voting method counts scored ballots, and that every score is in range.
+// It returns the invalid fields.
+func ValidateBallotKind(kind tally.BallotKind, scores map[int]int) FieldErrors {
+	if kind != tally.ScoredBallot {
+		if len(scores) > 0 {
+			return fieldError("/scores", FieldCodeNotAllowed, "scores are only accepted on score polls")
+		}
+		return nil
+	}
+
+	if len(scores) == 0 {
+		return fieldError("/scores", FieldCodeRequired, "scores are required on score polls")
+	}
+
+	var errs FieldErrors
+	for _, optionID := range slices.Sorted(maps.Keys(scores)) {
+		if score := scores[optionID]; score < 0 || score > MaxScore {
+			errs = append(errs, fieldError(fmt.Sprintf("/scores/%d", optionID), FieldCodeOutOfRange,
+				fmt.Sprintf("scores must be between 0 and %d", MaxScore))...)
+		}
+	}
+
+	return errs
+}
+
+// ValidateSelectionRule validates the minimum and maximum number of options a
+// ballot may select and returns the invalid fields
+func ValidateSelectionRule(minSelections, maxSelections, optionCount int) FieldErrors {
+	var errs FieldErrors
+	if minSelections < 1 {
+		errs = append(errs, fieldError("/min_selections", FieldCodeOutOfRange, "min_selections must be at least 1")...)
+	}
+
+	switch {
+	case maxSelections < minSelections:
+		errs = append(errs, fieldError("/max_selections", FieldCodeOutOfRange, "max_selections must not be less than min_selections")...)
+	case maxSelections > optionCount:
+		errs = append(errs, fieldError("/max_selections", FieldCodeOutOfRange, "max_selections must not exceed the number of options")...)
+	}
+
+	return errs
+}
+
+// ValidateSelections validates the options selected on a ballot against the
+// poll's selection rule and returns the invalid fields
+func ValidateSelections(optionIDs []int, minSelections, maxSelections int) FieldErrors {
+	var errs FieldErrors
+	seen := make(map[int]struct{}, len(optionIDs))
+	for i, id := range optionIDs {
+		if _, ok := seen[id]; ok {
+			errs = append(errs, fieldError(fmt.Sprintf("/option_ids/%d", i), FieldCodeDuplicate, "option_ids must not contain duplicates")...)
+		}
+		seen[id] = struct{}{}
+	}
+
+	switch {
+	case len(optionIDs) < minSelections:
+		errs = append(errs, fieldError("/option_ids", FieldCodeTooFew, fmt.Sprintf("at least %d options must be selected", minSelections))...)
+	case len(optionIDs) > maxSelections && maxSelections == 1:
+		errs = append(errs, fieldError("/option_ids", FieldCodeTooMany, "only one option can be selected")...)
+	case len(optionIDs) > maxSelections:
+		errs = append(errs, fieldError("/option_ids", FieldCodeTooMany, fmt.Sprintf("at most %d options can be selected", maxSelections))...)
+	}
+
+	return errs
 }
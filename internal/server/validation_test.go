@@ -3,8 +3,11 @@ package server
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ivankorhner/polling-app/internal/tally"
 )
 
 func TestValidateUsername(t *testing.T) {
@@ -77,7 +80,7 @@ func TestValidateUsername(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := ValidateUsername(tt.username)
+			got := ValidateUsername(tt.username).Detail()
 			assert.Equal(t, tt.wantErr, got)
 		})
 	}
@@ -143,7 +146,7 @@ func TestValidateEmail(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := ValidateEmail(tt.email)
+			got := ValidateEmail(tt.email).Detail()
 			assert.Equal(t, tt.wantErr, got)
 		})
 	}
@@ -179,7 +182,217 @@ func TestValidatePollTitle(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := ValidatePollTitle(tt.title)
+			got := ValidatePollTitle(tt.title).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidateTokenName(t *testing.T) {
+	tests := []struct {
+		name      string
+		tokenName string
+		wantErr   string
+	}{
+		{
+			name:      "valid name",
+			tokenName: "ci deploys",
+			wantErr:   "",
+		},
+		{
+			name:      "whitespace only",
+			tokenName: "   ",
+			wantErr:   "name is required",
+		},
+		{
+			name:      "too long",
+			tokenName: strings.Repeat("a", 101),
+			wantErr:   "name must be at most 100 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateTokenName(tt.tokenName).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidateOrganizationName(t *testing.T) {
+	tests := []struct {
+		name    string
+		orgName string
+		wantErr string
+	}{
+		{
+			name:    "valid name",
+			orgName: "Platform Team",
+			wantErr: "",
+		},
+		{
+			name:    "whitespace only",
+			orgName: "   ",
+			wantErr: "name is required",
+		},
+		{
+			name:    "too long",
+			orgName: strings.Repeat("a", 101),
+			wantErr: "name must be at most 100 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateOrganizationName(tt.orgName).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidateMemberRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    string
+		wantErr string
+	}{
+		{
+			name:    "owner",
+			role:    "owner",
+			wantErr: "",
+		},
+		{
+			name:    "admin",
+			role:    "admin",
+			wantErr: "",
+		},
+		{
+			name:    "member",
+			role:    "member",
+			wantErr: "",
+		},
+		{
+			name:    "unknown",
+			role:    "guest",
+			wantErr: `unsupported role "guest"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateMemberRole(tt.role).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr string
+	}{
+		{
+			name:    "valid https url",
+			url:     "https://example.com/hooks/polls",
+			wantErr: "",
+		},
+		{
+			name:    "valid http url with port",
+			url:     "http://hooks.example.com:8080/hook",
+			wantErr: "",
+		},
+		{
+			name:    "public ip",
+			url:     "https://203.0.113.10/hook",
+			wantErr: "",
+		},
+		{
+			name:    "localhost",
+			url:     "http://localhost:8080/hook",
+			wantErr: "url must not point to a loopback, private or link-local address",
+		},
+		{
+			name:    "loopback",
+			url:     "http://127.0.0.1/hook",
+			wantErr: "url must not point to a loopback, private or link-local address",
+		},
+		{
+			name:    "loopback ipv6",
+			url:     "http://[::1]/hook",
+			wantErr: "url must not point to a loopback, private or link-local address",
+		},
+		{
+			name:    "private",
+			url:     "http://10.0.0.5/hook",
+			wantErr: "url must not point to a loopback, private or link-local address",
+		},
+		{
+			name:    "cloud metadata",
+			url:     "http://169.254.169.254/latest/meta-data/",
+			wantErr: "url must not point to a loopback, private or link-local address",
+		},
+		{
+			name:    "unspecified",
+			url:     "http://0.0.0.0/hook",
+			wantErr: "url must not point to a loopback, private or link-local address",
+		},
+		{
+			name:    "empty",
+			url:     "",
+			wantErr: "url is required",
+		},
+		{
+			name:    "relative",
+			url:     "/hooks/polls",
+			wantErr: "url must be an absolute http or https URL",
+		},
+		{
+			name:    "unsupported scheme",
+			url:     "ftp://example.com/hook",
+			wantErr: "url must be an absolute http or https URL",
+		},
+		{
+			name:    "too long",
+			url:     "https://example.com/" + strings.Repeat("a", 2048),
+			wantErr: "url must be at most 2048 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateWebhookURL(tt.url).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidateWebhookEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		events  []string
+		wantErr string
+	}{
+		{
+			name:    "valid events",
+			events:  []string{"vote", "poll_closed"},
+			wantErr: "",
+		},
+		{
+			name:    "none",
+			events:  nil,
+			wantErr: "at least 1 event is required",
+		},
+		{
+			name:    "unknown event",
+			events:  []string{"vote", "poll_archived"},
+			wantErr: `unknown event "poll_archived"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateWebhookEvents(tt.events).Detail()
 			assert.Equal(t, tt.wantErr, got)
 		})
 	}
@@ -219,7 +432,7 @@ func TestValidatePollOptions(t *testing.T) {
 		{
 			name:    "too many options",
 			options: make([]string, 21),
-			wantErr: "at most 20 options are allowed",
+			wantErr: "at most 20 options are allowed; option cannot be empty",
 		},
 		{
 			name:    "empty option text",
@@ -243,8 +456,448 @@ func TestValidatePollOptions(t *testing.T) {
 			// Create a copy to avoid modifying test data
 			opts := make([]string, len(tt.options))
 			copy(opts, tt.options)
-			got := ValidatePollOptions(opts)
+			got := ValidatePollOptions(opts).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidatePollSchedule(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	farFuture := now.Add(2 * time.Hour)
+
+	tests := []struct {
+		name     string
+		opensAt  *time.Time
+		closesAt *time.Time
+		wantErr  string
+	}{
+		{
+			name:    "no schedule",
+			wantErr: "",
+		},
+		{
+			name:    "only opens_at",
+			opensAt: &future,
+			wantErr: "",
+		},
+		{
+			name:     "closes in the future",
+			closesAt: &future,
+			wantErr:  "",
+		},
+		{
+			name:     "opens before it closes",
+			opensAt:  &future,
+			closesAt: &farFuture,
+			wantErr:  "",
+		},
+		{
+			name:     "closes in the past",
+			closesAt: &past,
+			wantErr:  "closes_at must be in the future",
+		},
+		{
+			name:     "closes now",
+			closesAt: &now,
+			wantErr:  "closes_at must be in the future",
+		},
+		{
+			name:     "closes before it opens",
+			opensAt:  &farFuture,
+			closesAt: &future,
+			wantErr:  "closes_at must be after opens_at",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidatePollSchedule(tt.opensAt, tt.closesAt, now).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidateSelectionRule(t *testing.T) {
+	tests := []struct {
+		name        string
+		min         int
+		max         int
+		optionCount int
+		wantErr     string
+	}{
+		{
+			name:        "single choice",
+			min:         1,
+			max:         1,
+			optionCount: 2,
+			wantErr:     "",
+		},
+		{
+			name:        "pick up to all options",
+			min:         1,
+			max:         3,
+			optionCount: 3,
+			wantErr:     "",
+		},
+		{
+			name:        "pick exactly two",
+			min:         2,
+			max:         2,
+			optionCount: 4,
+			wantErr:     "",
+		},
+		{
+			name:        "min below one",
+			min:         0,
+			max:         2,
+			optionCount: 3,
+			wantErr:     "min_selections must be at least 1",
+		},
+		{
+			name:        "max below min",
+			min:         3,
+			max:         2,
+			optionCount: 4,
+			wantErr:     "max_selections must not be less than min_selections",
+		},
+		{
+			name:        "max above option count",
+			min:         1,
+			max:         4,
+			optionCount: 3,
+			wantErr:     "max_selections must not exceed the number of options",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateSelectionRule(tt.min, tt.max, tt.optionCount).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidateSelections(t *testing.T) {
+	tests := []struct {
+		name      string
+		optionIDs []int
+		min       int
+		max       int
+		wantErr   string
+	}{
+		{
+			name:      "single choice",
+			optionIDs: []int{1},
+			min:       1,
+			max:       1,
+			wantErr:   "",
+		},
+		{
+			name:      "within range",
+			optionIDs: []int{1, 2},
+			min:       1,
+			max:       3,
+			wantErr:   "",
+		},
+		{
+			name:      "duplicate option",
+			optionIDs: []int{1, 1},
+			min:       1,
+			max:       3,
+			wantErr:   "option_ids must not contain duplicates",
+		},
+		{
+			name:      "too few",
+			optionIDs: []int{1},
+			min:       2,
+			max:       3,
+			wantErr:   "at least 2 options must be selected",
+		},
+		{
+			name:      "too many for single choice",
+			optionIDs: []int{1, 2},
+			min:       1,
+			max:       1,
+			wantErr:   "only one option can be selected",
+		},
+		{
+			name:      "too many",
+			optionIDs: []int{1, 2, 3},
+			min:       1,
+			max:       2,
+			wantErr:   "at most 2 options can be selected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateSelections(tt.optionIDs, tt.min, tt.max).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidateVotingMethod(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		wantErr string
+	}{
+		{
+			name:    "plurality",
+			method:  "plurality",
+			wantErr: "",
+		},
+		{
+			name:    "instant runoff",
+			method:  "instant_runoff",
+			wantErr: "",
+		},
+		{
+			name:    "schulze",
+			method:  "schulze",
+			wantErr: "",
+		},
+		{
+			name:    "score",
+			method:  "score",
+			wantErr: "",
+		},
+		{
+			name:    "unknown method",
+			method:  "coin_toss",
+			wantErr: `unsupported voting_method "coin_toss"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateVotingMethod(tt.method).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidatePollVisibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility string
+		wantErr    string
+	}{
+		{
+			name:       "public",
+			visibility: "public",
+			wantErr:    "",
+		},
+		{
+			name:       "unlisted",
+			visibility: "unlisted",
+			wantErr:    "",
+		},
+		{
+			name:       "private",
+			visibility: "private",
+			wantErr:    "",
+		},
+		{
+			name:       "unknown",
+			visibility: "hidden",
+			wantErr:    `unsupported visibility "hidden"`,
+		},
+		{
+			name:       "empty",
+			visibility: "",
+			wantErr:    `unsupported visibility ""`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidatePollVisibility(tt.visibility).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidateResultsVisibility(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility string
+		wantErr    string
+	}{
+		{
+			name:       "always",
+			visibility: "always",
+			wantErr:    "",
+		},
+		{
+			name:       "after vote",
+			visibility: "after_vote",
+			wantErr:    "",
+		},
+		{
+			name:       "after close",
+			visibility: "after_close",
+			wantErr:    "",
+		},
+		{
+			name:       "owner only",
+			visibility: "owner_only",
+			wantErr:    "",
+		},
+		{
+			name:       "unknown visibility",
+			visibility: "never",
+			wantErr:    `unsupported results_visibility "never"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateResultsVisibility(tt.visibility).Detail()
 			assert.Equal(t, tt.wantErr, got)
 		})
 	}
 }
+
+func TestValidateBallotKind(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    tally.BallotKind
+		scores  map[int]int
+		wantErr string
+	}{
+		{
+			name:    "selection ballot without scores",
+			kind:    tally.SelectionBallot,
+			wantErr: "",
+		},
+		{
+			name:    "ranked ballot with scores",
+			kind:    tally.RankedBallot,
+			scores:  map[int]int{1: 5},
+			wantErr: "scores are only accepted on score polls",
+		},
+		{
+			name:    "scored ballot",
+			kind:    tally.ScoredBallot,
+			scores:  map[int]int{1: 0, 2: 10},
+			wantErr: "",
+		},
+		{
+			name:    "scored ballot without scores",
+			kind:    tally.ScoredBallot,
+			wantErr: "scores are required on score polls",
+		},
+		{
+			name:    "negative score",
+			kind:    tally.ScoredBallot,
+			scores:  map[int]int{1: -1},
+			wantErr: "scores must be between 0 and 10",
+		},
+		{
+			name:    "score above maximum",
+			kind:    tally.ScoredBallot,
+			scores:  map[int]int{1: 11},
+			wantErr: "scores must be between 0 and 10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateBallotKind(tt.kind, tt.scores).Detail()
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func TestValidate_FieldErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		got  FieldErrors
+		want FieldErrors
+	}{
+		{
+			name: "valid",
+			got:  ValidateUsername("johndoe"),
+			want: nil,
+		},
+		{
+			name: "single field",
+			got:  ValidateUsername("ab"),
+			want: FieldErrors{{Pointer: "/username", Code: FieldCodeTooShort, Detail: "username must be at least 3 characters"}},
+		},
+		{
+			name: "every invalid option",
+			got:  ValidatePollOptions([]string{" ", strings.Repeat("a", 257)}),
+			want: FieldErrors{
+				{Pointer: "/options/0", Code: FieldCodeRequired, Detail: "option cannot be empty"},
+				{Pointer: "/options/1", Code: FieldCodeTooLong, Detail: "option text must be at most 256 characters"},
+			},
+		},
+		{
+			name: "too few options and an empty one",
+			got:  ValidatePollOptions([]string{""}),
+			want: FieldErrors{
+				{Pointer: "/options", Code: FieldCodeTooFew, Detail: "at least 2 options are required"},
+				{Pointer: "/options/0", Code: FieldCodeRequired, Detail: "option cannot be empty"},
+			},
+		},
+		{
+			name: "every unknown event",
+			got:  ValidateWebhookEvents([]string{"nope", "poll_closed", "also.nope"}),
+			want: FieldErrors{
+				{Pointer: "/events/0", Code: FieldCodeUnsupported, Detail: `unknown event "nope"`},
+				{Pointer: "/events/2", Code: FieldCodeUnsupported, Detail: `unknown event "also.nope"`},
+			},
+		},
+		{
+			name: "both selection bounds",
+			got:  ValidateSelectionRule(0, 5, 3),
+			want: FieldErrors{
+				{Pointer: "/min_selections", Code: FieldCodeOutOfRange, Detail: "min_selections must be at least 1"},
+				{Pointer: "/max_selections", Code: FieldCodeOutOfRange, Detail: "max_selections must not exceed the number of options"},
+			},
+		},
+		{
+			name: "duplicates and too many selections",
+			got:  ValidateSelections([]int{1, 2, 1}, 1, 2),
+			want: FieldErrors{
+				{Pointer: "/option_ids/2", Code: FieldCodeDuplicate, Detail: "option_ids must not contain duplicates"},
+				{Pointer: "/option_ids", Code: FieldCodeTooMany, Detail: "at most 2 options can be selected"},
+			},
+		},
+		{
+			name: "every score out of range",
+			got:  ValidateBallotKind(tally.ScoredBallot, map[int]int{3: 11, 1: -1, 2: 5}),
+			want: FieldErrors{
+				{Pointer: "/scores/1", Code: FieldCodeOutOfRange, Detail: "scores must be between 0 and 10"},
+				{Pointer: "/scores/3", Code: FieldCodeOutOfRange, Detail: "scores must be between 0 and 10"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestFieldErrors_Detail(t *testing.T) {
+	errs := FieldErrors{
+		{Pointer: "/scores/1", Code: FieldCodeOutOfRange, Detail: "scores must be between 0 and 10"},
+		{Pointer: "/scores/3", Code: FieldCodeOutOfRange, Detail: "scores must be between 0 and 10"},
+		{Pointer: "/option_ids", Code: FieldCodeTooMany, Detail: "only one option can be selected"},
+	}
+
+	assert.Equal(t, "scores must be between 0 and 10; only one option can be selected", errs.Detail())
+	assert.Empty(t, FieldErrors(nil).Detail())
+	for _, e := range errs.at("/text") {
+		assert.Equal(t, "/text", e.Pointer)
+	}
+	assert.Equal(t, "/scores/1", errs[0].Pointer, "at leaves the original errors")
+}
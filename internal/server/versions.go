@@ -0,0 +1,45 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// unversionedDeprecation describes the bare paths the API was first served
+// at. They alias /v1 until their sunset.
+var unversionedDeprecation = middleware.Deprecation{
+	Since:  time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+	Sunset: time.Date(2027, 4, 17, 0, 0, 0, 0, time.UTC),
+	Successor: func(r *http.Request) string {
+		return "/v1" + r.URL.Path
+	},
+}
+
+// apiVersion registers the routes of one version of the API under its path
+// prefix. Versions differ only in the request and response types their
+// handlers decode and write; the services behind them, such as the hub, the
+// event bus and the idempotency store, are shared, so a vote cast through one
+// version reaches the live results and webhooks of every other. Handlers
+// are thin adapters around domain functions: HandleCreatePoll decodes a
+// CreatePollRequest into a pollSpec for createPoll, and HandleGetPoll
+// encodes the pollView from getPoll and viewPoll as a PollResponse. A
+// version with other types adds its own adapters around the same functions.
+type apiVersion struct {
+	mux    *http.ServeMux
+	prefix string
+	// unversioned, when set, also serves the version's routes at their bare
+	// paths, marked deprecated
+	unversioned *middleware.Deprecation
+}
+
+// Handle registers the handler for a "METHOD /path" pattern of the version
+func (v apiVersion) Handle(pattern string, h http.Handler) {
+	method, path, _ := strings.Cut(pattern, " ")
+	v.mux.Handle(method+" "+v.prefix+path, h)
+	if v.unversioned != nil {
+		v.mux.Handle(pattern, middleware.Deprecated(*v.unversioned)(h))
+	}
+}
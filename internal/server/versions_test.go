@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivankorhner/polling-app/internal/config"
+	"github.com/ivankorhner/polling-app/internal/events"
+)
+
+func TestAddRoutes_Versions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// None of these requests reach the database
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handler := AddRoutes(ctx, &config.Config{APITimeout: time.Minute}, logger, nil, nil, events.NewMemory(logger))
+
+	tests := []struct {
+		name           string
+		path           string
+		wantStatus     int
+		wantDeprecated bool
+		wantSuccessor  string
+	}{
+		{"versioned", "/v1/openapi.json", http.StatusOK, false, ""},
+		{"versioned error", "/v1/me", http.StatusUnauthorized, false, ""},
+		{"unversioned alias", "/openapi.json", http.StatusOK, true, "</v1/openapi.json>"},
+		{"unversioned alias error", "/me", http.StatusUnauthorized, true, "</v1/me>"},
+		{"unknown route", "/v1/nope", http.StatusNotFound, false, ""},
+		{"unknown version", "/v2/polls", http.StatusNotFound, false, ""},
+		{"health is unversioned", "/v1/health", http.StatusNotFound, false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if !tt.wantDeprecated {
+				assert.Empty(t, rec.Header().Get("Deprecation"))
+				assert.Empty(t, rec.Header().Get("Sunset"))
+				return
+			}
+			assert.Equal(t, "@1792195200", rec.Header().Get("Deprecation"))
+			assert.Equal(t, "Sat, 17 Apr 2027 00:00:00 GMT", rec.Header().Get("Sunset"))
+			assert.Equal(t, tt.wantSuccessor+`; rel="successor-version"`, rec.Header().Get("Link"))
+		})
+	}
+}
@@ -5,144 +5,446 @@ import (
 	"encoding/json"
 	"errors"
 	"log/slog"
+	"maps"
 	"net/http"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/ballot"
 	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
 	"github.com/ivankorhner/polling-app/internal/ent/polloption"
-	"github.com/ivankorhner/polling-app/internal/ent/user"
+	"github.com/ivankorhner/polling-app/internal/ent/vote"
+	"github.com/ivankorhner/polling-app/internal/ent/voteevent"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+	"github.com/ivankorhner/polling-app/internal/tally"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
 )
 
-// VoteRequest represents the request body for voting
+// VoteRequest represents the request body for voting. On ranked polls the
+// order of option_ids is the voter's preference order; score polls take
+// scores keyed by option ID instead. The ballot is cast by the authenticated
+// user.
 type VoteRequest struct {
-	OptionID int `json:"option_id"`
-	UserID   int `json:"user_id"`
+	OptionID  int         `json:"option_id,omitempty"`
+	OptionIDs []int       `json:"option_ids,omitempty"`
+	Scores    map[int]int `json:"scores,omitempty"`
 }
 
+// selections returns the options chosen on the ballot. A single option_id is
+// accepted as shorthand for a one-element option_ids list, and scored
+// ballots select the options they score.
+func (req VoteRequest) selections() []int {
+	if len(req.Scores) > 0 {
+		return slices.Sorted(maps.Keys(req.Scores))
+	}
+	if len(req.OptionIDs) > 0 {
+		return req.OptionIDs
+	}
+	if req.OptionID != 0 {
+		return []int{req.OptionID}
+	}
+	return nil
+}
+
+// ballotError is a ballot rejected for a reason the voter can act on, with
+// the error response that explains it
+type ballotError struct {
+	message string
+	code    string
+	status  int
+	fields  FieldErrors
+}
+
+func (e *ballotError) Error() string {
+	return e.message
+}
+
+// problem returns the problem details of the rejection
+func (e *ballotError) problem() ErrorResponse {
+	problem := newErrorResponse(e.message, e.code)
+	problem.Errors = e.fields
+	return problem
+}
+
+// invalidBallot returns a ballot error for a ballot that fails validation
+func invalidBallot(message string) *ballotError {
+	return &ballotError{message: message, code: ErrCodeValidation, status: http.StatusBadRequest}
+}
+
+// invalidBallotFields returns a ballot error for the invalid fields of a
+// ballot
+func invalidBallotFields(errs FieldErrors) *ballotError {
+	rejected := invalidBallot(errs.Detail())
+	rejected.fields = errs
+	return rejected
+}
+
+// ballotWrite stores a validated ballot for the user. Errors the voter can
+// act on are returned as a *ballotError.
+type ballotWrite func(ctx context.Context, client *ent.Client, p *ent.Poll, req VoteRequest, optionIDs []int, userID int) error
+
 // HandleVote handles vote submission
-func HandleVote(logger *slog.Logger, client *ent.Client) http.Handler {
+func HandleVote(logger *slog.Logger, client *ent.Client, bus events.Bus) http.Handler {
+	return handleBallot(logger, client, bus, "submit vote", castBallot)
+}
+
+// HandleChangeVote handles replacing the selections on a user's ballot
+func HandleChangeVote(logger *slog.Logger, client *ent.Client, bus events.Bus) http.Handler {
+	return handleBallot(logger, client, bus, "change vote", changeBallot)
+}
+
+// castBallot stores the user's first ballot on the poll
+func castBallot(ctx context.Context, client *ent.Client, p *ent.Poll, req VoteRequest, optionIDs []int, userID int) error {
+	err := createVote(ctx, client, p, optionIDs, req.Scores, userID)
+	if ent.IsConstraintError(err) {
+		return &ballotError{message: "user has already voted on this poll", code: ErrCodeConflict, status: http.StatusConflict}
+	}
+	return err
+}
+
+// changeBallot replaces the selections on the user's ballot
+func changeBallot(ctx context.Context, client *ent.Client, p *ent.Poll, req VoteRequest, optionIDs []int, userID int) error {
+	if p.Anonymous {
+		return errAnonymousBallot("changed")
+	}
+	err := replaceVote(ctx, client, p, optionIDs, req.Scores, userID)
+	switch {
+	case ent.IsNotFound(err):
+		return &ballotError{message: "vote not found", code: ErrCodeNotFound, status: http.StatusNotFound}
+	case ent.IsConstraintError(err):
+		return &ballotError{message: "vote was changed concurrently", code: ErrCodeConflict, status: http.StatusConflict}
+	}
+	return err
+}
+
+// HandleRetractVote handles withdrawing the authenticated user's ballot from
+// an open poll
+func HandleRetractVote(logger *slog.Logger, client *ent.Client, bus events.Bus) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Limit request body size
-		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
 
 		pollIDStr := r.PathValue("id")
 		pollID, err := strconv.Atoi(pollIDStr)
 		if err != nil {
-			writeValidationError(w, "invalid poll id")
+			writeValidationError(w, r, "invalid poll id")
 			return
 		}
 
-		logger.LogAttrs(r.Context(), slog.LevelInfo, "submit vote: starting", slog.Int("poll_id", pollID))
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "retract vote: starting", slog.Int("poll_id", pollID))
 
-		var req VoteRequest
-		if decodeErr := json.NewDecoder(r.Body).Decode(&req); decodeErr != nil {
-			writeValidationError(w, "invalid request body")
+		p, err := queryViewablePolls(r.Context(), client, principal).Where(entpoll.ID(pollID)).Only(r.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				writeNotFoundError(w, r, "poll not found")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to check poll", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to retract vote")
 			return
 		}
-
-		if req.OptionID == 0 {
-			writeValidationError(w, "option_id is required")
+		if status := pollStatus(p, time.Now()); status != PollStatusOpen {
+			writeError(w, r, "poll is not open for voting (status: "+status+")", ErrCodePollNotOpen, http.StatusConflict)
 			return
 		}
-		if req.UserID == 0 {
-			writeValidationError(w, "user_id is required")
+		if p.Anonymous {
+			rejected := errAnonymousBallot("retracted")
+			writeProblem(w, r, rejected.status, rejected.problem())
 			return
 		}
 
-		// Verify poll exists
-		pollExists, err := client.Poll.Query().Where(entpoll.ID(pollID)).Exist(r.Context())
-		if err != nil {
-			logger.LogAttrs(r.Context(), slog.LevelError, "failed to check poll", slog.String("error", err.Error()))
-			writeInternalError(w, "failed to submit vote")
-			return
-		}
-		if !pollExists {
-			writeNotFoundError(w, "poll not found")
+		if err := retractVote(r.Context(), client, p, principal.UserID); err != nil {
+			if ent.IsNotFound(err) {
+				writeNotFoundError(w, r, "vote not found")
+				return
+			}
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to retract vote", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to retract vote")
 			return
 		}
+		publishEvent(r.Context(), logger, bus, events.TypeVote, pollID)
 
-		// Verify option exists and belongs to poll
-		optionExists, err := client.PollOption.Query().
-			Where(polloption.ID(req.OptionID), polloption.PollID(pollID)).
-			Exist(r.Context())
+		// Return updated poll with vote counts
+		response, err := loadPollResponse(r.Context(), client, pollID, principal)
 		if err != nil {
-			logger.LogAttrs(r.Context(), slog.LevelError, "failed to check option", slog.String("error", err.Error()))
-			writeInternalError(w, "failed to submit vote")
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to reload poll", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to retract vote")
 			return
 		}
-		if !optionExists {
-			writeValidationError(w, "option not found or does not belong to poll")
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"retract vote: completed",
+			slog.Int("poll_id", pollID),
+			slog.Int("user_id", principal.UserID),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to encode response", slog.String("error", err.Error()))
+		}
+	})
+}
+
+// handleBallot decodes a ballot and hands it to submitBallot with write.
+// On success it publishes a vote event and responds with the updated poll.
+func handleBallot(logger *slog.Logger, client *ent.Client, bus events.Bus, action string, write ballotWrite) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
 			return
 		}
 
-		// Verify user exists
-		userExists, err := client.User.Query().Where(user.ID(req.UserID)).Exist(r.Context())
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		pollIDStr := r.PathValue("id")
+		pollID, err := strconv.Atoi(pollIDStr)
 		if err != nil {
-			logger.LogAttrs(r.Context(), slog.LevelError, "failed to check user", slog.String("error", err.Error()))
-			writeInternalError(w, "failed to submit vote")
+			writeValidationError(w, r, "invalid poll id")
 			return
 		}
-		if !userExists {
-			writeValidationError(w, "user not found")
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, action+": starting", slog.Int("poll_id", pollID))
+
+		var req VoteRequest
+		if decodeErr := json.NewDecoder(r.Body).Decode(&req); decodeErr != nil {
+			writeValidationError(w, r, "invalid request body")
 			return
 		}
 
-		// Create vote (no need to increment vote_count anymore - it's calculated dynamically)
-		err = createVote(r.Context(), client, pollID, req.OptionID, req.UserID)
+		p, optionIDs, err := submitBallot(r.Context(), client, pollID, principal, req, write)
 		if err != nil {
-			if ent.IsConstraintError(err) {
-				writeConflictError(w, "user has already voted on this poll")
+			var rejected *ballotError
+			if errors.As(err, &rejected) {
+				writeProblem(w, r, rejected.status, rejected.problem())
 				return
 			}
-			logger.LogAttrs(r.Context(), slog.LevelError, "failed to create vote", slog.String("error", err.Error()))
-			writeInternalError(w, "failed to submit vote")
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to "+action, slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to "+action)
 			return
 		}
+		publishEvent(r.Context(), logger, bus, events.TypeVote, pollID)
 
 		// Return updated poll with vote counts
-		poll, err := client.Poll.Query().
-			Where(entpoll.ID(pollID)).
-			WithOptions(func(q *ent.PollOptionQuery) {
-				q.WithVotes()
-			}).
-			Only(r.Context())
+		response, err := loadPollResponse(r.Context(), client, pollID, principal)
 		if err != nil {
 			logger.LogAttrs(r.Context(), slog.LevelError, "failed to reload poll", slog.String("error", err.Error()))
-			writeInternalError(w, "failed to submit vote")
+			writeInternalError(w, r, "failed to "+action)
 			return
 		}
 
-		logger.LogAttrs(
-			r.Context(),
-			slog.LevelInfo,
-			"submit vote: completed",
-			slog.Int("poll_id", pollID),
-			slog.Int("option_id", req.OptionID),
-			slog.Int("user_id", req.UserID),
-		)
+		logger.LogAttrs(r.Context(), slog.LevelInfo, action+": completed", ballotLogAttrs(p, optionIDs, principal.UserID)...)
 
 		w.Header().Set("Content-Type", "application/json")
-		if err := json.NewEncoder(w).Encode(mapPollToResponse(poll)); err != nil {
+		if err := json.NewEncoder(w).Encode(response); err != nil {
 			logger.LogAttrs(r.Context(), slog.LevelError, "failed to encode response", slog.String("error", err.Error()))
 		}
 	})
 }
 
-func createVote(ctx context.Context, client *ent.Client, pollID, optionID, userID int) error {
+// submitBallot validates a ballot against a poll the principal may see and
+// stores it with write, returning the poll and the selected options. Ballots
+// that are rejected return a *ballotError.
+func submitBallot(ctx context.Context, client *ent.Client, pollID int, principal middleware.Principal, req VoteRequest, write ballotWrite) (*ent.Poll, []int, error) {
+	if req.OptionID != 0 && len(req.OptionIDs) > 0 {
+		return nil, nil, invalidBallotFields(fieldError("/option_ids", FieldCodeNotAllowed, "option_id and option_ids cannot both be set"))
+	}
+	if len(req.Scores) > 0 && (req.OptionID != 0 || len(req.OptionIDs) > 0) {
+		return nil, nil, invalidBallotFields(fieldError("/scores", FieldCodeNotAllowed, "scores cannot be combined with option_id or option_ids"))
+	}
+	optionIDs := req.selections()
+	if len(optionIDs) == 0 {
+		return nil, nil, invalidBallotFields(fieldError("/option_id", FieldCodeRequired, "option_id is required"))
+	}
+
+	// Verify poll exists and is accepting votes
+	p, err := queryViewablePolls(ctx, client, principal).Where(entpoll.ID(pollID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, &ballotError{message: "poll not found", code: ErrCodeNotFound, status: http.StatusNotFound}
+		}
+		return nil, nil, err
+	}
+	if status := pollStatus(p, time.Now()); status != PollStatusOpen {
+		return nil, nil, &ballotError{
+			message: "poll is not open for voting (status: " + status + ")",
+			code:    ErrCodePollNotOpen,
+			status:  http.StatusConflict,
+		}
+	}
+
+	// Verify the ballot is marked the way the poll's voting method counts
+	// and its selections satisfy the poll's selection rule
+	errs := append(
+		ValidateBallotKind(votingMethod(p.VotingMethod).Ballot(), req.Scores),
+		ValidateSelections(optionIDs, p.MinSelections, p.MaxSelections)...,
+	)
+	if errs != nil {
+		return nil, nil, invalidBallotFields(errs)
+	}
+
+	// Verify options exist and belong to poll
+	optionCount, err := client.PollOption.Query().
+		Where(polloption.IDIn(optionIDs...), polloption.PollID(pollID)).
+		Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if optionCount != len(optionIDs) {
+		return nil, nil, invalidBallot("option not found or does not belong to poll")
+	}
+
+	return p, optionIDs, write(ctx, client, p, req, optionIDs, principal.UserID)
+}
+
+// createVote stores the user's first ballot on the poll. The ballot goes to
+// anonymous_votes on anonymous polls.
+func createVote(ctx context.Context, client *ent.Client, p *ent.Poll, optionIDs []int, scores map[int]int, userID int) error {
+	if p.Anonymous {
+		return createAnonymousVote(ctx, client, p, optionIDs, scores, userID)
+	}
+
 	tx, err := client.Tx(ctx)
 	if err != nil {
 		return err
 	}
 
-	_, err = tx.Vote.Create().
-		SetPollID(pollID).
-		SetOptionID(optionID).
+	// The unique (user_id, poll_id) index on ballots rejects a second ballot
+	b, err := tx.Ballot.Create().
+		SetPollID(p.ID).
 		SetUserID(userID).
 		Save(ctx)
 	if err != nil {
 		return errors.Join(err, tx.Rollback())
 	}
 
+	if err = addVotes(ctx, tx, p, b.ID, optionIDs, scores, userID); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = recordVoteEvent(ctx, tx, p, userID, voteevent.ActionCast, optionIDs); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
+
+// replaceVote swaps the votes on the user's ballot for the new selections in
+// a single transaction. It returns a not-found error if the user has not voted.
+func replaceVote(ctx context.Context, client *ent.Client, p *ent.Poll, optionIDs []int, scores map[int]int, userID int) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	b, err := tx.Ballot.Query().
+		Where(ballot.PollID(p.ID), ballot.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if _, err = tx.Vote.Delete().Where(vote.BallotID(b.ID)).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = addVotes(ctx, tx, p, b.ID, optionIDs, scores, userID); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = recordVoteEvent(ctx, tx, p, userID, voteevent.ActionChange, optionIDs); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
+
+// retractVote deletes the user's ballot and its votes. It returns a not-found
+// error if the user has not voted.
+func retractVote(ctx context.Context, client *ent.Client, p *ent.Poll, userID int) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	b, err := tx.Ballot.Query().
+		Where(ballot.PollID(p.ID), ballot.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if _, err = tx.Vote.Delete().Where(vote.BallotID(b.ID)).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = tx.Ballot.DeleteOne(b).Exec(ctx); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	if err = recordVoteEvent(ctx, tx, p, userID, voteevent.ActionRetract, nil); err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
 	return tx.Commit()
 }
+
+// addVotes creates one vote per selected option on the ballot, recording the
+// rank or score the poll's voting method counts
+func addVotes(ctx context.Context, tx *ent.Tx, p *ent.Poll, ballotID int, optionIDs []int, scores map[int]int, userID int) error {
+	kind := votingMethod(p.VotingMethod).Ballot()
+	for i, optionID := range optionIDs {
+		create := tx.Vote.Create().
+			SetBallotID(ballotID).
+			SetPollID(p.ID).
+			SetOptionID(optionID).
+			SetUserID(userID)
+		switch kind {
+		case tally.RankedBallot:
+			create.SetRank(i + 1)
+		case tally.ScoredBallot:
+			create.SetScore(scores[optionID])
+		}
+		if _, err := create.Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordVoteEvent appends a ballot change to the poll's vote history and
+// queues it for the poll's webhooks. Neither records who voted or how on
+// anonymous polls.
+func recordVoteEvent(ctx context.Context, tx *ent.Tx, p *ent.Poll, userID int, action voteevent.Action, optionIDs []int) error {
+	create := tx.VoteEvent.Create().
+		SetPollID(p.ID).
+		SetAction(action)
+	vote := &webhooks.Vote{Action: string(action)}
+	if !p.Anonymous {
+		create.SetUserID(userID).SetOptionIds(optionIDs)
+		vote.UserID = userID
+		vote.OptionIDs = optionIDs
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return err
+	}
+	return webhooks.Enqueue(ctx, tx, events.TypeVote, p, vote)
+}
+
+// ballotLogAttrs describes a ballot for the logs, leaving out the options on
+// anonymous polls where they would tie the user to a choice
+func ballotLogAttrs(p *ent.Poll, optionIDs []int, userID int) []slog.Attr {
+	attrs := []slog.Attr{slog.Int("poll_id", p.ID), slog.Int("user_id", userID)}
+	if !p.Anonymous {
+		attrs = append(attrs, slog.Any("option_ids", optionIDs))
+	}
+	return attrs
+}
@@ -12,7 +12,11 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/events"
 	"github.com/ivankorhner/polling-app/internal/server"
 	"github.com/ivankorhner/polling-app/internal/testutil"
 	"github.com/stretchr/testify/assert"
@@ -60,13 +64,14 @@ func TestHandleVote_Success(t *testing.T) {
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 
-	body := fmt.Sprintf(`{"option_id": %d, "user_id": %d}`, option1.ID, user.ID)
+	body := fmt.Sprintf(`{"option_id": %d}`, option1.ID)
 	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(body))
 	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
 	req.Header.Set("Content-Type", "application/json")
+	req = asUser(req, user.ID)
 	rec := httptest.NewRecorder()
 
-	handler := server.HandleVote(logger, testDB.Client)
+	handler := server.HandleVote(logger, testDB.Client, events.NewMemory(logger))
 	handler.ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusOK, rec.Code)
@@ -88,7 +93,12 @@ func TestHandleVote_Success(t *testing.T) {
 		}
 	}
 	require.NotNil(t, votedOption, "voted option should be in response")
-	assert.Equal(t, 1, votedOption.VoteCount)
+	assert.Equal(t, 1, *votedOption.VoteCount)
+
+	// The ballot belongs to the authenticated user
+	b, err := testDB.Client.Ballot.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, b.UserID)
 }
 
 func TestHandleVote_DuplicateVote(t *testing.T) {
@@ -117,8 +127,15 @@ func TestHandleVote_DuplicateVote(t *testing.T) {
 		Save(ctx)
 	require.NoError(t, err)
 
-	// Create existing vote
+	// Create existing ballot and vote
+	ballot, err := testDB.Client.Ballot.Create().
+		SetPollID(poll.ID).
+		SetUserID(user.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
 	_, err = testDB.Client.Vote.Create().
+		SetBallotID(ballot.ID).
 		SetPollID(poll.ID).
 		SetOptionID(option.ID).
 		SetUserID(user.ID).
@@ -127,13 +144,14 @@ func TestHandleVote_DuplicateVote(t *testing.T) {
 
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 
-	body := fmt.Sprintf(`{"option_id": %d, "user_id": %d}`, option.ID, user.ID)
+	body := fmt.Sprintf(`{"option_id": %d}`, option.ID)
 	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(body))
 	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
 	req.Header.Set("Content-Type", "application/json")
+	req = asUser(req, user.ID)
 	rec := httptest.NewRecorder()
 
-	handler := server.HandleVote(logger, testDB.Client)
+	handler := server.HandleVote(logger, testDB.Client, events.NewMemory(logger))
 	handler.ServeHTTP(rec, req)
 
 	assert.Equal(t, http.StatusConflict, rec.Code)
@@ -144,26 +162,28 @@ func TestHandleVote_Validation(t *testing.T) {
 		name       string
 		body       string
 		pathID     string
+		anonymous  bool
 		wantStatus int
 		wantError  string
 	}{
 		{
 			name:       "missing option_id",
-			body:       `{"user_id": 1}`,
+			body:       `{}`,
 			pathID:     "1",
 			wantStatus: http.StatusBadRequest,
 			wantError:  "option_id is required",
 		},
 		{
-			name:       "missing user_id",
+			name:       "unauthenticated",
 			body:       `{"option_id": 1}`,
 			pathID:     "1",
-			wantStatus: http.StatusBadRequest,
-			wantError:  "user_id is required",
+			anonymous:  true,
+			wantStatus: http.StatusUnauthorized,
+			wantError:  "authentication required",
 		},
 		{
 			name:       "invalid poll ID",
-			body:       `{"option_id": 1, "user_id": 1}`,
+			body:       `{"option_id": 1}`,
 			pathID:     "invalid",
 			wantStatus: http.StatusBadRequest,
 			wantError:  "invalid poll id",
@@ -188,9 +208,12 @@ func TestHandleVote_Validation(t *testing.T) {
 			req := httptest.NewRequest(http.MethodPost, "/polls/"+tt.pathID+"/vote", bytes.NewBufferString(tt.body))
 			req.SetPathValue("id", tt.pathID)
 			req.Header.Set("Content-Type", "application/json")
+			if !tt.anonymous {
+				req = asUser(req, 1)
+			}
 			rec := httptest.NewRecorder()
 
-			handler := server.HandleVote(logger, testDB.Client)
+			handler := server.HandleVote(logger, testDB.Client, events.NewMemory(logger))
 			handler.ServeHTTP(rec, req)
 
 			assert.Equal(t, tt.wantStatus, rec.Code)
@@ -198,7 +221,7 @@ func TestHandleVote_Validation(t *testing.T) {
 			var errResp server.ErrorResponse
 			err := json.Unmarshal(rec.Body.Bytes(), &errResp)
 			require.NoError(t, err)
-			assert.Equal(t, tt.wantError, errResp.Error)
+			assert.Equal(t, tt.wantError, errResp.Detail)
 		})
 	}
 }
@@ -234,25 +257,345 @@ func TestHandleVote_NotFound(t *testing.T) {
 			wantStatus: http.StatusBadRequest,
 			wantError:  "option not found or does not belong to poll",
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			pollID, optionID, userID := tt.setup(ctx, testDB)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			body := fmt.Sprintf(`{"option_id": %d}`, optionID)
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", pollID), bytes.NewBufferString(body))
+			req.SetPathValue("id", fmt.Sprintf("%d", pollID))
+			req.Header.Set("Content-Type", "application/json")
+			req = asUser(req, userID)
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleVote(logger, testDB.Client, events.NewMemory(logger))
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var errResp server.ErrorResponse
+			err := json.Unmarshal(rec.Body.Bytes(), &errResp)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantError, errResp.Detail)
+		})
+	}
+}
+
+func TestHandleVote_PollNotOpen(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(c *ent.PollCreate) *ent.PollCreate
+		wantError string
+	}{
+		{
+			name: "draft",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetDraft(true)
+			},
+			wantError: "poll is not open for voting (status: draft)",
+		},
 		{
-			name: "user not found",
-			setup: func(ctx context.Context, testDB *testutil.TestDB) (int, int, int) {
-				owner, _ := testDB.Client.User.Create().
-					SetUsername("owner").
-					SetEmail("owner@example.com").
-					Save(ctx)
-				poll, _ := testDB.Client.Poll.Create().
-					SetOwnerID(owner.ID).
-					SetTitle("Test Poll").
-					Save(ctx)
-				option, _ := testDB.Client.PollOption.Create().
+			name: "scheduled",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetOpensAt(time.Now().Add(time.Hour))
+			},
+			wantError: "poll is not open for voting (status: scheduled)",
+		},
+		{
+			name: "closing time passed",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetClosesAt(time.Now().Add(-time.Minute))
+			},
+			wantError: "poll is not open for voting (status: closed)",
+		},
+		{
+			name: "closed manually",
+			setup: func(c *ent.PollCreate) *ent.PollCreate {
+				return c.SetClosedAt(time.Now().Add(-time.Minute))
+			},
+			wantError: "poll is not open for voting (status: closed)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			user, err := testDB.Client.User.Create().
+				SetUsername("voter").
+				SetEmail("voter@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			poll, err := tt.setup(testDB.Client.Poll.Create().
+				SetOwnerID(user.ID).
+				SetTitle("Test Poll")).
+				Save(ctx)
+			require.NoError(t, err)
+
+			option, err := testDB.Client.PollOption.Create().
+				SetPollID(poll.ID).
+				SetText("Option 1").
+				Save(ctx)
+			require.NoError(t, err)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			body := fmt.Sprintf(`{"option_id": %d}`, option.ID)
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(body))
+			req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+			req.Header.Set("Content-Type", "application/json")
+			req = asUser(req, user.ID)
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleVote(logger, testDB.Client, events.NewMemory(logger))
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusConflict, rec.Code)
+
+			var errResp server.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+			assert.Equal(t, tt.wantError, errResp.Detail)
+			assert.Equal(t, server.ErrCodePollNotOpen, errResp.Code)
+
+			voteCount, err := testDB.Client.Vote.Query().Count(ctx)
+			require.NoError(t, err)
+			assert.Zero(t, voteCount)
+		})
+	}
+}
+
+func TestHandleVote_MultipleChoice(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	user, err := testDB.Client.User.Create().
+		SetUsername("voter").
+		SetEmail("voter@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	poll, err := testDB.Client.Poll.Create().
+		SetOwnerID(user.ID).
+		SetTitle("Pick up to two").
+		SetMinSelections(1).
+		SetMaxSelections(2).
+		Save(ctx)
+	require.NoError(t, err)
+
+	options := make([]*ent.PollOption, 3)
+	for i := range options {
+		options[i], err = testDB.Client.PollOption.Create().
+			SetPollID(poll.ID).
+			SetText(fmt.Sprintf("Option %d", i+1)).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	body := fmt.Sprintf(`{"option_ids": [%d, %d]}`, options[0].ID, options[2].ID)
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(body))
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	req.Header.Set("Content-Type", "application/json")
+	req = asUser(req, user.ID)
+	rec := httptest.NewRecorder()
+
+	handler := server.HandleVote(logger, testDB.Client, events.NewMemory(logger))
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var result server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, 1, result.MinSelections)
+	assert.Equal(t, 2, result.MaxSelections)
+
+	counts := make(map[int]int)
+	for _, opt := range result.Options {
+		counts[opt.ID] = *opt.VoteCount
+	}
+	assert.Equal(t, 1, counts[options[0].ID])
+	assert.Equal(t, 0, counts[options[1].ID])
+	assert.Equal(t, 1, counts[options[2].ID])
+
+	// Both selections belong to a single ballot
+	ballotCount, err := testDB.Client.Ballot.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, ballotCount)
+
+	// A second ballot from the same user is rejected
+	body = fmt.Sprintf(`{"option_ids": [%d]}`, options[1].ID)
+	req = httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(body))
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	req.Header.Set("Content-Type", "application/json")
+	req = asUser(req, user.ID)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestHandleVote_SelectionRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		min       int
+		max       int
+		body      func(optionIDs []int) string
+		wantError string
+	}{
+		{
+			name: "too many selections",
+			min:  1,
+			max:  2,
+			body: func(optionIDs []int) string {
+				return fmt.Sprintf(`{"option_ids": [%d, %d, %d]}`, optionIDs[0], optionIDs[1], optionIDs[2])
+			},
+			wantError: "at most 2 options can be selected",
+		},
+		{
+			name: "too few selections",
+			min:  2,
+			max:  3,
+			body: func(optionIDs []int) string {
+				return fmt.Sprintf(`{"option_id": %d}`, optionIDs[0])
+			},
+			wantError: "at least 2 options must be selected",
+		},
+		{
+			name: "duplicate selections",
+			min:  1,
+			max:  3,
+			body: func(optionIDs []int) string {
+				return fmt.Sprintf(`{"option_ids": [%d, %d]}`, optionIDs[0], optionIDs[0])
+			},
+			wantError: "option_ids must not contain duplicates",
+		},
+		{
+			name: "option_id and option_ids",
+			min:  1,
+			max:  3,
+			body: func(optionIDs []int) string {
+				return fmt.Sprintf(`{"option_id": %d, "option_ids": [%d]}`, optionIDs[0], optionIDs[1])
+			},
+			wantError: "option_id and option_ids cannot both be set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			user, err := testDB.Client.User.Create().
+				SetUsername("voter").
+				SetEmail("voter@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			poll, err := testDB.Client.Poll.Create().
+				SetOwnerID(user.ID).
+				SetTitle("Test Poll").
+				SetMinSelections(tt.min).
+				SetMaxSelections(tt.max).
+				Save(ctx)
+			require.NoError(t, err)
+
+			optionIDs := make([]int, 3)
+			for i := range optionIDs {
+				option, err := testDB.Client.PollOption.Create().
 					SetPollID(poll.ID).
-					SetText("Option 1").
+					SetText(fmt.Sprintf("Option %d", i+1)).
 					Save(ctx)
-				return poll.ID, option.ID, 99999
+				require.NoError(t, err)
+				optionIDs[i] = option.ID
+			}
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(tt.body(optionIDs)))
+			req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+			req.Header.Set("Content-Type", "application/json")
+			req = asUser(req, user.ID)
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleVote(logger, testDB.Client, events.NewMemory(logger))
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var errResp server.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+			assert.Equal(t, tt.wantError, errResp.Detail)
+		})
+	}
+}
+
+func TestHandleVote_Scores(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     entpoll.VotingMethod
+		body       func(optionIDs []int) string
+		wantStatus int
+		wantError  string
+		wantScores map[int]int
+	}{
+		{
+			name:   "scored ballot",
+			method: entpoll.VotingMethodScore,
+			body: func(optionIDs []int) string {
+				return fmt.Sprintf(`{"scores": {"%d": 10, "%d": 0}}`, optionIDs[0], optionIDs[2])
+			},
+			wantStatus: http.StatusOK,
+			wantScores: map[int]int{0: 10, 2: 0},
+		},
+		{
+			name:   "score out of range",
+			method: entpoll.VotingMethodScore,
+			body: func(optionIDs []int) string {
+				return fmt.Sprintf(`{"scores": {"%d": 11}}`, optionIDs[0])
 			},
 			wantStatus: http.StatusBadRequest,
-			wantError:  "user not found",
+			wantError:  "scores must be between 0 and 10",
+		},
+		{
+			name:   "score poll without scores",
+			method: entpoll.VotingMethodScore,
+			body: func(optionIDs []int) string {
+				return fmt.Sprintf(`{"option_id": %d}`, optionIDs[0])
+			},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "scores are required on score polls",
+		},
+		{
+			name:   "scores on plurality poll",
+			method: entpoll.VotingMethodPlurality,
+			body: func(optionIDs []int) string {
+				return fmt.Sprintf(`{"scores": {"%d": 5}}`, optionIDs[0])
+			},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "scores are only accepted on score polls",
+		},
+		{
+			name:   "scores with option_ids",
+			method: entpoll.VotingMethodScore,
+			body: func(optionIDs []int) string {
+				return fmt.Sprintf(`{"scores": {"%d": 5}, "option_ids": [%d]}`, optionIDs[0], optionIDs[1])
+			},
+			wantStatus: http.StatusBadRequest,
+			wantError:  "scores cannot be combined with option_id or option_ids",
 		},
 	}
 
@@ -262,25 +605,226 @@ func TestHandleVote_NotFound(t *testing.T) {
 			testDB := testutil.SetupTestDB(ctx, t)
 			defer testDB.Teardown(ctx)
 
-			pollID, optionID, userID := tt.setup(ctx, testDB)
+			user, err := testDB.Client.User.Create().
+				SetUsername("voter").
+				SetEmail("voter@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			poll, err := testDB.Client.Poll.Create().
+				SetOwnerID(user.ID).
+				SetTitle("Test Poll").
+				SetVotingMethod(tt.method).
+				SetMaxSelections(3).
+				Save(ctx)
+			require.NoError(t, err)
+
+			optionIDs := make([]int, 3)
+			for i := range optionIDs {
+				option, err := testDB.Client.PollOption.Create().
+					SetPollID(poll.ID).
+					SetText(fmt.Sprintf("Option %d", i+1)).
+					Save(ctx)
+				require.NoError(t, err)
+				optionIDs[i] = option.ID
+			}
 
 			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 
-			body := fmt.Sprintf(`{"option_id": %d, "user_id": %d}`, optionID, userID)
-			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", pollID), bytes.NewBufferString(body))
-			req.SetPathValue("id", fmt.Sprintf("%d", pollID))
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(tt.body(optionIDs)))
+			req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
 			req.Header.Set("Content-Type", "application/json")
+			req = asUser(req, user.ID)
 			rec := httptest.NewRecorder()
 
-			handler := server.HandleVote(logger, testDB.Client)
+			handler := server.HandleVote(logger, testDB.Client, events.NewMemory(logger))
 			handler.ServeHTTP(rec, req)
 
 			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantError != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantError, errResp.Detail)
+				return
+			}
+
+			votes, err := testDB.Client.Vote.Query().All(ctx)
+			require.NoError(t, err)
+			require.Len(t, votes, len(tt.wantScores))
+
+			for _, v := range votes {
+				require.NotNil(t, v.Score)
+				for i, optionID := range optionIDs {
+					if v.OptionID == optionID {
+						assert.Equal(t, tt.wantScores[i], *v.Score)
+					}
+				}
+			}
+		})
+	}
+}
 
-			var errResp server.ErrorResponse
-			err := json.Unmarshal(rec.Body.Bytes(), &errResp)
+func TestHandleChangeVote(t *testing.T) {
+	tests := []struct {
+		name       string
+		voted      bool
+		closed     bool
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "switches option",
+			voted:      true,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "not voted",
+			voted:      false,
+			wantStatus: http.StatusNotFound,
+			wantError:  "vote not found",
+		},
+		{
+			name:       "poll closed",
+			voted:      true,
+			closed:     true,
+			wantStatus: http.StatusConflict,
+			wantError:  "poll is not open for voting (status: closed)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testDB := testutil.SetupTestDB(ctx, t)
+			defer testDB.Teardown(ctx)
+
+			user, err := testDB.Client.User.Create().
+				SetUsername("voter").
+				SetEmail("voter@example.com").
+				Save(ctx)
+			require.NoError(t, err)
+
+			poll, err := testDB.Client.Poll.Create().
+				SetOwnerID(user.ID).
+				SetTitle("Test Poll").
+				Save(ctx)
+			require.NoError(t, err)
+
+			option1, err := testDB.Client.PollOption.Create().
+				SetPollID(poll.ID).
+				SetText("Option 1").
+				Save(ctx)
+			require.NoError(t, err)
+
+			option2, err := testDB.Client.PollOption.Create().
+				SetPollID(poll.ID).
+				SetText("Option 2").
+				Save(ctx)
 			require.NoError(t, err)
-			assert.Equal(t, tt.wantError, errResp.Error)
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+			if tt.voted {
+				body := fmt.Sprintf(`{"option_id": %d}`, option1.ID)
+				req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(body))
+				req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+				req = asUser(req, user.ID)
+				rec := httptest.NewRecorder()
+				server.HandleVote(logger, testDB.Client, events.NewMemory(logger)).ServeHTTP(rec, req)
+				require.Equal(t, http.StatusOK, rec.Code)
+			}
+			if tt.closed {
+				_, err = testDB.Client.Poll.UpdateOne(poll).SetClosedAt(time.Now()).Save(ctx)
+				require.NoError(t, err)
+			}
+
+			body := fmt.Sprintf(`{"option_id": %d}`, option2.ID)
+			req := httptest.NewRequest(http.MethodPut, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(body))
+			req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+			req.Header.Set("Content-Type", "application/json")
+			req = asUser(req, user.ID)
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleChangeVote(logger, testDB.Client, events.NewMemory(logger))
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantError != "" {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantError, errResp.Detail)
+				return
+			}
+
+			var result server.PollResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			require.Len(t, result.Options, 2)
+			assert.Equal(t, 0, *result.Options[0].VoteCount)
+			assert.Equal(t, 1, *result.Options[1].VoteCount)
+
+			// The ballot is kept, only its votes are replaced
+			ballots, err := testDB.Client.Ballot.Query().Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 1, ballots)
 		})
 	}
 }
+
+func TestHandleRetractVote(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	user, err := testDB.Client.User.Create().
+		SetUsername("voter").
+		SetEmail("voter@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	poll, err := testDB.Client.Poll.Create().
+		SetOwnerID(user.ID).
+		SetTitle("Test Poll").
+		Save(ctx)
+	require.NoError(t, err)
+
+	option, err := testDB.Client.PollOption.Create().
+		SetPollID(poll.ID).
+		SetText("Option 1").
+		Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	voteBody := fmt.Sprintf(`{"option_id": %d}`, option.ID)
+
+	serve := func(handler http.Handler, method, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, fmt.Sprintf("/polls/%d/vote", poll.ID), bytes.NewBufferString(body))
+		req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+		req.Header.Set("Content-Type", "application/json")
+		req = asUser(req, user.ID)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Retracting before voting finds nothing
+	rec := serve(server.HandleRetractVote(logger, testDB.Client, events.NewMemory(logger)), http.MethodDelete, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(server.HandleVote(logger, testDB.Client, events.NewMemory(logger)), http.MethodPost, voteBody)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(server.HandleRetractVote(logger, testDB.Client, events.NewMemory(logger)), http.MethodDelete, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var result server.PollResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	assert.Equal(t, 0, *result.Options[0].VoteCount)
+
+	ballots, err := testDB.Client.Ballot.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, ballots)
+
+	// A retracted vote can be cast again
+	rec = serve(server.HandleVote(logger, testDB.Client, events.NewMemory(logger)), http.MethodPost, voteBody)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
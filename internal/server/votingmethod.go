@@ -0,0 +1,19 @@
+package server
+
+import (
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/tally"
+)
+
+// MaxScore is the highest score a voter may give an option on score polls
+const MaxScore = 10
+
+// votingMethod returns the tally method that counts a poll's ballots. The
+// schema only accepts registered methods, so the plurality fallback is never
+// reached for stored polls.
+func votingMethod(method entpoll.VotingMethod) tally.Method {
+	if m, ok := tally.Lookup(string(method)); ok {
+		return m
+	}
+	return tally.Plurality{}
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/tally"
+)
+
+func TestVotingMethod(t *testing.T) {
+	tests := []struct {
+		method entpoll.VotingMethod
+		want   tally.Method
+	}{
+		{method: entpoll.VotingMethodPlurality, want: tally.Plurality{}},
+		{method: entpoll.VotingMethodApproval, want: tally.Approval{}},
+		{method: entpoll.VotingMethodBorda, want: tally.Borda{}},
+		{method: entpoll.VotingMethodInstantRunoff, want: tally.InstantRunoff{}},
+		{method: entpoll.VotingMethodSchulze, want: tally.Schulze{}},
+		{method: entpoll.VotingMethodScore, want: tally.Score{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method.String(), func(t *testing.T) {
+			// Every method the schema accepts must be registered with tally
+			_, ok := tally.Lookup(tt.method.String())
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, votingMethod(tt.method))
+		})
+	}
+}
@@ -0,0 +1,382 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	entpoll "github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/webhook"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookattempt"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookdelivery"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
+)
+
+// CreateWebhookRequest represents the request body for creating a webhook.
+// Without a poll the webhook receives events on every poll the user owns.
+type CreateWebhookRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	PollID *int     `json:"poll_id,omitempty"`
+}
+
+// WebhookResponse represents a webhook in responses. The secret is only
+// returned when the webhook is created.
+type WebhookResponse struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	PollID    *int      `json:"poll_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreatedWebhookResponse represents a newly created webhook
+type CreatedWebhookResponse struct {
+	WebhookResponse
+	Secret string `json:"secret"`
+}
+
+// WebhookListResponse represents the webhooks of the authenticated user
+type WebhookListResponse struct {
+	Data []WebhookResponse `json:"data"`
+}
+
+// WebhookDeliveryResponse represents one event sent, or to be sent, to a
+// webhook along with every attempt made so far
+type WebhookDeliveryResponse struct {
+	ID            int                      `json:"id"`
+	Event         string                   `json:"event"`
+	Status        string                   `json:"status"`
+	Payload       json.RawMessage          `json:"payload"`
+	AttemptCount  int                      `json:"attempt_count"`
+	NextAttemptAt *time.Time               `json:"next_attempt_at,omitempty"`
+	DeliveredAt   *time.Time               `json:"delivered_at,omitempty"`
+	CreatedAt     time.Time                `json:"created_at"`
+	Attempts      []WebhookAttemptResponse `json:"attempts"`
+}
+
+// WebhookAttemptResponse represents a single request made for a delivery.
+// StatusCode is absent when no response arrived.
+type WebhookAttemptResponse struct {
+	ID         int       `json:"id"`
+	StatusCode *int      `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int       `json:"duration_ms"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// WebhookDeliveryListResponse represents the most recent deliveries of a
+// webhook, newest first
+type WebhookDeliveryListResponse struct {
+	Data []WebhookDeliveryResponse `json:"data"`
+}
+
+// HandleCreateWebhook handles subscribing a URL to poll events on behalf of
+// the authenticated user
+func HandleCreateWebhook(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "create webhook: starting")
+
+		// Limit request body size
+		r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodySize)
+
+		var req CreateWebhookRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeValidationError(w, r, "invalid request body")
+			return
+		}
+
+		errs := append(ValidateWebhookURL(req.URL), ValidateWebhookEvents(req.Events)...)
+		if errs != nil {
+			writeFieldErrors(w, r, errs)
+			return
+		}
+
+		// Only those who manage a poll may watch it
+		if req.PollID != nil {
+			p, err := queryViewablePolls(r.Context(), client, principal).Where(entpoll.ID(*req.PollID)).Only(r.Context())
+			if err != nil {
+				if ent.IsNotFound(err) {
+					writeValidationError(w, r, "poll not found")
+					return
+				}
+				logger.LogAttrs(r.Context(), slog.LevelError, "failed to check poll", slog.String("error", err.Error()))
+				writeInternalError(w, r, "failed to create webhook")
+				return
+			}
+			if !canManagePoll(principal, p) {
+				writeForbiddenError(w, r, "only the poll owner or an admin can add webhooks to this poll")
+				return
+			}
+		}
+
+		// Drop repeated event types, keeping the order they were given in
+		var subscribed []string
+		for _, e := range req.Events {
+			if !slices.Contains(subscribed, e) {
+				subscribed = append(subscribed, e)
+			}
+		}
+
+		secret := webhooks.NewSecret()
+		h, err := client.Webhook.Create().
+			SetUserID(principal.UserID).
+			SetNillablePollID(req.PollID).
+			SetURL(req.URL).
+			SetSecret(secret).
+			SetEvents(subscribed).
+			Save(r.Context())
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to create webhook",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to create webhook")
+			return
+		}
+
+		logger.LogAttrs(
+			r.Context(),
+			slog.LevelInfo,
+			"create webhook: completed",
+			slog.Int("webhook_id", h.ID),
+			slog.Int("user_id", principal.UserID),
+		)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		response := CreatedWebhookResponse{WebhookResponse: mapWebhookToResponse(h), Secret: secret}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode webhook response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleListWebhooks handles listing the authenticated user's webhooks
+func HandleListWebhooks(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, ok := requirePrincipal(w, r)
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list webhooks: starting")
+
+		hooks, err := client.Webhook.Query().
+			Where(webhook.UserID(principal.UserID)).
+			Order(ent.Asc(webhook.FieldID)).
+			All(r.Context())
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query webhooks",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to retrieve webhooks")
+			return
+		}
+
+		response := WebhookListResponse{Data: make([]WebhookResponse, len(hooks))}
+		for i, h := range hooks {
+			response.Data[i] = mapWebhookToResponse(h)
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "list webhooks: completed", slog.Int("count", len(hooks)))
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode webhooks response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleGetWebhook handles retrieving one of the authenticated user's
+// webhooks
+func HandleGetWebhook(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h, ok := loadOwnWebhook(logger, client, w, r, "retrieve webhook")
+		if !ok {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(mapWebhookToResponse(h)); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode webhook response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// HandleDeleteWebhook handles deleting one of the authenticated user's
+// webhooks along with its delivery log. Pending deliveries are not sent.
+func HandleDeleteWebhook(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h, ok := loadOwnWebhook(logger, client, w, r, "delete webhook")
+		if !ok {
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "delete webhook: starting", slog.Int("webhook_id", h.ID))
+
+		// Deliveries and their attempts go with the webhook by cascade
+		if err := client.Webhook.DeleteOne(h).Exec(r.Context()); err != nil {
+			logger.LogAttrs(r.Context(), slog.LevelError, "failed to delete webhook", slog.String("error", err.Error()))
+			writeInternalError(w, r, "failed to delete webhook")
+			return
+		}
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "delete webhook: completed", slog.Int("webhook_id", h.ID))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleListWebhookDeliveries handles listing the most recent deliveries of
+// one of the authenticated user's webhooks, with every attempt made for each
+func HandleListWebhookDeliveries(logger *slog.Logger, client *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit := DefaultPageLimit
+		if v := r.URL.Query().Get("limit"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 || n > MaxPageLimit {
+				writeValidationError(w, r, fmt.Sprintf("limit must be between 1 and %d", MaxPageLimit))
+				return
+			}
+			limit = n
+		}
+
+		h, ok := loadOwnWebhook(logger, client, w, r, "retrieve webhook deliveries")
+		if !ok {
+			return
+		}
+
+		deliveries, err := h.QueryDeliveries().
+			Order(ent.Desc(webhookdelivery.FieldID)).
+			Limit(limit).
+			WithAttempts(func(q *ent.WebhookAttemptQuery) {
+				q.Order(ent.Asc(webhookattempt.FieldID))
+			}).
+			All(r.Context())
+		if err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to query webhook deliveries",
+				slog.String("error", err.Error()),
+			)
+			writeInternalError(w, r, "failed to retrieve webhook deliveries")
+			return
+		}
+
+		response := WebhookDeliveryListResponse{Data: make([]WebhookDeliveryResponse, len(deliveries))}
+		for i, d := range deliveries {
+			response.Data[i] = mapWebhookDeliveryToResponse(d)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			logger.LogAttrs(
+				r.Context(),
+				slog.LevelError,
+				"failed to encode webhook deliveries response",
+				slog.String("error", err.Error()),
+			)
+		}
+	})
+}
+
+// loadOwnWebhook loads the webhook named in the request path if it belongs to
+// the authenticated user. Webhooks of other users are reported as missing
+// rather than forbidden. It writes an error response and returns false
+// otherwise.
+func loadOwnWebhook(logger *slog.Logger, client *ent.Client, w http.ResponseWriter, r *http.Request, action string) (*ent.Webhook, bool) {
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		writeValidationError(w, r, "invalid webhook id")
+		return nil, false
+	}
+
+	h, err := client.Webhook.Query().
+		Where(webhook.ID(id), webhook.UserID(principal.UserID)).
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			writeNotFoundError(w, r, "webhook not found")
+			return nil, false
+		}
+		logger.LogAttrs(r.Context(), slog.LevelError, "failed to query webhook", slog.String("error", err.Error()))
+		writeInternalError(w, r, "failed to "+action)
+		return nil, false
+	}
+	return h, true
+}
+
+func mapWebhookToResponse(h *ent.Webhook) WebhookResponse {
+	return WebhookResponse{
+		ID:        h.ID,
+		URL:       h.URL,
+		Events:    h.Events,
+		PollID:    h.PollID,
+		CreatedAt: h.CreatedAt,
+	}
+}
+
+func mapWebhookDeliveryToResponse(d *ent.WebhookDelivery) WebhookDeliveryResponse {
+	response := WebhookDeliveryResponse{
+		ID:           d.ID,
+		Event:        d.Event,
+		Status:       string(d.Status),
+		Payload:      json.RawMessage(d.Payload),
+		AttemptCount: d.AttemptCount,
+		DeliveredAt:  d.DeliveredAt,
+		CreatedAt:    d.CreatedAt,
+		Attempts:     make([]WebhookAttemptResponse, len(d.Edges.Attempts)),
+	}
+	// Only pending deliveries will be attempted again
+	if d.Status == webhookdelivery.StatusPending {
+		response.NextAttemptAt = &d.NextAttemptAt
+	}
+	for i, a := range d.Edges.Attempts {
+		response.Attempts[i] = WebhookAttemptResponse{
+			ID:         a.ID,
+			StatusCode: a.StatusCode,
+			Error:      a.Error,
+			DurationMs: a.DurationMs,
+			CreatedAt:  a.CreatedAt,
+		}
+	}
+	return response
+}
@@ -0,0 +1,327 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleCreateWebhook(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	alice, err := testDB.Client.User.Create().
+		SetUsername("alice").
+		SetEmail("alice@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	bob, err := testDB.Client.User.Create().
+		SetUsername("bob").
+		SetEmail("bob@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	alicePoll, err := testDB.Client.Poll.Create().SetOwnerID(alice.ID).SetTitle("Lunch?").Save(ctx)
+	require.NoError(t, err)
+	bobPoll, err := testDB.Client.Poll.Create().SetOwnerID(bob.ID).SetTitle("Dinner?").Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "all owned polls",
+			body:       `{"url": "https://example.com/hook", "events": ["vote", "poll_closed", "vote"]}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "one poll",
+			body:       fmt.Sprintf(`{"url": "https://example.com/hook", "events": ["vote"], "poll_id": %d}`, alicePoll.ID),
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "invalid body",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   server.ErrCodeValidation,
+		},
+		{
+			name:       "invalid url",
+			body:       `{"url": "example.com/hook", "events": ["vote"]}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   server.ErrCodeValidation,
+		},
+		{
+			name:       "unknown event",
+			body:       `{"url": "https://example.com/hook", "events": ["poll_archived"]}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   server.ErrCodeValidation,
+		},
+		{
+			name:       "poll not found",
+			body:       `{"url": "https://example.com/hook", "events": ["vote"], "poll_id": 99999}`,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   server.ErrCodeValidation,
+		},
+		{
+			name:       "poll of another user",
+			body:       fmt.Sprintf(`{"url": "https://example.com/hook", "events": ["vote"], "poll_id": %d}`, bobPoll.ID),
+			wantStatus: http.StatusForbidden,
+			wantCode:   server.ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = asUser(req, alice.ID)
+			rec := httptest.NewRecorder()
+
+			handler := server.HandleCreateWebhook(logger, testDB.Client)
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+
+			if tt.wantStatus != http.StatusCreated {
+				var errResp server.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.wantCode, errResp.Code)
+				return
+			}
+
+			var created server.CreatedWebhookResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+			assert.True(t, strings.HasPrefix(created.Secret, "whsec_"))
+			assert.Equal(t, "https://example.com/hook", created.URL)
+
+			stored, err := testDB.Client.Webhook.Get(ctx, created.ID)
+			require.NoError(t, err)
+			assert.Equal(t, alice.ID, stored.UserID)
+			assert.Equal(t, created.Secret, stored.Secret)
+			assert.Equal(t, created.Events, stored.Events)
+		})
+	}
+
+	// Repeated event types are stored once
+	hooks, err := testDB.Client.Webhook.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, hooks, 2)
+	assert.Equal(t, []string{"vote", "poll_closed"}, hooks[0].Events)
+}
+
+func TestHandleWebhooks_OwnerOnly(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	alice, err := testDB.Client.User.Create().
+		SetUsername("alice").
+		SetEmail("alice@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	bob, err := testDB.Client.User.Create().
+		SetUsername("bob").
+		SetEmail("bob@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	hook, err := testDB.Client.Webhook.Create().
+		SetUserID(alice.ID).
+		SetURL("https://example.com/hook").
+		SetSecret(webhooks.NewSecret()).
+		SetEvents([]string{"vote"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	call := func(handler http.Handler, method, path string, userID int) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.SetPathValue("id", fmt.Sprintf("%d", hook.ID))
+		req = asUser(req, userID)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	path := fmt.Sprintf("/webhooks/%d", hook.ID)
+
+	// Other users cannot see the webhook
+	rec := call(server.HandleListWebhooks(logger, testDB.Client), http.MethodGet, "/webhooks", bob.ID)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list server.WebhookListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	assert.Empty(t, list.Data)
+
+	rec = call(server.HandleGetWebhook(logger, testDB.Client), http.MethodGet, path, bob.ID)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec = call(server.HandleListWebhookDeliveries(logger, testDB.Client), http.MethodGet, path+"/deliveries", bob.ID)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec = call(server.HandleDeleteWebhook(logger, testDB.Client), http.MethodDelete, path, bob.ID)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = call(server.HandleGetWebhook(logger, testDB.Client), http.MethodGet, path, alice.ID)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), hook.Secret)
+	var got server.WebhookResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.Equal(t, hook.ID, got.ID)
+
+	rec = call(server.HandleDeleteWebhook(logger, testDB.Client), http.MethodDelete, path, alice.ID)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	exists, err := testDB.Client.Webhook.Query().Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	owner, err := testDB.Client.User.Create().
+		SetUsername("owner").
+		SetEmail("owner@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	voter, err := testDB.Client.User.Create().
+		SetUsername("voter").
+		SetEmail("voter@example.com").
+		Save(ctx)
+	require.NoError(t, err)
+
+	poll, err := testDB.Client.Poll.Create().
+		SetOwnerID(owner.ID).
+		SetTitle("Test Poll").
+		Save(ctx)
+	require.NoError(t, err)
+
+	options, err := testDB.Client.PollOption.CreateBulk(
+		testDB.Client.PollOption.Create().SetPollID(poll.ID).SetText("Option 1"),
+		testDB.Client.PollOption.Create().SetPollID(poll.ID).SetText("Option 2"),
+	).Save(ctx)
+	require.NoError(t, err)
+
+	received := make(chan webhooks.Payload, 4)
+	var secret string
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		if r.Header.Get("X-Signature") != webhooks.Sign(secret, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var payload webhooks.Payload
+		assert.NoError(t, json.Unmarshal(body, &payload))
+		received <- payload
+	}))
+	defer receiver.Close()
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	bus := events.NewMemory(logger)
+
+	// Subscribe the owner to votes and closures on the poll. The API refuses
+	// loopback targets, so the webhook is pointed at the receiver afterwards.
+	body := fmt.Sprintf(`{"url": "https://example.com/hooks/polls", "events": ["vote", "poll_closed"], "poll_id": %d}`, poll.ID)
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewBufferString(body))
+	req = asUser(req, owner.ID)
+	rec := httptest.NewRecorder()
+	server.HandleCreateWebhook(logger, testDB.Client).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var hook server.CreatedWebhookResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &hook))
+	secret = hook.Secret
+	require.NoError(t, testDB.Client.Webhook.UpdateOneID(hook.ID).SetURL(receiver.URL).Exec(ctx))
+
+	pollPath := fmt.Sprintf("/polls/%d", poll.ID)
+	req = httptest.NewRequest(http.MethodPost, pollPath+"/vote", bytes.NewBufferString(fmt.Sprintf(`{"option_id": %d}`, options[1].ID)))
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	req = asUser(req, voter.ID)
+	rec = httptest.NewRecorder()
+	server.HandleVote(logger, testDB.Client, bus).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	req = httptest.NewRequest(http.MethodPost, pollPath+"/close", nil)
+	req.SetPathValue("id", fmt.Sprintf("%d", poll.ID))
+	req = asUser(req, owner.ID)
+	rec = httptest.NewRecorder()
+	server.HandleClosePoll(logger, testDB.Client, bus).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// Both changes were queued in the order they happened
+	listDeliveries := func() server.WebhookDeliveryListResponse {
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/webhooks/%d/deliveries", hook.ID), nil)
+		req.SetPathValue("id", fmt.Sprintf("%d", hook.ID))
+		req = asUser(req, owner.ID)
+		rec := httptest.NewRecorder()
+		server.HandleListWebhookDeliveries(logger, testDB.Client).ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var list server.WebhookDeliveryListResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+		return list
+	}
+
+	list := listDeliveries()
+	require.Len(t, list.Data, 2)
+	assert.Equal(t, "poll_closed", list.Data[0].Event)
+	assert.Equal(t, "vote", list.Data[1].Event)
+	for _, d := range list.Data {
+		assert.Equal(t, "pending", d.Status)
+		assert.NotNil(t, d.NextAttemptAt)
+		assert.Empty(t, d.Attempts)
+	}
+
+	worker := webhooks.NewWorker(testDB.Client, logger)
+	worker.AllowPrivateTargets = true
+	require.Equal(t, 2, worker.ProcessDue(ctx))
+
+	vote := <-received
+	assert.Equal(t, events.TypeVote, vote.Event)
+	assert.Equal(t, poll.ID, vote.Poll.ID)
+	require.NotNil(t, vote.Vote)
+	assert.Equal(t, "cast", vote.Vote.Action)
+	assert.Equal(t, voter.ID, vote.Vote.UserID)
+	assert.Equal(t, []int{options[1].ID}, vote.Vote.OptionIDs)
+
+	closed := <-received
+	assert.Equal(t, events.TypePollClosed, closed.Event)
+	assert.Nil(t, closed.Vote)
+
+	list = listDeliveries()
+	require.Len(t, list.Data, 2)
+	for _, d := range list.Data {
+		assert.Equal(t, "succeeded", d.Status)
+		assert.Nil(t, d.NextAttemptAt)
+		assert.NotNil(t, d.DeliveredAt)
+		require.Len(t, d.Attempts, 1)
+		require.NotNil(t, d.Attempts[0].StatusCode)
+		assert.Equal(t, http.StatusOK, *d.Attempts[0].StatusCode)
+	}
+}
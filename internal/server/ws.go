@@ -0,0 +1,220 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// wsWriteTimeout is how long writing a single message to a WebSocket client
+// may take
+const wsWriteTimeout = 10 * time.Second
+
+// WebSocket message types
+const (
+	wsSubscribe    = "subscribe"
+	wsUnsubscribe  = "unsubscribe"
+	wsVote         = "vote"
+	wsSubscribed   = "subscribed"
+	wsUnsubscribed = "unsubscribed"
+	wsVoted        = "voted"
+	wsDelta        = "delta"
+	wsPresence     = "presence"
+	wsError        = "error"
+)
+
+// WSRequest represents a message from a WebSocket client: subscribe,
+// unsubscribe or vote. Votes carry the same fields as VoteRequest. Unlisted
+// polls are only reached with their share slug alongside the poll ID.
+type WSRequest struct {
+	Type   string `json:"type"`
+	PollID int    `json:"poll_id"`
+	Slug   string `json:"slug,omitempty"`
+	VoteRequest
+}
+
+// WSMessage represents a message to a WebSocket client. Subscribing and
+// voting are answered with a snapshot of the poll, votes by anyone produce a
+// delta with the changed counts for clients the poll's results are visible
+// to, and presence messages carry the number of clients subscribed to the
+// poll on the same replica.
+type WSMessage struct {
+	Type    string         `json:"type"`
+	PollID  int            `json:"poll_id,omitempty"`
+	Poll    *PollResponse  `json:"poll,omitempty"`
+	Changes []OptionDelta  `json:"changes,omitempty"`
+	Viewers int            `json:"viewers,omitempty"`
+	Error   *ErrorResponse `json:"error,omitempty"`
+}
+
+// OptionDelta represents a change in an option's vote count
+type OptionDelta struct {
+	OptionID  int `json:"option_id"`
+	VoteCount int `json:"vote_count"`
+	Delta     int `json:"delta"`
+}
+
+// HandleWebSocket handles a WebSocket connection on which clients subscribe
+// to polls for live vote counts and viewer counts. Authenticated clients can
+// also vote, with the same rules as HandleVote.
+func HandleWebSocket(logger *slog.Logger, client *ent.Client, bus events.Bus, hub *Hub) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, authenticated := middleware.PrincipalFromContext(r.Context())
+
+		// The connection outlives the server's read and write timeouts
+		rc := http.NewResponseController(w)
+		for _, setDeadline := range []func(time.Time) error{rc.SetReadDeadline, rc.SetWriteDeadline} {
+			if err := setDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+				logger.LogAttrs(r.Context(), slog.LevelError, "failed to clear deadline", slog.String("error", err.Error()))
+				writeInternalError(w, r, "failed to open websocket")
+				return
+			}
+		}
+
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			// Accept has already written the error response
+			logger.LogAttrs(r.Context(), slog.LevelInfo, "failed to accept websocket", slog.String("error", err.Error()))
+			return
+		}
+		defer conn.CloseNow()
+
+		logger.LogAttrs(r.Context(), slog.LevelInfo, "websocket: connected")
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		stop := context.AfterFunc(hub.ctx, func() {
+			_ = conn.Close(websocket.StatusGoingAway, "server shutting down")
+		})
+		defer stop()
+
+		c := hub.register(principal)
+		defer hub.unregister(c)
+
+		go writeWebSocket(ctx, conn, c)
+
+		for {
+			_, data, err := conn.Read(ctx)
+			if err != nil {
+				logger.LogAttrs(
+					r.Context(),
+					slog.LevelInfo,
+					"websocket: closed",
+					slog.Int("status", int(websocket.CloseStatus(err))),
+				)
+				return
+			}
+
+			var req WSRequest
+			if err := json.Unmarshal(data, &req); err != nil {
+				hub.send(c, wsErrorMessage(0, "invalid message", ErrCodeValidation))
+				continue
+			}
+			if req.PollID <= 0 {
+				hub.send(c, wsErrorMessage(0, "poll_id is required", ErrCodeValidation))
+				continue
+			}
+
+			msgCtx := ctx
+			if req.Slug != "" {
+				msgCtx = withShareSlug(ctx, req.Slug)
+			}
+
+			switch req.Type {
+			case wsSubscribe:
+				if err := hub.subscribe(msgCtx, c, req.PollID); err != nil {
+					if ent.IsNotFound(err) {
+						hub.send(c, wsErrorMessage(req.PollID, "poll not found", ErrCodeNotFound))
+						continue
+					}
+					logger.LogAttrs(r.Context(), slog.LevelError, "failed to subscribe", slog.String("error", err.Error()))
+					hub.send(c, wsErrorMessage(req.PollID, "failed to subscribe", ErrCodeInternal))
+				}
+			case wsUnsubscribe:
+				hub.unsubscribe(c, req.PollID)
+				hub.send(c, WSMessage{Type: wsUnsubscribed, PollID: req.PollID})
+			case wsVote:
+				if !authenticated {
+					hub.send(c, wsErrorMessage(req.PollID, "authentication required", ErrCodeUnauthorized))
+					continue
+				}
+				hub.send(c, castWebSocketVote(msgCtx, logger, client, bus, principal, req))
+			default:
+				hub.send(c, wsErrorMessage(req.PollID, "type must be one of: subscribe, unsubscribe, vote", ErrCodeValidation))
+			}
+		}
+	})
+}
+
+// castWebSocketVote submits a ballot received over a WebSocket and returns
+// the reply for the voter
+func castWebSocketVote(
+	ctx context.Context,
+	logger *slog.Logger,
+	client *ent.Client,
+	bus events.Bus,
+	principal middleware.Principal,
+	req WSRequest,
+) WSMessage {
+	p, optionIDs, err := submitBallot(ctx, client, req.PollID, principal, req.VoteRequest, castBallot)
+	if err != nil {
+		var rejected *ballotError
+		if errors.As(err, &rejected) {
+			return wsProblemMessage(req.PollID, rejected.problem())
+		}
+		logger.LogAttrs(ctx, slog.LevelError, "failed to submit vote", slog.String("error", err.Error()))
+		return wsErrorMessage(req.PollID, "failed to submit vote", ErrCodeInternal)
+	}
+	publishEvent(ctx, logger, bus, events.TypeVote, req.PollID)
+
+	response, err := loadPollResponse(ctx, client, req.PollID, principal)
+	if err != nil {
+		logger.LogAttrs(ctx, slog.LevelError, "failed to reload poll", slog.String("error", err.Error()))
+		return wsErrorMessage(req.PollID, "failed to submit vote", ErrCodeInternal)
+	}
+
+	logger.LogAttrs(ctx, slog.LevelInfo, "websocket vote: completed", ballotLogAttrs(p, optionIDs, principal.UserID)...)
+
+	return WSMessage{Type: wsVoted, PollID: req.PollID, Poll: &response}
+}
+
+// writeWebSocket writes the client's queued messages to the connection until
+// ctx is canceled, closing the connection if the hub drops the client
+func writeWebSocket(ctx context.Context, conn *websocket.Conn, c *hubClient) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.dropped:
+			_ = conn.Close(websocket.StatusPolicyViolation, "client too slow")
+			return
+		case data := <-c.send:
+			writeCtx, cancel := context.WithTimeout(ctx, wsWriteTimeout)
+			err := conn.Write(writeCtx, websocket.MessageText, data)
+			cancel()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// wsErrorMessage returns an error message for a WebSocket client
+func wsErrorMessage(pollID int, message, code string) WSMessage {
+	return wsProblemMessage(pollID, newErrorResponse(message, code))
+}
+
+// wsProblemMessage returns an error message for a WebSocket client carrying
+// the problem details
+func wsProblemMessage(pollID int, problem ErrorResponse) WSMessage {
+	return WSMessage{Type: wsError, PollID: pollID, Error: &problem}
+}
@@ -0,0 +1,171 @@
+//go:build integration
+
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/ivankorhner/polling-app/internal/config"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/server"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dialWebSocket opens a WebSocket to the server, authenticated with token
+// unless it is empty
+func dialWebSocket(ctx context.Context, t *testing.T, serverURL, token string) *websocket.Conn {
+	t.Helper()
+
+	opts := &websocket.DialOptions{HTTPHeader: http.Header{}}
+	if token != "" {
+		opts.HTTPHeader.Set("Authorization", "Bearer "+token)
+	}
+	conn, resp, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(serverURL, "http")+"/ws", opts)
+	require.NoError(t, err)
+	if resp.Body != nil {
+		resp.Body.Close()
+	}
+	t.Cleanup(func() { conn.CloseNow() })
+	return conn
+}
+
+// readMessage reads messages until one of the given type arrives
+func readMessage(ctx context.Context, t *testing.T, conn *websocket.Conn, msgType string) server.WSMessage {
+	t.Helper()
+
+	for {
+		var msg server.WSMessage
+		require.NoError(t, wsjson.Read(ctx, conn, &msg))
+		if msg.Type == msgType {
+			return msg
+		}
+	}
+}
+
+func TestHandleWebSocket(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	// A timeout far shorter than the test proves sockets are exempt from it
+	apiTimeout := 50 * time.Millisecond
+	srv := httptest.NewServer(server.AddRoutes(ctx, &config.Config{APITimeout: apiTimeout}, logger, testDB.DB, testDB.Client, events.NewMemory(logger)))
+	defer srv.Close()
+
+	post := func(path, token, body string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+path, bytes.NewBufferString(body))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	resp := post("/users", "", `{"username": "alice", "email": "alice@example.com"}`)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var alice server.RegisteredUserResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&alice))
+
+	resp = post("/polls", alice.Token, `{"title": "Lunch?", "options": ["Soup", "Salad"]}`)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var poll server.PollResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&poll))
+
+	readCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	voter := dialWebSocket(ctx, t, srv.URL, alice.Token)
+	viewer := dialWebSocket(ctx, t, srv.URL, "")
+
+	// Subscribing returns a snapshot and the viewer count
+	require.NoError(t, wsjson.Write(readCtx, voter, server.WSRequest{Type: "subscribe", PollID: poll.ID}))
+	msg := readMessage(readCtx, t, voter, "subscribed")
+	require.NotNil(t, msg.Poll)
+	assert.Equal(t, poll.ID, msg.Poll.ID)
+	assert.Equal(t, 1, readMessage(readCtx, t, voter, "presence").Viewers)
+
+	require.NoError(t, wsjson.Write(readCtx, viewer, server.WSRequest{Type: "subscribe", PollID: poll.ID}))
+	readMessage(readCtx, t, viewer, "subscribed")
+	assert.Equal(t, 2, readMessage(readCtx, t, viewer, "presence").Viewers)
+	assert.Equal(t, 2, readMessage(readCtx, t, voter, "presence").Viewers)
+
+	time.Sleep(2 * apiTimeout)
+
+	// Votes need a token, as on HTTP
+	vote := server.WSRequest{Type: "vote", PollID: poll.ID, VoteRequest: server.VoteRequest{OptionID: poll.Options[0].ID}}
+	require.NoError(t, wsjson.Write(readCtx, viewer, vote))
+	msg = readMessage(readCtx, t, viewer, "error")
+	require.NotNil(t, msg.Error)
+	assert.Equal(t, server.ErrCodeUnauthorized, msg.Error.Code)
+
+	// A vote is answered with the poll and fanned out as a delta
+	require.NoError(t, wsjson.Write(readCtx, voter, vote))
+	msg = readMessage(readCtx, t, voter, "voted")
+	require.NotNil(t, msg.Poll)
+	assert.Equal(t, 1, *msg.Poll.Options[0].VoteCount)
+
+	want := []server.OptionDelta{{OptionID: poll.Options[0].ID, VoteCount: 1, Delta: 1}}
+	assert.Equal(t, want, readMessage(readCtx, t, viewer, "delta").Changes)
+
+	// Ballots are validated like HandleVote
+	require.NoError(t, wsjson.Write(readCtx, voter, vote))
+	msg = readMessage(readCtx, t, voter, "error")
+	require.NotNil(t, msg.Error)
+	assert.Equal(t, server.ErrCodeConflict, msg.Error.Code)
+
+	// Leaving updates the remaining viewers
+	require.NoError(t, wsjson.Write(readCtx, viewer, server.WSRequest{Type: "unsubscribe", PollID: poll.ID}))
+	readMessage(readCtx, t, viewer, "unsubscribed")
+	assert.Equal(t, 1, readMessage(readCtx, t, voter, "presence").Viewers)
+
+	require.NoError(t, wsjson.Write(readCtx, viewer, server.WSRequest{Type: "subscribe", PollID: 99999}))
+	msg = readMessage(readCtx, t, viewer, "error")
+	require.NotNil(t, msg.Error)
+	assert.Equal(t, server.ErrCodeNotFound, msg.Error.Code)
+	assert.Equal(t, 99999, msg.PollID)
+
+	// Clients who may not see a poll never join its viewers
+	resp = post("/polls", alice.Token, `{"title": "Raises?", "options": ["Yes", "No"], "visibility": "private"}`)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	var private server.PollResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&private))
+
+	require.NoError(t, wsjson.Write(readCtx, voter, server.WSRequest{Type: "subscribe", PollID: private.ID}))
+	readMessage(readCtx, t, voter, "subscribed")
+	assert.Equal(t, 1, readMessage(readCtx, t, voter, "presence").Viewers)
+
+	require.NoError(t, wsjson.Write(readCtx, viewer, server.WSRequest{Type: "subscribe", PollID: private.ID}))
+	msg = readMessage(readCtx, t, viewer, "error")
+	require.NotNil(t, msg.Error)
+	assert.Equal(t, server.ErrCodeNotFound, msg.Error.Code)
+
+	// Had the viewer joined, a presence message would precede the vote reply
+	vote = server.WSRequest{Type: "vote", PollID: private.ID, VoteRequest: server.VoteRequest{OptionID: private.Options[0].ID}}
+	require.NoError(t, wsjson.Write(readCtx, voter, vote))
+	for {
+		var msg server.WSMessage
+		require.NoError(t, wsjson.Read(readCtx, voter, &msg))
+		require.NotEqual(t, "presence", msg.Type, "an unauthorized viewer changed the viewer count")
+		if msg.Type == "voted" {
+			break
+		}
+	}
+}
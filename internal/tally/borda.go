@@ -0,0 +1,21 @@
+package tally
+
+// Borda elects the option with the most points from ranked ballots. With n
+// options, a ballot gives n-1 points to its first preference, n-2 to its
+// second and so on; options left unranked receive no points.
+type Borda struct{}
+
+// Ballot implements Method
+func (Borda) Ballot() BallotKind { return RankedBallot }
+
+// Count implements Method. It returns a single round holding each option's
+// points, in which all options sharing the highest non-zero total win.
+func (Borda) Count(options []int, ballots []Ballot) Result {
+	counts := make(map[int]int, len(options))
+	for _, b := range ballots {
+		for i, optionID := range b.Options {
+			counts[optionID] += len(options) - 1 - i
+		}
+	}
+	return singleRound(options, counts)
+}
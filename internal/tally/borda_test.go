@@ -0,0 +1,56 @@
+package tally
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBorda(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     []int
+		ballots     []Ballot
+		wantWinners []int
+		wantPoints  map[int]int
+	}{
+		{
+			name:        "no ballots",
+			options:     []int{1, 2},
+			wantWinners: nil,
+			wantPoints:  map[int]int{1: 0, 2: 0},
+		},
+		{
+			name:        "Tennessee",
+			options:     tennesseeOptions,
+			ballots:     tennesseeBallots(),
+			wantWinners: []int{nashville},
+			wantPoints:  map[int]int{memphis: 126, nashville: 194, chattanooga: 173, knoxville: 107},
+		},
+		{
+			name:        "unranked options receive no points",
+			options:     []int{1, 2, 3},
+			ballots:     profile(weighted{2, ballot(1)}, weighted{1, ballot(3, 2)}),
+			wantWinners: []int{1},
+			wantPoints:  map[int]int{1: 4, 2: 1, 3: 2},
+		},
+		{
+			name:        "tie",
+			options:     []int{1, 2},
+			ballots:     profile(weighted{1, ballot(1, 2)}, weighted{1, ballot(2, 1)}),
+			wantWinners: []int{1, 2},
+			wantPoints:  map[int]int{1: 1, 2: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Borda{}.Count(tt.options, tt.ballots)
+
+			assert.Equal(t, tt.wantWinners, result.Winners)
+			require.Len(t, result.Rounds, 1)
+			assert.Equal(t, tt.wantPoints, votesByOption(result.Rounds[0]))
+		})
+	}
+}
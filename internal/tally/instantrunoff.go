@@ -0,0 +1,99 @@
+package tally
+
+import "slices"
+
+// InstantRunoff counts ranked ballots by instant-runoff voting. Each round
+// gives every ballot to its most preferred continuing option. An option with
+// a majority of the non-exhausted ballots wins; otherwise the option with the
+// fewest votes is eliminated and the ballots are counted again.
+//
+// Ties for last place are broken deterministically: the tied option with the
+// fewest votes in the most recent earlier round where the counts differ is
+// eliminated, and if they were tied in every round the one listed last in
+// options is eliminated.
+type InstantRunoff struct{}
+
+// Ballot implements Method
+func (InstantRunoff) Ballot() BallotKind { return RankedBallot }
+
+// Count implements Method. It returns one round per elimination.
+func (InstantRunoff) Count(options []int, ballots []Ballot) Result {
+	continuing := slices.Clone(options)
+	var rounds []Round
+
+	for {
+		counts := make(map[int]int, len(continuing))
+		exhausted := 0
+		for _, b := range ballots {
+			if optionID, ok := firstContinuing(b, continuing); ok {
+				counts[optionID]++
+			} else {
+				exhausted++
+			}
+		}
+
+		round := Round{
+			Number:    len(rounds) + 1,
+			Tallies:   tallies(continuing, counts),
+			Exhausted: exhausted,
+		}
+
+		active := len(ballots) - exhausted
+		if active == 0 {
+			return Result{Rounds: append(rounds, round)}
+		}
+
+		for _, optionID := range continuing {
+			if counts[optionID]*2 > active || len(continuing) == 1 {
+				return Result{Winners: []int{optionID}, Rounds: append(rounds, round)}
+			}
+		}
+
+		round.Eliminated = lastPlace(continuing, counts, rounds)
+		rounds = append(rounds, round)
+		continuing = slices.DeleteFunc(continuing, func(optionID int) bool {
+			return optionID == round.Eliminated
+		})
+	}
+}
+
+// firstContinuing returns the most preferred option on the ballot that is
+// still in the count
+func firstContinuing(b Ballot, continuing []int) (int, bool) {
+	for _, optionID := range b.Options {
+		if slices.Contains(continuing, optionID) {
+			return optionID, true
+		}
+	}
+	return 0, false
+}
+
+// lastPlace picks the option to eliminate, breaking ties by looking back
+// through earlier rounds and finally by position in continuing
+func lastPlace(continuing []int, counts map[int]int, previous []Round) int {
+	tied := fewest(continuing, counts)
+
+	for i := len(previous) - 1; i >= 0 && len(tied) > 1; i-- {
+		earlier := make(map[int]int, len(previous[i].Tallies))
+		for _, t := range previous[i].Tallies {
+			earlier[t.OptionID] = t.Votes
+		}
+		tied = fewest(tied, earlier)
+	}
+
+	return tied[len(tied)-1]
+}
+
+// fewest returns the options that share the lowest count
+func fewest(options []int, counts map[int]int) []int {
+	var result []int
+	for _, optionID := range options {
+		switch {
+		case len(result) == 0 || counts[optionID] < counts[result[0]]:
+			result = []int{optionID}
+		case counts[optionID] == counts[result[0]]:
+			result = append(result, optionID)
+		}
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+package tally
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstantRunoff_Tennessee(t *testing.T) {
+	result := InstantRunoff{}.Count(tennesseeOptions, tennesseeBallots())
+
+	assert.Equal(t, []int{knoxville}, result.Winners)
+	require.Len(t, result.Rounds, 3)
+
+	assert.Equal(t, map[int]int{memphis: 42, nashville: 26, chattanooga: 15, knoxville: 17}, votesByOption(result.Rounds[0]))
+	assert.Equal(t, chattanooga, result.Rounds[0].Eliminated)
+
+	assert.Equal(t, map[int]int{memphis: 42, nashville: 26, knoxville: 32}, votesByOption(result.Rounds[1]))
+	assert.Equal(t, nashville, result.Rounds[1].Eliminated)
+
+	assert.Equal(t, map[int]int{memphis: 42, knoxville: 58}, votesByOption(result.Rounds[2]))
+	assert.Zero(t, result.Rounds[2].Eliminated)
+}
+
+func TestInstantRunoff(t *testing.T) {
+	tests := []struct {
+		name           string
+		options        []int
+		ballots        []Ballot
+		wantWinners    []int
+		wantRounds     int
+		wantEliminated []int
+		wantExhausted  int
+	}{
+		{
+			name:        "no ballots",
+			options:     []int{1, 2, 3},
+			ballots:     nil,
+			wantWinners: nil,
+			wantRounds:  1,
+		},
+		{
+			name:        "first round majority",
+			options:     []int{1, 2, 3},
+			ballots:     profile(weighted{3, ballot(1)}, weighted{1, ballot(2)}, weighted{1, ballot(3)}),
+			wantWinners: []int{1},
+			wantRounds:  1,
+		},
+		{
+			name:    "exhausted ballots leave the count",
+			options: []int{1, 2, 3},
+			ballots: profile(
+				weighted{4, ballot(1)},
+				weighted{3, ballot(2)},
+				weighted{2, ballot(3)},
+			),
+			wantWinners:    []int{1},
+			wantRounds:     2,
+			wantEliminated: []int{3},
+			wantExhausted:  2,
+		},
+		{
+			name:    "tie for last broken by earlier round",
+			options: []int{1, 2, 3, 4},
+			ballots: profile(
+				weighted{5, ballot(1)},
+				weighted{4, ballot(2)},
+				weighted{3, ballot(3)},
+				weighted{1, ballot(4, 2)},
+				weighted{2, ballot(4, 3)},
+			),
+			// Round 1: 1=5 2=4 3=3 4=3; 3 and 4 tie in every round, 4 is listed last
+			// Round 2: 1=5 2=5 3=5; round 1 had 3 behind 1 and 2
+			// Round 3: 1=5 2=5; round 2 is tied too, round 1 had 2 behind 1
+			wantWinners:    []int{1},
+			wantRounds:     4,
+			wantEliminated: []int{4, 3, 2},
+			wantExhausted:  10,
+		},
+		{
+			name:           "exact tie resolved by option order",
+			options:        []int{1, 2},
+			ballots:        profile(weighted{2, ballot(1)}, weighted{2, ballot(2)}),
+			wantWinners:    []int{1},
+			wantRounds:     2,
+			wantEliminated: []int{2},
+			wantExhausted:  2,
+		},
+		{
+			name:        "single option",
+			options:     []int{1},
+			ballots:     profile(weighted{1, ballot(1)}),
+			wantWinners: []int{1},
+			wantRounds:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := InstantRunoff{}.Count(tt.options, tt.ballots)
+
+			assert.Equal(t, tt.wantWinners, result.Winners)
+			require.Len(t, result.Rounds, tt.wantRounds)
+
+			var eliminated []int
+			for i, r := range result.Rounds {
+				assert.Equal(t, i+1, r.Number)
+				if r.Eliminated != 0 {
+					eliminated = append(eliminated, r.Eliminated)
+				}
+			}
+			assert.Equal(t, tt.wantEliminated, eliminated)
+			assert.Equal(t, tt.wantExhausted, result.Rounds[len(result.Rounds)-1].Exhausted)
+		})
+	}
+}
+
+func TestInstantRunoff_Deterministic(t *testing.T) {
+	ballots := profile(
+		weighted{3, ballot(1, 2)},
+		weighted{3, ballot(2, 3)},
+		weighted{3, ballot(3, 1)},
+	)
+
+	first := InstantRunoff{}.Count([]int{1, 2, 3}, ballots)
+	for range 10 {
+		assert.Equal(t, first, InstantRunoff{}.Count([]int{1, 2, 3}, ballots))
+	}
+}
+
+func TestInstantRunoff_DoesNotModifyInput(t *testing.T) {
+	options := []int{1, 2, 3}
+	ballots := profile(weighted{2, ballot(1)}, weighted{1, ballot(2)}, weighted{1, ballot(3)})
+
+	InstantRunoff{}.Count(options, ballots)
+
+	assert.Equal(t, []int{1, 2, 3}, options)
+}
@@ -0,0 +1,38 @@
+package tally
+
+// BallotKind describes how voters mark ballots for a method
+type BallotKind int
+
+const (
+	// SelectionBallot ballots mark options without order
+	SelectionBallot BallotKind = iota
+	// RankedBallot ballots order options by preference
+	RankedBallot
+	// ScoredBallot ballots give each marked option a score
+	ScoredBallot
+)
+
+// Method is a voting method that determines the winners of a poll
+type Method interface {
+	// Ballot reports how ballots are marked for the method
+	Ballot() BallotKind
+	// Count tallies the ballots cast for options. Options are listed in
+	// creation order, which methods may use to break ties.
+	Count(options []int, ballots []Ballot) Result
+}
+
+// methods maps voting method names, as stored on polls, to their Method
+var methods = map[string]Method{
+	"plurality":      Plurality{},
+	"approval":       Approval{},
+	"borda":          Borda{},
+	"instant_runoff": InstantRunoff{},
+	"schulze":        Schulze{},
+	"score":          Score{},
+}
+
+// Lookup returns the voting method with the given name
+func Lookup(name string) (Method, bool) {
+	m, ok := methods[name]
+	return m, ok
+}
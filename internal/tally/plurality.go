@@ -0,0 +1,39 @@
+package tally
+
+// Plurality elects the option selected on the most ballots. Ballots on
+// multiple-choice polls count once for each option they select.
+type Plurality struct{}
+
+// Ballot implements Method
+func (Plurality) Ballot() BallotKind { return SelectionBallot }
+
+// Count implements Method. It returns a single round in which all options
+// sharing the highest non-zero count win.
+func (Plurality) Count(options []int, ballots []Ballot) Result {
+	return singleRound(options, selections(ballots))
+}
+
+// Approval elects the option approved by the most voters. The count matches
+// Plurality; the methods differ in that approval voters may select as many
+// options as they like.
+type Approval struct{}
+
+// Ballot implements Method
+func (Approval) Ballot() BallotKind { return SelectionBallot }
+
+// Count implements Method. It returns a single round in which all options
+// sharing the highest non-zero number of approvals win.
+func (Approval) Count(options []int, ballots []Ballot) Result {
+	return singleRound(options, selections(ballots))
+}
+
+// selections counts how many ballots mark each option
+func selections(ballots []Ballot) map[int]int {
+	counts := make(map[int]int)
+	for _, b := range ballots {
+		for _, optionID := range b.Options {
+			counts[optionID]++
+		}
+	}
+	return counts
+}
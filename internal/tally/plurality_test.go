@@ -0,0 +1,79 @@
+package tally
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlurality(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     []int
+		ballots     []Ballot
+		wantWinners []int
+		wantVotes   map[int]int
+	}{
+		{
+			name:        "no ballots",
+			options:     []int{1, 2},
+			wantWinners: nil,
+			wantVotes:   map[int]int{1: 0, 2: 0},
+		},
+		{
+			name:        "single winner",
+			options:     []int{1, 2, 3},
+			ballots:     profile(weighted{2, ballot(1)}, weighted{1, ballot(2)}),
+			wantWinners: []int{1},
+			wantVotes:   map[int]int{1: 2, 2: 1, 3: 0},
+		},
+		{
+			name:        "tie",
+			options:     []int{1, 2, 3},
+			ballots:     profile(weighted{2, ballot(1)}, weighted{2, ballot(3)}),
+			wantWinners: []int{1, 3},
+			wantVotes:   map[int]int{1: 2, 2: 0, 3: 2},
+		},
+		{
+			name:        "multiple selections per ballot",
+			options:     []int{1, 2, 3},
+			ballots:     profile(weighted{2, ballot(1, 2)}, weighted{1, ballot(2, 3)}),
+			wantWinners: []int{2},
+			wantVotes:   map[int]int{1: 2, 2: 3, 3: 1},
+		},
+		{
+			name:        "Tennessee first preferences",
+			options:     tennesseeOptions,
+			ballots:     firstPreferences(tennesseeBallots()),
+			wantWinners: []int{memphis},
+			wantVotes:   map[int]int{memphis: 42, nashville: 26, chattanooga: 15, knoxville: 17},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Plurality{}.Count(tt.options, tt.ballots)
+
+			assert.Equal(t, tt.wantWinners, result.Winners)
+			require.Len(t, result.Rounds, 1)
+			assert.Equal(t, tt.wantVotes, votesByOption(result.Rounds[0]))
+		})
+	}
+}
+
+func TestApproval_Tennessee(t *testing.T) {
+	// Voters approve their favourite and any city they consider close enough
+	ballots := profile(
+		weighted{42, ballot(memphis)},
+		weighted{26, ballot(nashville, chattanooga)},
+		weighted{15, ballot(chattanooga, knoxville)},
+		weighted{17, ballot(knoxville, chattanooga)},
+	)
+
+	result := Approval{}.Count(tennesseeOptions, ballots)
+
+	assert.Equal(t, []int{chattanooga}, result.Winners)
+	require.Len(t, result.Rounds, 1)
+	assert.Equal(t, map[int]int{memphis: 42, nashville: 26, chattanooga: 58, knoxville: 32}, votesByOption(result.Rounds[0]))
+}
@@ -0,0 +1,190 @@
+package tally
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Properties every method must satisfy, checked against randomly generated
+// elections. The generator is seeded so failures are reproducible.
+
+const (
+	propertyElections = 200
+	maxTestScore      = 10
+)
+
+// randomElection returns between two and six options and up to fifty ballots
+// of the kind the method expects. Ranked and selection ballots mark a random
+// prefix of a random ordering; scored ballots score every option.
+func randomElection(rng *rand.Rand, kind BallotKind) ([]int, []Ballot) {
+	options := make([]int, 2+rng.IntN(5))
+	for i := range options {
+		options[i] = (i + 1) * 10
+	}
+
+	ballots := make([]Ballot, rng.IntN(51))
+	for i := range ballots {
+		order := slices.Clone(options)
+		rng.Shuffle(len(order), func(x, y int) { order[x], order[y] = order[y], order[x] })
+
+		switch kind {
+		case ScoredBallot:
+			scores := make([]int, len(order))
+			for j := range scores {
+				scores[j] = rng.IntN(maxTestScore + 1)
+			}
+			ballots[i] = Ballot{Options: order, Scores: scores}
+		default:
+			ballots[i] = ballot(order[:1+rng.IntN(len(order))]...)
+		}
+	}
+	return options, ballots
+}
+
+// forEachMethod runs fn on random elections for every registered method
+func forEachMethod(t *testing.T, fn func(t *testing.T, rng *rand.Rand, m Method)) {
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(1, 2))
+			for i := range propertyElections {
+				t.Run(fmt.Sprint(i), func(t *testing.T) {
+					fn(t, rng, methods[name])
+				})
+			}
+		})
+	}
+}
+
+func TestProperty_WinnersAreOptions(t *testing.T) {
+	forEachMethod(t, func(t *testing.T, rng *rand.Rand, m Method) {
+		options, ballots := randomElection(rng, m.Ballot())
+		result := m.Count(options, ballots)
+
+		for _, w := range result.Winners {
+			assert.Contains(t, options, w)
+		}
+		if len(ballots) == 0 {
+			assert.Empty(t, result.Winners)
+		}
+		for i, r := range result.Rounds {
+			assert.Equal(t, i+1, r.Number)
+		}
+	})
+}
+
+func TestProperty_Anonymity(t *testing.T) {
+	// Reordering the ballots does not change the result
+	forEachMethod(t, func(t *testing.T, rng *rand.Rand, m Method) {
+		options, ballots := randomElection(rng, m.Ballot())
+		want := m.Count(options, ballots)
+
+		shuffled := slices.Clone(ballots)
+		rng.Shuffle(len(shuffled), func(x, y int) { shuffled[x], shuffled[y] = shuffled[y], shuffled[x] })
+
+		assert.Equal(t, want, m.Count(options, shuffled))
+	})
+}
+
+func TestProperty_Unanimity(t *testing.T) {
+	// An option every voter prefers to all others is the only winner
+	forEachMethod(t, func(t *testing.T, rng *rand.Rand, m Method) {
+		options, ballots := randomElection(rng, m.Ballot())
+		if len(ballots) == 0 {
+			return
+		}
+		favourite := options[rng.IntN(len(options))]
+
+		for i, b := range ballots {
+			order := slices.DeleteFunc(slices.Clone(b.Options), func(o int) bool { return o == favourite })
+			order = append([]int{favourite}, order...)
+
+			switch m.Ballot() {
+			case ScoredBallot:
+				scores := make([]int, len(order))
+				scores[0] = maxTestScore
+				for j := 1; j < len(scores); j++ {
+					scores[j] = rng.IntN(maxTestScore)
+				}
+				ballots[i] = Ballot{Options: order, Scores: scores}
+			case SelectionBallot:
+				ballots[i] = ballot(favourite)
+			default:
+				ballots[i] = ballot(order...)
+			}
+		}
+
+		assert.Equal(t, []int{favourite}, m.Count(options, ballots).Winners)
+	})
+}
+
+func TestProperty_RoundsAccountForEveryBallot(t *testing.T) {
+	// Each instant-runoff round holds every ballot, either with a
+	// continuing option or as exhausted
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range propertyElections {
+		options, ballots := randomElection(rng, RankedBallot)
+		result := InstantRunoff{}.Count(options, ballots)
+
+		for _, r := range result.Rounds {
+			total := r.Exhausted
+			for _, ot := range r.Tallies {
+				total += ot.Votes
+			}
+			require.Equal(t, len(ballots), total)
+		}
+	}
+}
+
+func TestProperty_BordaPointsTotal(t *testing.T) {
+	// Complete ballots award n(n-1)/2 points each
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range propertyElections {
+		options, ballots := randomElection(rng, RankedBallot)
+		for i := range ballots {
+			missing := slices.DeleteFunc(slices.Clone(options), func(o int) bool {
+				return slices.Contains(ballots[i].Options, o)
+			})
+			ballots[i] = ballot(append(slices.Clone(ballots[i].Options), missing...)...)
+		}
+
+		result := Borda{}.Count(options, ballots)
+		total := 0
+		for _, ot := range result.Rounds[0].Tallies {
+			total += ot.Votes
+		}
+		n := len(options)
+		require.Equal(t, len(ballots)*n*(n-1)/2, total)
+	}
+}
+
+func TestProperty_SchulzeElectsCondorcetWinner(t *testing.T) {
+	// An option that beats every other option head to head wins
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range propertyElections {
+		options, ballots := randomElection(rng, RankedBallot)
+		d := pairwise(options, ballots)
+
+		for i, optionID := range options {
+			condorcet := true
+			for j := range options {
+				if i != j && d[i][j] <= d[j][i] {
+					condorcet = false
+				}
+			}
+			if condorcet {
+				require.Equal(t, []int{optionID}, Schulze{}.Count(options, ballots).Winners)
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package tally
+
+import "slices"
+
+// Schulze elects the Condorcet winner when one exists and otherwise resolves
+// the preference cycle by the Schulze method. Each pair of options is
+// compared by the number of ballots ranking one above the other, ranked
+// options being preferred to unranked ones. The strength of a path between
+// options is its weakest pairwise win, and an option beats another when its
+// strongest path to it is stronger than the reverse.
+type Schulze struct{}
+
+// Ballot implements Method
+func (Schulze) Ballot() BallotKind { return RankedBallot }
+
+// Count implements Method. It returns a single round holding the number of
+// options each option beats. Options that no other option beats win.
+func (Schulze) Count(options []int, ballots []Ballot) Result {
+	if len(ballots) == 0 {
+		return singleRound(options, nil)
+	}
+
+	paths := strongestPaths(pairwise(options, ballots))
+
+	counts := make(map[int]int, len(options))
+	var winners []int
+	for i, optionID := range options {
+		beaten := false
+		for j := range options {
+			switch {
+			case paths[i][j] > paths[j][i]:
+				counts[optionID]++
+			case paths[j][i] > paths[i][j]:
+				beaten = true
+			}
+		}
+		if !beaten {
+			winners = append(winners, optionID)
+		}
+	}
+
+	round := Round{Number: 1, Tallies: tallies(options, counts)}
+	return Result{Winners: slices.Clip(winners), Rounds: []Round{round}}
+}
+
+// pairwise returns d where d[i][j] is the number of ballots that prefer
+// options[i] to options[j]
+func pairwise(options []int, ballots []Ballot) [][]int {
+	index := make(map[int]int, len(options))
+	for i, optionID := range options {
+		index[optionID] = i
+	}
+
+	d := make([][]int, len(options))
+	for i := range d {
+		d[i] = make([]int, len(options))
+	}
+
+	for _, b := range ballots {
+		ranked := make([]bool, len(options))
+		for _, optionID := range b.Options {
+			i, ok := index[optionID]
+			if !ok {
+				continue
+			}
+			// Preferred to every option not yet ranked above it
+			for j := range options {
+				if j != i && !ranked[j] {
+					d[i][j]++
+				}
+			}
+			ranked[i] = true
+		}
+	}
+	return d
+}
+
+// strongestPaths returns p where p[i][j] is the strength of the strongest
+// path from option i to option j, following only pairwise wins
+func strongestPaths(d [][]int) [][]int {
+	n := len(d)
+	p := make([][]int, n)
+	for i := range p {
+		p[i] = make([]int, n)
+		for j := range n {
+			if i != j && d[i][j] > d[j][i] {
+				p[i][j] = d[i][j]
+			}
+		}
+	}
+
+	for k := range n {
+		for i := range n {
+			if i == k {
+				continue
+			}
+			for j := range n {
+				if j == i || j == k {
+					continue
+				}
+				p[i][j] = max(p[i][j], min(p[i][k], p[k][j]))
+			}
+		}
+	}
+	return p
+}
@@ -0,0 +1,111 @@
+package tally
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The 45-voter example from the description of the Schulze method, in which
+// no Condorcet winner exists
+const (
+	a = iota + 1
+	b
+	c
+	d
+	e
+)
+
+func schulzeBallots() []Ballot {
+	return profile(
+		weighted{5, ballot(a, c, b, e, d)},
+		weighted{5, ballot(a, d, e, c, b)},
+		weighted{8, ballot(b, e, d, a, c)},
+		weighted{3, ballot(c, a, b, e, d)},
+		weighted{7, ballot(c, a, e, b, d)},
+		weighted{2, ballot(c, b, a, d, e)},
+		weighted{7, ballot(d, c, e, b, a)},
+		weighted{8, ballot(e, b, a, d, c)},
+	)
+}
+
+func TestSchulze_PairwiseAndPaths(t *testing.T) {
+	options := []int{a, b, c, d, e}
+
+	preferences := pairwise(options, schulzeBallots())
+	assert.Equal(t, [][]int{
+		{0, 20, 26, 30, 22},
+		{25, 0, 16, 33, 18},
+		{19, 29, 0, 17, 24},
+		{15, 12, 28, 0, 14},
+		{23, 27, 21, 31, 0},
+	}, preferences)
+
+	assert.Equal(t, [][]int{
+		{0, 28, 28, 30, 24},
+		{25, 0, 28, 33, 24},
+		{25, 29, 0, 29, 24},
+		{25, 28, 28, 0, 24},
+		{25, 28, 28, 31, 0},
+	}, strongestPaths(preferences))
+}
+
+func TestSchulze(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     []int
+		ballots     []Ballot
+		wantWinners []int
+		wantBeats   map[int]int
+	}{
+		{
+			name:        "no ballots",
+			options:     []int{1, 2},
+			wantWinners: nil,
+			wantBeats:   map[int]int{1: 0, 2: 0},
+		},
+		{
+			name:        "Tennessee elects the Condorcet winner",
+			options:     tennesseeOptions,
+			ballots:     tennesseeBallots(),
+			wantWinners: []int{nashville},
+			wantBeats:   map[int]int{memphis: 0, nashville: 3, chattanooga: 2, knoxville: 1},
+		},
+		{
+			name:        "preference cycle",
+			options:     []int{a, b, c, d, e},
+			ballots:     schulzeBallots(),
+			wantWinners: []int{e},
+			wantBeats:   map[int]int{a: 3, b: 1, c: 2, d: 0, e: 4},
+		},
+		{
+			name:    "symmetric cycle ties",
+			options: []int{1, 2, 3},
+			ballots: profile(
+				weighted{1, ballot(1, 2, 3)},
+				weighted{1, ballot(2, 3, 1)},
+				weighted{1, ballot(3, 1, 2)},
+			),
+			wantWinners: []int{1, 2, 3},
+			wantBeats:   map[int]int{1: 0, 2: 0, 3: 0},
+		},
+		{
+			name:        "ranked options beat unranked ones",
+			options:     []int{1, 2, 3},
+			ballots:     profile(weighted{2, ballot(3)}, weighted{1, ballot(1, 2)}),
+			wantWinners: []int{3},
+			wantBeats:   map[int]int{1: 1, 2: 0, 3: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Schulze{}.Count(tt.options, tt.ballots)
+
+			assert.Equal(t, tt.wantWinners, result.Winners)
+			require.Len(t, result.Rounds, 1)
+			assert.Equal(t, tt.wantBeats, votesByOption(result.Rounds[0]))
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package tally
+
+// Score elects the option with the highest total score. Voters score each
+// option independently; options left unscored count as zero.
+type Score struct{}
+
+// Ballot implements Method
+func (Score) Ballot() BallotKind { return ScoredBallot }
+
+// Count implements Method. It returns a single round holding each option's
+// total score, in which all options sharing the highest non-zero total win.
+func (Score) Count(options []int, ballots []Ballot) Result {
+	counts := make(map[int]int, len(options))
+	for _, b := range ballots {
+		for i, optionID := range b.Options {
+			counts[optionID] += b.Scores[i]
+		}
+	}
+	return singleRound(options, counts)
+}
@@ -0,0 +1,62 @@
+package tally
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     []int
+		ballots     []Ballot
+		wantWinners []int
+		wantTotals  map[int]int
+	}{
+		{
+			name:        "no ballots",
+			options:     []int{1, 2},
+			wantWinners: nil,
+			wantTotals:  map[int]int{1: 0, 2: 0},
+		},
+		{
+			name:    "Tennessee",
+			options: tennesseeOptions,
+			// Scores out of 10, higher for cities closer to the voter
+			ballots: profile(
+				weighted{42, scored(memphis, 10, nashville, 4, chattanooga, 2, knoxville, 0)},
+				weighted{26, scored(memphis, 0, nashville, 10, chattanooga, 4, knoxville, 2)},
+				weighted{15, scored(memphis, 0, nashville, 6, chattanooga, 10, knoxville, 6)},
+				weighted{17, scored(memphis, 0, nashville, 5, chattanooga, 7, knoxville, 10)},
+			),
+			wantWinners: []int{nashville},
+			wantTotals:  map[int]int{memphis: 420, nashville: 603, chattanooga: 457, knoxville: 312},
+		},
+		{
+			name:        "unscored options count as zero",
+			options:     []int{1, 2, 3},
+			ballots:     profile(weighted{1, scored(1, 3)}, weighted{1, scored(2, 5)}),
+			wantWinners: []int{2},
+			wantTotals:  map[int]int{1: 3, 2: 5, 3: 0},
+		},
+		{
+			name:        "only zero scores",
+			options:     []int{1, 2},
+			ballots:     profile(weighted{2, scored(1, 0, 2, 0)}),
+			wantWinners: nil,
+			wantTotals:  map[int]int{1: 0, 2: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Score{}.Count(tt.options, tt.ballots)
+
+			assert.Equal(t, tt.wantWinners, result.Winners)
+			require.Len(t, result.Rounds, 1)
+			assert.Equal(t, tt.wantTotals, votesByOption(result.Rounds[0]))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Package tally counts ballots and determines the winners of a poll.
+package tally
+
+import "slices"
+
+// Ballot is the set of marks made by one voter
+type Ballot struct {
+	// Options are the options marked on the ballot. For ranked methods the
+	// order is the voter's preference order, most preferred first.
+	Options []int
+	// Scores holds the score given to each of Options on scored ballots
+	Scores []int
+}
+
+// OptionTally is the count an option holds in a counting round. What Votes
+// measures depends on the method: selections, points or score totals.
+type OptionTally struct {
+	OptionID int
+	Votes    int
+}
+
+// Round is a single counting round
+type Round struct {
+	Number  int
+	Tallies []OptionTally
+	// Exhausted is the number of ballots without a continuing option
+	Exhausted int
+	// Eliminated is the option removed at the end of the round, or zero
+	Eliminated int
+}
+
+// Result is the outcome of a count. Winners holds several options only when
+// the method cannot break a tie; it is empty when no ballots were cast.
+type Result struct {
+	Winners []int
+	Rounds  []Round
+}
+
+// singleRound returns a one-round result in which the options sharing the
+// highest non-zero count win
+func singleRound(options []int, counts map[int]int) Result {
+	round := Round{Number: 1, Tallies: tallies(options, counts)}
+	return Result{Winners: leaders(options, counts), Rounds: []Round{round}}
+}
+
+// tallies returns the counts of the given options in their original order
+func tallies(options []int, counts map[int]int) []OptionTally {
+	result := make([]OptionTally, len(options))
+	for i, optionID := range options {
+		result[i] = OptionTally{OptionID: optionID, Votes: counts[optionID]}
+	}
+	return result
+}
+
+// leaders returns the options sharing the highest non-zero count
+func leaders(options []int, counts map[int]int) []int {
+	best := 0
+	var winners []int
+	for _, optionID := range options {
+		switch c := counts[optionID]; {
+		case c > best:
+			best = c
+			winners = []int{optionID}
+		case c == best && c > 0:
+			winners = append(winners, optionID)
+		}
+	}
+	return slices.Clip(winners)
+}
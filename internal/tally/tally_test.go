@@ -0,0 +1,102 @@
+package tally
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Options of the Tennessee capital election, a standard example for
+// ranked voting methods
+const (
+	memphis = iota + 1
+	nashville
+	chattanooga
+	knoxville
+)
+
+var tennesseeOptions = []int{memphis, nashville, chattanooga, knoxville}
+
+// tennesseeBallots returns the electorate in percent: 42% Memphis,
+// 26% Nashville, 15% Chattanooga and 17% Knoxville as first preference
+func tennesseeBallots() []Ballot {
+	return profile(
+		weighted{42, ballot(memphis, nashville, chattanooga, knoxville)},
+		weighted{26, ballot(nashville, chattanooga, knoxville, memphis)},
+		weighted{15, ballot(chattanooga, knoxville, nashville, memphis)},
+		weighted{17, ballot(knoxville, chattanooga, nashville, memphis)},
+	)
+}
+
+// ballot returns a ballot marking the options in order
+func ballot(options ...int) Ballot {
+	return Ballot{Options: options}
+}
+
+// scored returns a scored ballot from alternating option and score values
+func scored(pairs ...int) Ballot {
+	var b Ballot
+	for i := 0; i < len(pairs); i += 2 {
+		b.Options = append(b.Options, pairs[i])
+		b.Scores = append(b.Scores, pairs[i+1])
+	}
+	return b
+}
+
+type weighted struct {
+	count  int
+	ballot Ballot
+}
+
+// profile expands weighted ballots into a list of individual ballots
+func profile(groups ...weighted) []Ballot {
+	var ballots []Ballot
+	for _, g := range groups {
+		for range g.count {
+			ballots = append(ballots, g.ballot)
+		}
+	}
+	return ballots
+}
+
+func votesByOption(r Round) map[int]int {
+	result := make(map[int]int, len(r.Tallies))
+	for _, t := range r.Tallies {
+		result[t.OptionID] = t.Votes
+	}
+	return result
+}
+
+// firstPreferences truncates ranked ballots to their first choice
+func firstPreferences(ballots []Ballot) []Ballot {
+	result := make([]Ballot, len(ballots))
+	for i, b := range ballots {
+		result[i] = ballot(b.Options[0])
+	}
+	return result
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		wantKind BallotKind
+	}{
+		{name: "plurality", wantKind: SelectionBallot},
+		{name: "approval", wantKind: SelectionBallot},
+		{name: "borda", wantKind: RankedBallot},
+		{name: "instant_runoff", wantKind: RankedBallot},
+		{name: "schulze", wantKind: RankedBallot},
+		{name: "score", wantKind: ScoredBallot},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, ok := Lookup(tt.name)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantKind, m.Ballot())
+		})
+	}
+
+	_, ok := Lookup("coin_toss")
+	assert.False(t, ok)
+}
@@ -0,0 +1,62 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+)
+
+// CreatePoll creates a poll owned by the user with an option per text, in the
+// same order. set, when not nil, adjusts the poll before it is saved.
+func CreatePoll(ctx context.Context, t testing.TB, client *ent.Client, ownerID int, title string, options []string, set func(*ent.PollCreate)) (*ent.Poll, []*ent.PollOption) {
+	t.Helper()
+
+	create := client.Poll.Create().
+		SetOwnerID(ownerID).
+		SetTitle(title)
+	if set != nil {
+		set(create)
+	}
+	p, err := create.Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create poll %q: %v", title, err)
+	}
+
+	creates := make([]*ent.PollOptionCreate, len(options))
+	for i, text := range options {
+		creates[i] = client.PollOption.Create().SetPollID(p.ID).SetText(text)
+	}
+	created, err := client.PollOption.CreateBulk(creates...).Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create options of poll %q: %v", title, err)
+	}
+	return p, created
+}
+
+// CastVote records the user's unranked ballot for the options straight in the
+// database, bypassing the vote handlers
+func CastVote(ctx context.Context, t testing.TB, client *ent.Client, pollID, userID int, optionIDs ...int) *ent.Ballot {
+	t.Helper()
+
+	b, err := client.Ballot.Create().
+		SetPollID(pollID).
+		SetUserID(userID).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed to create ballot: %v", err)
+	}
+
+	for _, optionID := range optionIDs {
+		_, err := client.Vote.Create().
+			SetBallotID(b.ID).
+			SetPollID(pollID).
+			SetOptionID(optionID).
+			SetUserID(userID).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create vote: %v", err)
+		}
+	}
+	return b
+}
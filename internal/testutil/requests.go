@@ -0,0 +1,27 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/ivankorhner/polling-app/internal/server/middleware"
+)
+
+// NewRequest returns a request with body encoded as JSON, authenticated as
+// the user as the authentication middleware would leave it. It has no body
+// when body is nil and is unauthenticated when userID is zero.
+func NewRequest(method, target string, userID int, body any) *http.Request {
+	var reader io.Reader
+	if body != nil {
+		data, _ := json.Marshal(body)
+		reader = bytes.NewReader(data)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	if userID != 0 {
+		req = req.WithContext(middleware.WithPrincipal(req.Context(), middleware.Principal{UserID: userID}))
+	}
+	return req
+}
@@ -28,11 +28,13 @@ const (
 type TestDB struct {
 	Container testcontainers.Container
 	Client    *ent.Client
+	// DB is the connection pool behind Client, for bulk fixtures in raw SQL
+	DB *sql.DB
 }
 
 // SetupTestDB creates a new PostgreSQL container and returns an Ent client.
 // The container is ephemeral with no volumes.
-func SetupTestDB(ctx context.Context, t *testing.T) *TestDB {
+func SetupTestDB(ctx context.Context, t testing.TB) *TestDB {
 	t.Helper()
 
 	container, err := postgres.Run(ctx,
@@ -75,6 +77,7 @@ func SetupTestDB(ctx context.Context, t *testing.T) *TestDB {
 	return &TestDB{
 		Container: container,
 		Client:    client,
+		DB:        db,
 	}
 }
 
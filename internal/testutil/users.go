@@ -0,0 +1,27 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+)
+
+// CreateUsers creates a user per name, with the email name@example.com, and
+// returns them in the same order
+func CreateUsers(ctx context.Context, t testing.TB, client *ent.Client, names ...string) []*ent.User {
+	t.Helper()
+
+	users := make([]*ent.User, len(names))
+	for i, name := range names {
+		u, err := client.User.Create().
+			SetUsername(name).
+			SetEmail(name + "@example.com").
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed to create user %q: %v", name, err)
+		}
+		users[i] = u
+	}
+	return users
+}
@@ -0,0 +1,69 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrTargetNotAllowed is returned when a delivery would connect to an address
+// inside the deployment
+var ErrTargetNotAllowed = errors.New("webhook target address not allowed")
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598), which is
+// private in practice but not reported by netip.Addr.IsPrivate
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// PublicAddr reports whether webhooks may be sent to addr: anything but
+// loopback, private, link-local (which covers cloud metadata endpoints),
+// multicast and unspecified addresses
+func PublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// newHTTPClient returns the client deliveries are sent with. The address is
+// checked after the host name is resolved, so neither DNS names nor
+// rebinding reach the deployment, and redirects are returned rather than
+// followed. allowPrivate reports whether the check is skipped.
+func newHTTPClient(allowPrivate func() bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: requestTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			if allowPrivate() {
+				return nil
+			}
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !PublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrTargetNotAllowed, address)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			// A proxy would be dialed instead of the target, skipping the check
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: requestTimeout,
+			IdleConnTimeout:     90 * time.Second,
+			MaxIdleConns:        100,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
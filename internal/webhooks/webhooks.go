@@ -0,0 +1,112 @@
+// Package webhooks queues poll events for webhook subscribers and delivers
+// them with signed HTTP requests.
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/webhook"
+	"github.com/ivankorhner/polling-app/internal/events"
+)
+
+// secretPrefix marks strings issued as webhook secrets
+const secretPrefix = "whsec_"
+
+// Events are the event types a webhook can subscribe to
+var Events = []events.Type{
+	events.TypeVote,
+	events.TypePollCreated,
+	events.TypePollPublished,
+	events.TypePollClosed,
+	events.TypePollReopened,
+	events.TypePollDeleted,
+}
+
+// Payload is the JSON body of a webhook delivery
+type Payload struct {
+	Event      events.Type `json:"event"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Poll       Poll        `json:"poll"`
+	Vote       *Vote       `json:"vote,omitempty"`
+}
+
+// Poll identifies the poll an event happened to
+type Poll struct {
+	ID      int    `json:"id"`
+	Title   string `json:"title"`
+	OwnerID int    `json:"owner_id"`
+}
+
+// Vote describes the ballot change behind a vote event. OptionIDs is absent
+// when the ballot was retracted, and votes on anonymous polls carry only the
+// action.
+type Vote struct {
+	Action    string `json:"action"`
+	UserID    int    `json:"user_id,omitempty"`
+	OptionIDs []int  `json:"option_ids,omitempty"`
+}
+
+// NewSecret generates a random key for signing a webhook's deliveries
+func NewSecret() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b) // never returns an error
+	return secretPrefix + base64.RawURLEncoding.EncodeToString(b)
+}
+
+// Sign returns the X-Signature header value for a request body: the
+// hex-encoded HMAC-SHA256 of the body keyed with the webhook's secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Enqueue writes a delivery of the event to the outbox for every webhook
+// subscribed to it: those on the poll and those of the poll's owner without
+// a poll. Called within the transaction that makes the change, deliveries
+// exist exactly when the change is committed.
+func Enqueue(ctx context.Context, tx *ent.Tx, event events.Type, p *ent.Poll, vote *Vote) error {
+	hooks, err := tx.Webhook.Query().
+		Where(webhook.Or(
+			webhook.PollID(p.ID),
+			webhook.And(webhook.PollIDIsNil(), webhook.UserID(p.OwnerID)),
+		)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	hooks = slices.DeleteFunc(hooks, func(h *ent.Webhook) bool {
+		return !slices.Contains(h.Events, string(event))
+	})
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(Payload{
+		Event:      event,
+		OccurredAt: time.Now(),
+		Poll:       Poll{ID: p.ID, Title: p.Title, OwnerID: p.OwnerID},
+		Vote:       vote,
+	})
+	if err != nil {
+		return err
+	}
+
+	creates := make([]*ent.WebhookDeliveryCreate, len(hooks))
+	for i, h := range hooks {
+		creates[i] = tx.WebhookDelivery.Create().
+			SetWebhookID(h.ID).
+			SetEvent(string(event)).
+			SetPayload(string(payload))
+	}
+	return tx.WebhookDelivery.CreateBulk(creates...).Exec(ctx)
+}
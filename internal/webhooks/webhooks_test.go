@@ -0,0 +1,112 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event":"vote"}`)
+
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write(body)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.Equal(t, want, Sign("whsec_test", body))
+	assert.NotEqual(t, want, Sign("whsec_other", body))
+}
+
+func TestNewSecret(t *testing.T) {
+	secret := NewSecret()
+
+	assert.True(t, strings.HasPrefix(secret, secretPrefix))
+	assert.Len(t, secret, len(secretPrefix)+43)
+	assert.NotEqual(t, secret, NewSecret())
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{name: "first failure", attempts: 1, want: 30 * time.Second},
+		{name: "second failure", attempts: 2, want: time.Minute},
+		{name: "fourth failure", attempts: 4, want: 4 * time.Minute},
+		{name: "capped", attempts: 10, want: time.Hour},
+		{name: "far past the cap", attempts: 100, want: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Backoff(tt.attempts, 30*time.Second, time.Hour))
+		})
+	}
+}
+
+func TestPublicAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "203.0.113.10", want: true},
+		{addr: "2001:db8::1", want: true},
+		{addr: "127.0.0.1", want: false},
+		{addr: "::1", want: false},
+		{addr: "10.1.2.3", want: false},
+		{addr: "172.16.0.1", want: false},
+		{addr: "192.168.1.1", want: false},
+		{addr: "fd00::1", want: false},
+		{addr: "169.254.169.254", want: false},
+		{addr: "fe80::1", want: false},
+		{addr: "100.64.0.1", want: false},
+		{addr: "0.0.0.0", want: false},
+		{addr: "::", want: false},
+		{addr: "224.0.0.1", want: false},
+		{addr: "::ffff:127.0.0.1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.want, PublicAddr(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	var calls atomic.Int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path == "/moved" {
+			http.Redirect(w, r, "/hook", http.StatusFound)
+		}
+	}))
+	defer receiver.Close()
+
+	t.Run("private target refused", func(t *testing.T) {
+		calls.Store(0)
+		_, err := newHTTPClient(func() bool { return false }).Get(receiver.URL + "/hook")
+		assert.ErrorIs(t, err, ErrTargetNotAllowed)
+		assert.Zero(t, calls.Load())
+	})
+
+	t.Run("redirect not followed", func(t *testing.T) {
+		calls.Store(0)
+		resp, err := newHTTPClient(func() bool { return true }).Get(receiver.URL + "/moved")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
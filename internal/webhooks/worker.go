@@ -0,0 +1,279 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/poll"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookdelivery"
+)
+
+// Worker defaults
+const (
+	DefaultPollInterval = 5 * time.Second
+	DefaultMaxAttempts  = 8
+	DefaultBaseDelay    = 30 * time.Second
+	DefaultMaxDelay     = time.Hour
+	DefaultBatchSize    = 50
+
+	// requestTimeout bounds a single delivery request
+	requestTimeout = 10 * time.Second
+	// claimLease is how long a claimed delivery is hidden from other
+	// workers. It outlasts a request, so a delivery is only picked up again
+	// if its worker died mid-send.
+	claimLease = 2 * requestTimeout
+	// maxErrorLength bounds the error message stored on an attempt
+	maxErrorLength = 500
+)
+
+// Worker sends due deliveries from the outbox. Any number of workers may run
+// against the same database: a delivery is claimed before it is sent.
+type Worker struct {
+	client *ent.Client
+	logger *slog.Logger
+	http   *http.Client
+
+	// PollInterval is how often the outbox is checked for due deliveries
+	PollInterval time.Duration
+	// MaxAttempts is how many times a delivery is tried before it fails
+	MaxAttempts int
+	// BaseDelay is the wait after the first failed attempt. It doubles with
+	// every further failure, up to MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// BatchSize is how many due deliveries are sent per check
+	BatchSize int
+	// AllowPrivateTargets lets deliveries reach loopback and private
+	// addresses. It is meant for tests against a local receiver.
+	AllowPrivateTargets bool
+}
+
+// NewWorker creates a worker with the default schedule
+func NewWorker(client *ent.Client, logger *slog.Logger) *Worker {
+	w := &Worker{
+		client:       client,
+		logger:       logger,
+		PollInterval: DefaultPollInterval,
+		MaxAttempts:  DefaultMaxAttempts,
+		BaseDelay:    DefaultBaseDelay,
+		MaxDelay:     DefaultMaxDelay,
+		BatchSize:    DefaultBatchSize,
+	}
+	w.http = newHTTPClient(func() bool { return w.AllowPrivateTargets })
+	return w
+}
+
+// Run sends due deliveries every PollInterval until ctx is canceled
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.PollInterval)
+	defer ticker.Stop()
+	for {
+		// A full batch suggests a backlog, so check again straight away
+		if w.ProcessDue(ctx) == w.BatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessDue sends one batch of due deliveries and returns how many it
+// attempted
+func (w *Worker) ProcessDue(ctx context.Context) int {
+	due, err := w.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(time.Now()),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(w.BatchSize).
+		WithWebhook().
+		All(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.LogAttrs(ctx, slog.LevelError, "failed to query webhook deliveries", slog.String("error", err.Error()))
+		}
+		return 0
+	}
+
+	attempted := 0
+	for _, d := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		claimed, err := w.claim(ctx, d)
+		if err != nil {
+			w.logger.LogAttrs(ctx, slog.LevelError, "failed to claim webhook delivery", slog.String("error", err.Error()), slog.Int("delivery_id", d.ID))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		w.deliver(ctx, d)
+		attempted++
+	}
+	return attempted
+}
+
+// claim pushes the delivery's next attempt past the lease, reporting whether
+// this worker got to it first
+func (w *Worker) claim(ctx context.Context, d *ent.WebhookDelivery) (bool, error) {
+	n, err := w.client.WebhookDelivery.Update().
+		Where(
+			webhookdelivery.ID(d.ID),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAt(d.NextAttemptAt),
+		).
+		SetNextAttemptAt(time.Now().Add(claimLease)).
+		Save(ctx)
+	return n == 1, err
+}
+
+// deliver sends the delivery once and records the outcome
+func (w *Worker) deliver(ctx context.Context, d *ent.WebhookDelivery) {
+	start := time.Now()
+	statusCode, sendErr := w.send(ctx, d)
+	duration := time.Since(start)
+
+	if err := w.record(ctx, d, statusCode, sendErr, duration); err != nil {
+		w.logger.LogAttrs(ctx, slog.LevelError, "failed to record webhook attempt", slog.String("error", err.Error()), slog.Int("delivery_id", d.ID))
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.Int("delivery_id", d.ID),
+		slog.Int("webhook_id", d.WebhookID),
+		slog.String("event", d.Event),
+		slog.Int("attempt", d.AttemptCount+1),
+		slog.Duration("duration", duration),
+	}
+	if sendErr != nil {
+		w.logger.LogAttrs(ctx, slog.LevelWarn, "webhook delivery failed", append(attrs, slog.String("error", sendErr.Error()))...)
+		return
+	}
+	w.logger.LogAttrs(ctx, slog.LevelInfo, "webhook delivered", attrs...)
+}
+
+// send posts the payload to the webhook, returning the response status if
+// one arrived. Any status outside 2xx is an error.
+func (w *Worker) send(ctx context.Context, d *ent.WebhookDelivery) (*int, error) {
+	body := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Edges.Webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "polling-app-webhooks")
+	req.Header.Set("X-Webhook-Event", d.Event)
+	req.Header.Set("X-Webhook-Delivery", strconv.Itoa(d.ID))
+	req.Header.Set("X-Signature", Sign(d.Edges.Webhook.Secret, body))
+
+	resp, err := w.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return &resp.StatusCode, nil
+}
+
+// record logs the attempt and schedules what happens to the delivery next
+func (w *Worker) record(ctx context.Context, d *ent.WebhookDelivery, statusCode *int, sendErr error, duration time.Duration) error {
+	tx, err := w.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	attempt := tx.WebhookAttempt.Create().
+		SetDeliveryID(d.ID).
+		SetNillableStatusCode(statusCode).
+		SetDurationMs(int(duration.Milliseconds()))
+	if sendErr != nil {
+		msg := sendErr.Error()
+		if len(msg) > maxErrorLength {
+			msg = msg[:maxErrorLength]
+		}
+		attempt.SetError(msg)
+	}
+	if err := attempt.Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	attempts := d.AttemptCount + 1
+	update := tx.WebhookDelivery.UpdateOneID(d.ID).SetAttemptCount(attempts)
+	switch {
+	case sendErr == nil:
+		update.SetStatus(webhookdelivery.StatusSucceeded).SetDeliveredAt(time.Now())
+	case attempts >= w.MaxAttempts:
+		update.SetStatus(webhookdelivery.StatusFailed)
+	default:
+		update.SetNextAttemptAt(time.Now().Add(Backoff(attempts, w.BaseDelay, w.MaxDelay)))
+	}
+	if err := update.Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if sendErr == nil || attempts >= w.MaxAttempts {
+		if err := removeOrphaned(ctx, tx, d.Edges.Webhook); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// removeOrphaned deletes a webhook whose poll is gone once nothing is left
+// to send to it. Deleting the poll leaves such webhooks behind only while
+// their poll_deleted delivery, or an earlier one, is pending.
+func removeOrphaned(ctx context.Context, tx *ent.Tx, h *ent.Webhook) error {
+	if h.PollID == nil {
+		return nil
+	}
+	pollExists, err := tx.Poll.Query().Where(poll.ID(*h.PollID)).Exist(ctx)
+	if err != nil || pollExists {
+		return err
+	}
+	pending, err := tx.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.WebhookID(h.ID),
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+		).
+		Exist(ctx)
+	if err != nil || pending {
+		return err
+	}
+	// The owner may have deleted it in the meantime
+	if err := tx.Webhook.DeleteOneID(h.ID).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// Backoff returns the wait before retrying a delivery that has failed
+// attempts times: base doubled for each failure after the first, capped at
+// maxDelay
+func Backoff(attempts int, base, maxDelay time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	return min(delay, maxDelay)
+}
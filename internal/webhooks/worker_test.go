@@ -0,0 +1,234 @@
+//go:build integration
+
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ivankorhner/polling-app/internal/ent"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookattempt"
+	"github.com/ivankorhner/polling-app/internal/ent/webhookdelivery"
+	"github.com/ivankorhner/polling-app/internal/events"
+	"github.com/ivankorhner/polling-app/internal/testutil"
+	"github.com/ivankorhner/polling-app/internal/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// enqueue queues the event for the poll in its own transaction
+func enqueue(ctx context.Context, t *testing.T, client *ent.Client, event events.Type, p *ent.Poll, vote *webhooks.Vote) {
+	t.Helper()
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, webhooks.Enqueue(ctx, tx, event, p, vote))
+	require.NoError(t, tx.Commit())
+}
+
+func TestEnqueue(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+	client := testDB.Client
+
+	owner, err := client.User.Create().SetUsername("owner").SetEmail("owner@example.com").Save(ctx)
+	require.NoError(t, err)
+	other, err := client.User.Create().SetUsername("other").SetEmail("other@example.com").Save(ctx)
+	require.NoError(t, err)
+
+	p, err := client.Poll.Create().SetOwnerID(owner.ID).SetTitle("Lunch?").Save(ctx)
+	require.NoError(t, err)
+	otherPoll, err := client.Poll.Create().SetOwnerID(owner.ID).SetTitle("Dinner?").Save(ctx)
+	require.NoError(t, err)
+
+	newHook := func(userID int, pollID *int, types ...string) *ent.Webhook {
+		h, err := client.Webhook.Create().
+			SetUserID(userID).
+			SetNillablePollID(pollID).
+			SetURL("https://example.com/hook").
+			SetSecret(webhooks.NewSecret()).
+			SetEvents(types).
+			Save(ctx)
+		require.NoError(t, err)
+		return h
+	}
+
+	onPoll := newHook(owner.ID, &p.ID, "vote")
+	ownerWide := newHook(owner.ID, nil, "vote", "poll_closed")
+	newHook(owner.ID, &p.ID, "poll_closed")  // different event
+	newHook(owner.ID, &otherPoll.ID, "vote") // different poll
+	newHook(other.ID, nil, "vote")           // someone else's polls
+
+	enqueue(ctx, t, client, events.TypeVote, p, &webhooks.Vote{Action: "cast", UserID: other.ID, OptionIDs: []int{7}})
+
+	deliveries, err := client.WebhookDelivery.Query().
+		Order(ent.Asc(webhookdelivery.FieldWebhookID)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, onPoll.ID, deliveries[0].WebhookID)
+	assert.Equal(t, ownerWide.ID, deliveries[1].WebhookID)
+
+	d := deliveries[0]
+	assert.Equal(t, "vote", d.Event)
+	assert.Equal(t, webhookdelivery.StatusPending, d.Status)
+
+	var payload webhooks.Payload
+	require.NoError(t, json.Unmarshal([]byte(d.Payload), &payload))
+	assert.Equal(t, events.TypeVote, payload.Event)
+	assert.Equal(t, webhooks.Poll{ID: p.ID, Title: "Lunch?", OwnerID: owner.ID}, payload.Poll)
+	require.NotNil(t, payload.Vote)
+	assert.Equal(t, []int{7}, payload.Vote.OptionIDs)
+}
+
+func TestWorker(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+	client := testDB.Client
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	owner, err := client.User.Create().SetUsername("owner").SetEmail("owner@example.com").Save(ctx)
+	require.NoError(t, err)
+	p, err := client.Poll.Create().SetOwnerID(owner.ID).SetTitle("Lunch?").Save(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		// Statuses the receiver answers successive attempts with
+		responses   []int
+		maxAttempts int
+		wantStatus  webhookdelivery.Status
+	}{
+		{
+			name:        "delivered first time",
+			responses:   []int{http.StatusNoContent},
+			maxAttempts: 3,
+			wantStatus:  webhookdelivery.StatusSucceeded,
+		},
+		{
+			name:        "retried after a failure",
+			responses:   []int{http.StatusInternalServerError, http.StatusOK},
+			maxAttempts: 3,
+			wantStatus:  webhookdelivery.StatusSucceeded,
+		},
+		{
+			name:        "failed after max attempts",
+			responses:   []int{http.StatusBadGateway, http.StatusBadGateway},
+			maxAttempts: 2,
+			wantStatus:  webhookdelivery.StatusFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			var secret string
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, webhooks.Sign(secret, body), r.Header.Get("X-Signature"))
+				assert.Equal(t, "poll_closed", r.Header.Get("X-Webhook-Event"))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				n := int(calls.Add(1))
+				w.WriteHeader(tt.responses[min(n, len(tt.responses))-1])
+			}))
+			defer receiver.Close()
+
+			secret = webhooks.NewSecret()
+			h, err := client.Webhook.Create().
+				SetUserID(owner.ID).
+				SetPollID(p.ID).
+				SetURL(receiver.URL).
+				SetSecret(secret).
+				SetEvents([]string{"poll_closed"}).
+				Save(ctx)
+			require.NoError(t, err)
+			defer client.Webhook.DeleteOne(h).ExecX(ctx)
+
+			enqueue(ctx, t, client, events.TypePollClosed, p, nil)
+			d, err := h.QueryDeliveries().Only(ctx)
+			require.NoError(t, err)
+
+			worker := webhooks.NewWorker(client, logger)
+			worker.AllowPrivateTargets = true
+			worker.MaxAttempts = tt.maxAttempts
+			worker.BaseDelay = time.Millisecond
+			worker.MaxDelay = time.Millisecond
+
+			for range tt.responses {
+				require.Equal(t, 1, worker.ProcessDue(ctx))
+				time.Sleep(10 * time.Millisecond)
+			}
+			// Nothing is left to send once the delivery is settled
+			assert.Zero(t, worker.ProcessDue(ctx))
+
+			d, err = client.WebhookDelivery.Get(ctx, d.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, d.Status)
+			assert.Equal(t, len(tt.responses), d.AttemptCount)
+			assert.Equal(t, tt.wantStatus == webhookdelivery.StatusSucceeded, d.DeliveredAt != nil)
+
+			attempts, err := d.QueryAttempts().Order(ent.Asc(webhookattempt.FieldID)).All(ctx)
+			require.NoError(t, err)
+			require.Len(t, attempts, len(tt.responses))
+			for i, a := range attempts {
+				require.NotNil(t, a.StatusCode)
+				assert.Equal(t, tt.responses[i], *a.StatusCode, "attempt "+strconv.Itoa(i+1))
+				assert.Equal(t, *a.StatusCode >= 300, a.Error != "")
+			}
+		})
+	}
+}
+
+func TestWorker_Unreachable(t *testing.T) {
+	ctx := context.Background()
+	testDB := testutil.SetupTestDB(ctx, t)
+	defer testDB.Teardown(ctx)
+	client := testDB.Client
+
+	owner, err := client.User.Create().SetUsername("owner").SetEmail("owner@example.com").Save(ctx)
+	require.NoError(t, err)
+	p, err := client.Poll.Create().SetOwnerID(owner.ID).SetTitle("Lunch?").Save(ctx)
+	require.NoError(t, err)
+
+	// A server that is closed straight away refuses connections
+	receiver := httptest.NewServer(http.NotFoundHandler())
+	receiver.Close()
+
+	h, err := client.Webhook.Create().
+		SetUserID(owner.ID).
+		SetURL(receiver.URL).
+		SetSecret(webhooks.NewSecret()).
+		SetEvents([]string{"poll_closed"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	enqueue(ctx, t, client, events.TypePollClosed, p, nil)
+
+	worker := webhooks.NewWorker(client, slog.New(slog.NewTextHandler(os.Stdout, nil)))
+	worker.AllowPrivateTargets = true
+	require.Equal(t, 1, worker.ProcessDue(ctx))
+
+	d, err := h.QueryDeliveries().WithAttempts().Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, webhookdelivery.StatusPending, d.Status)
+	assert.Equal(t, 1, d.AttemptCount)
+	assert.True(t, d.NextAttemptAt.After(time.Now()), "retry is scheduled in the future")
+
+	require.Len(t, d.Edges.Attempts, 1)
+	assert.Nil(t, d.Edges.Attempts[0].StatusCode)
+	assert.NotEmpty(t, d.Edges.Attempts[0].Error)
+}